@@ -8,6 +8,7 @@ import (
 
 	"github.com/confluentinc/cli/v3/internal"
 	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/output"
 	pversion "github.com/confluentinc/cli/v3/pkg/version"
 )
 
@@ -26,6 +27,11 @@ func main() {
 	err := cfg.Load()
 	cobra.CheckErr(err)
 
+	// a broken project configuration must not break commands which don't depend on it, like "context use"
+	if err := cfg.LoadProject(); err != nil {
+		output.ErrPrintf(cfg.EnableColor, "[WARN] Ignoring the project configuration: %v\n", err)
+	}
+
 	disableUpdates, err := strconv.ParseBool(disableUpdates)
 	cobra.CheckErr(err)
 
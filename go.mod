@@ -3,6 +3,7 @@ module github.com/confluentinc/cli/v3
 go 1.21
 
 require (
+	filippo.io/age v1.0.0
 	github.com/antihax/optional v1.0.0
 	github.com/aws/aws-sdk-go v1.48.11
 	github.com/billgraziano/dpapi v0.5.0
@@ -180,7 +181,7 @@ require (
 	golang.org/x/tools v0.13.0 // indirect
 	google.golang.org/appengine v1.6.7 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20230525234030-28d5490b6b19 // indirect
-	google.golang.org/protobuf v1.31.0 // indirect
+	google.golang.org/protobuf v1.31.0
 	gopkg.in/alecthomas/kingpin.v2 v2.2.6 // indirect
 	gopkg.in/inf.v0 v0.9.1 // indirect
 	gopkg.in/launchdarkly/go-jsonstream.v1 v1.0.1 // indirect
@@ -0,0 +1,65 @@
+package alias
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	palias "github.com/confluentinc/cli/v3/pkg/alias"
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/output"
+	"github.com/confluentinc/cli/v3/pkg/version"
+)
+
+type command struct {
+	*pcmd.CLICommand
+}
+
+type out struct {
+	Name     string   `human:"Name" serialized:"name"`
+	Command  string   `human:"Command" serialized:"-"`
+	Commands []string `human:"-" serialized:"commands"`
+}
+
+func New(prerunner pcmd.PreRunner) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "alias",
+		Short: "Manage command aliases.",
+		Long: fmt.Sprintf("Manage user-defined command aliases. An alias is a new top-level command which expands to one or more %s commands. "+
+			"An alias which expands to several commands runs them in sequence, stopping at the first command which fails.\n\n"+
+			"In alias commands, `$1`, `$2`, etc. are replaced by the arguments passed to the alias, and `$@` by all of them. "+
+			"Arguments which are not referenced are appended to the last command. Aliases cannot shadow built-in commands.", version.CLIName),
+	}
+
+	c := &command{
+		CLICommand: pcmd.NewAnonymousCLICommand(cmd, prerunner),
+	}
+
+	cmd.AddCommand(c.newCreateCommand())
+	cmd.AddCommand(c.newDeleteCommand())
+	cmd.AddCommand(c.newListCommand())
+
+	return cmd
+}
+
+func (c *command) validArgsMultiple(cmd *cobra.Command, args []string) []string {
+	if err := c.PersistentPreRunE(cmd, args); err != nil {
+		return nil
+	}
+
+	suggestions := make([]string, 0, len(c.Config.Aliases))
+	for name, commands := range c.Config.Aliases {
+		suggestions = append(suggestions, fmt.Sprintf("%s\t%s", name, palias.Describe(commands)))
+	}
+	return suggestions
+}
+
+func printAlias(cmd *cobra.Command, name string, commands []string) error {
+	table := output.NewTable(cmd)
+	table.Add(&out{
+		Name:     name,
+		Command:  palias.Describe(commands),
+		Commands: commands,
+	})
+	return table.Print()
+}
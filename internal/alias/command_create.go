@@ -0,0 +1,64 @@
+package alias
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	palias "github.com/confluentinc/cli/v3/pkg/alias"
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/version"
+)
+
+func (c *command) newCreateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create <name> <command-1> [command-2] ... [command-n]",
+		Short: "Create a command alias.",
+		Long:  "Create a command alias which expands to one or more commands. Each command is passed as a single quoted argument, without the leading `confluent`.",
+		Args:  cobra.MinimumNArgs(2),
+		RunE:  c.create,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Create an alias "lag" which summarizes the lag of a consumer group in a specific Kafka cluster:`,
+				Code: fmt.Sprintf(`%[1]s alias create lag "kafka consumer group lag summarize --cluster lkc-123456"`+"\n"+`%[1]s lag my-consumer-group`, version.CLIName),
+			},
+			examples.Example{
+				Text: `Create an alias "switch" which selects an environment and a Kafka cluster:`,
+				Code: fmt.Sprintf(`%[1]s alias create switch 'environment use $1' 'kafka cluster use $2'`+"\n"+`%[1]s switch env-123456 lkc-123456`, version.CLIName),
+			},
+		),
+	}
+
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *command) create(cmd *cobra.Command, args []string) error {
+	name, commands := args[0], args[1:]
+
+	if err := palias.ValidateName(cmd, name); err != nil {
+		return err
+	}
+	if _, ok := c.Config.Aliases[name]; ok {
+		return errors.NewErrorWithSuggestions(
+			fmt.Sprintf(`alias "%s" already exists`, name),
+			fmt.Sprintf("Delete it first with `%s alias delete %s`.", version.CLIName, name),
+		)
+	}
+	if err := palias.Validate(commands); err != nil {
+		return err
+	}
+
+	if c.Config.Aliases == nil {
+		c.Config.Aliases = map[string][]string{}
+	}
+	c.Config.Aliases[name] = commands
+	if err := c.Config.Save(); err != nil {
+		return err
+	}
+
+	return printAlias(cmd, name, commands)
+}
@@ -0,0 +1,64 @@
+package alias
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/deletion"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/resource"
+	"github.com/confluentinc/cli/v3/pkg/utils"
+)
+
+func (c *command) newDeleteCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "delete <alias-1> [alias-2] ... [alias-n]",
+		Short:             "Delete one or more command aliases.",
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: pcmd.NewValidArgsFunction(c.validArgsMultiple),
+		RunE:              c.delete,
+	}
+
+	pcmd.AddForceFlag(cmd)
+
+	return cmd
+}
+
+func (c *command) delete(cmd *cobra.Command, args []string) error {
+	var missing []string
+	for _, name := range args {
+		if _, ok := c.Config.Aliases[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return aliasesNotFoundError(missing)
+	}
+
+	if err := deletion.ConfirmDeletionYesNo(cmd, deletion.DefaultYesNoPromptString(resource.Alias, args)); err != nil {
+		return err
+	}
+
+	deleteFunc := func(name string) error {
+		delete(c.Config.Aliases, name)
+		return c.Config.Save()
+	}
+
+	_, err := deletion.Delete(args, deleteFunc, resource.Alias)
+	return err
+}
+
+// aliasesNotFoundError is like resource.ResourcesNotFoundError, but with the plural "aliases" in its suggestion
+func aliasesNotFoundError(names []string) error {
+	resourceType := resource.Alias
+	if len(names) > 1 {
+		resourceType = resource.Plural(resource.Alias)
+	}
+
+	return errors.NewErrorWithSuggestions(
+		fmt.Sprintf("%s %s not found", resourceType, utils.ArrayToCommaDelimitedString(names, "and")),
+		fmt.Sprintf("List available %s with `confluent alias list`.", resource.Plural(resource.Alias)),
+	)
+}
@@ -0,0 +1,34 @@
+package alias
+
+import (
+	"github.com/spf13/cobra"
+
+	palias "github.com/confluentinc/cli/v3/pkg/alias"
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+func (c *command) newListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List command aliases.",
+		Args:  cobra.NoArgs,
+		RunE:  c.list,
+	}
+
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *command) list(cmd *cobra.Command, _ []string) error {
+	list := output.NewList(cmd)
+	for name, commands := range c.Config.Aliases {
+		list.Add(&out{
+			Name:     name,
+			Command:  palias.Describe(commands),
+			Commands: commands,
+		})
+	}
+	return list.Print()
+}
@@ -132,6 +132,10 @@ func (c *command) create(cmd *cobra.Command, _ []string) error {
 		if err := c.keystore.StoreAPIKey(c.V2Client, userKey, resourceId); err != nil {
 			return fmt.Errorf(unableToStoreApiKeyErrorMsg, err)
 		}
+	} else if resourceType == resource.SchemaRegistryCluster && c.Config.CredentialHelper != "" {
+		if err := c.keystore.StoreSchemaRegistryAPIKey(userKey, resourceId); err != nil {
+			return fmt.Errorf(unableToStoreApiKeyErrorMsg, err)
+		}
 	}
 
 	use, err := cmd.Flags().GetBool("use")
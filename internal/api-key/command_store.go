@@ -22,7 +22,11 @@ machine, the secret is not available for CLI use until you "store" it. This is b
 secrets are irretrievable after creation.
 
 You must have an API secret stored locally for certain CLI commands to
-work. For example, the Kafka topic consume and produce commands require an API secret.`
+work. For example, the Kafka topic consume and produce commands require an API secret.
+
+If a credential helper is configured with "confluent configuration update credential_helper <name>",
+the secret is stored by the "confluent-credential-<name>" executable instead of the local CLI
+configuration file. Schema Registry API keys can then be stored as well.`
 
 func (c *command) newStoreCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -62,8 +66,12 @@ func (c *command) store(cmd *cobra.Command, args []string) error {
 
 	// Attempt to get cluster from --resource flag if set; if that doesn't work,
 	// attempt to fall back to the currently active Kafka cluster
+	// Schema Registry API keys can only be stored by a credential helper
 	resourceType, clusterId, _, err := c.resolveResourceId(cmd, c.V2Client)
-	if err == nil && clusterId != "" {
+	isSchemaRegistry := err == nil && resourceType == resource.SchemaRegistryCluster && c.Config.CredentialHelper != ""
+	if isSchemaRegistry {
+		cluster = &config.KafkaClusterConfig{ID: clusterId}
+	} else if err == nil && clusterId != "" {
 		if resourceType != resource.KafkaCluster {
 			return fmt.Errorf(nonKafkaNotImplementedErrorMsg)
 		}
@@ -124,6 +132,14 @@ func (c *command) store(cmd *cobra.Command, args []string) error {
 		)
 	}
 
+	if isSchemaRegistry {
+		if err := c.keystore.StoreSchemaRegistryAPIKey(&config.APIKeyPair{Key: key, Secret: secret}, clusterId); err != nil {
+			return fmt.Errorf(unableToStoreApiKeyErrorMsg, err)
+		}
+		output.ErrPrintf(c.Config.EnableColor, "Stored secret for API key \"%s\" with credential helper \"%s\".\n", key, c.Config.CredentialHelper)
+		return nil
+	}
+
 	// API key exists server-side... now check if API key exists locally already
 	if found, err := c.keystore.HasAPIKey(c.V2Client, key, cluster.ID); err != nil {
 		return err
@@ -12,6 +12,7 @@ import (
 	cliv1 "github.com/confluentinc/ccloud-sdk-go-v2/cli/v1"
 
 	"github.com/confluentinc/cli/v3/internal/admin"
+	"github.com/confluentinc/cli/v3/internal/alias"
 	apikey "github.com/confluentinc/cli/v3/internal/api-key"
 	"github.com/confluentinc/cli/v3/internal/asyncapi"
 	auditlog "github.com/confluentinc/cli/v3/internal/audit-log"
@@ -43,6 +44,7 @@ import (
 	streamshare "github.com/confluentinc/cli/v3/internal/stream-share"
 	"github.com/confluentinc/cli/v3/internal/update"
 	"github.com/confluentinc/cli/v3/internal/version"
+	palias "github.com/confluentinc/cli/v3/pkg/alias"
 	pauth "github.com/confluentinc/cli/v3/pkg/auth"
 	"github.com/confluentinc/cli/v3/pkg/ccloudv2"
 	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
@@ -51,6 +53,7 @@ import (
 	"github.com/confluentinc/cli/v3/pkg/featureflags"
 	"github.com/confluentinc/cli/v3/pkg/form"
 	"github.com/confluentinc/cli/v3/pkg/help"
+	"github.com/confluentinc/cli/v3/pkg/log"
 	"github.com/confluentinc/cli/v3/pkg/netrc"
 	"github.com/confluentinc/cli/v3/pkg/output"
 	ppanic "github.com/confluentinc/cli/v3/pkg/panic-recovery"
@@ -72,6 +75,7 @@ func NewConfluentCommand(cfg *config.Config) *cobra.Command {
 	cmd.PersistentFlags().BoolP("help", "h", false, "Show help for this command.")
 	cmd.PersistentFlags().CountP("verbose", "v", "Increase verbosity (-v for warn, -vv for info, -vvv for debug, -vvvv for trace).")
 	cmd.PersistentFlags().Bool("unsafe-trace", false, "Equivalent to -vvvv, but also log HTTP requests and responses which might contain plaintext secrets.")
+	cmd.PersistentFlags().String("log-file", "", "Append JSON-formatted log entries to this file, including the timing of HTTP requests and responses. Credentials are redacted.")
 
 	disableUpdateCheck := cfg.DisableUpdates || cfg.DisableUpdateCheck
 	updateClient := update.NewClient(pversion.CLIName, disableUpdateCheck)
@@ -104,6 +108,7 @@ func NewConfluentCommand(cfg *config.Config) *cobra.Command {
 	}
 
 	cmd.AddCommand(admin.New(prerunner, cfg.IsTest))
+	cmd.AddCommand(alias.New(prerunner))
 	cmd.AddCommand(apikey.New(prerunner, flagResolver))
 	cmd.AddCommand(asyncapi.New(prerunner))
 	cmd.AddCommand(auditlog.New(prerunner))
@@ -141,6 +146,9 @@ func NewConfluentCommand(cfg *config.Config) *cobra.Command {
 		cmd.AddCommand(flink.New(cfg, prerunner))
 	}
 
+	// Aliases are added last, so that they cannot shadow built-in commands
+	palias.AddCommands(cmd, cfg)
+
 	changeDefaults(cmd, cfg)
 	deprecateCommandsAndFlags(cmd, cfg)
 	featureflags.Manager.SetCommandAndFlags(cmd, os.Args[1:])
@@ -161,6 +169,19 @@ func Execute(cmd *cobra.Command, args []string, cfg *config.Config) error {
 			cobra.CheckErr(r)
 		}
 	}()
+	if alias := palias.FindAlias(cmd, args, cfg); alias != nil {
+		steps, err := alias.Expand()
+		if err != nil {
+			output.ErrPrintf(cfg.EnableColor, "Error: %v\n", err)
+			output.ErrPrint(cfg.EnableColor, errors.DisplaySuggestionsMessage(err))
+			return err
+		}
+		if len(steps) > 1 {
+			return palias.ExecSteps(steps)
+		}
+		args = steps[0]
+		cmd.SetArgs(args)
+	}
 	if !cfg.DisablePlugins {
 		if plugin := pplugin.FindPlugin(cmd, args, cfg); plugin != nil {
 			return pplugin.ExecPlugin(plugin)
@@ -175,6 +196,7 @@ func Execute(cmd *cobra.Command, args []string, cfg *config.Config) error {
 
 	err := cmd.Execute()
 	output.ErrPrint(cfg.EnableColor, errors.DisplaySuggestionsMessage(err))
+	log.CliLogger.CloseLogFile(err)
 
 	u.Error = cliv1.PtrBool(err != nil)
 	if err := reportUsage(cmd, cfg, u); err != nil {
@@ -0,0 +1,134 @@
+package configuration
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/form"
+	"github.com/confluentinc/cli/v3/pkg/output"
+	"github.com/confluentinc/cli/v3/pkg/secret"
+)
+
+var encryptionNotEnabledErr = errors.NewErrorWithSuggestions(
+	"secrets in the configuration file are not protected by a passphrase or key file",
+	"Protect them with `confluent configuration encryption enable`.",
+)
+
+func (c *command) newEncryptionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "encryption",
+		Short: "Protect secrets in the configuration file with a passphrase or key file.",
+		Long: "Protect the API secrets, authentication tokens, and saved login passwords in the configuration file with a key derived from a passphrase, or with a random key which is encrypted to the age X25519 identity in a key file.\n\n" +
+			"By default, these secrets are encrypted with a key derived from the machine, so anyone who can read the configuration file on the same machine can decrypt them. " +
+			fmt.Sprintf("Once encryption is enabled, the CLI asks for the passphrase when it needs a secret, unless the key is cached for the session with `confluent configuration encryption unlock`, or the passphrase is set in the `%s` environment variable.", config.ConfigPassphraseEnvVar),
+	}
+
+	cmd.AddCommand(c.newEncryptionAgentCommand())
+	cmd.AddCommand(c.newEncryptionDisableCommand())
+	cmd.AddCommand(c.newEncryptionEnableCommand())
+	cmd.AddCommand(c.newEncryptionLockCommand())
+	cmd.AddCommand(c.newEncryptionRekeyCommand())
+	cmd.AddCommand(c.newEncryptionUnlockCommand())
+
+	return cmd
+}
+
+func addKeyFileFlag(cmd *cobra.Command) {
+	cmd.Flags().String("key-file", "", "Path to a file containing an age X25519 identity, as generated by `age-keygen`. If the file does not exist, a new identity is generated. By default, a passphrase is used.")
+	cobra.CheckErr(cmd.MarkFlagFilename("key-file"))
+}
+
+// loadConfig reads the configuration file from disk, since the secrets of the configuration in memory may already be
+// decrypted.
+func (c *command) loadConfig() (*config.Config, error) {
+	cfg := config.New()
+	cfg.Filename = c.cfg.GetFilename()
+	cfg.IsTest = c.cfg.IsTest
+	if err := cfg.Load(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// unlock returns the protection key of a configuration, and uses it for the rest of the command.
+func unlock(cfg *config.Config) ([]byte, error) {
+	key, err := cfg.UnlockEncryptionKeyWithPrompt(func() (string, error) {
+		f := form.New(form.Field{ID: "passphrase", Prompt: "Passphrase", IsHidden: true})
+		if err := f.Prompt(form.NewPrompt()); err != nil {
+			return "", err
+		}
+		return f.Responses["passphrase"].(string), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	secret.SetProtectionKey(key)
+	return key, nil
+}
+
+// newEncryptionConfig sets up a new protection key, from the key file if one is passed, or from a new passphrase.
+func newEncryptionConfig(cmd *cobra.Command) (*config.EncryptionConfig, []byte, error) {
+	keyFile, err := cmd.Flags().GetString("key-file")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if keyFile == "" {
+		passphrase, err := promptNewPassphrase()
+		if err != nil {
+			return nil, nil, err
+		}
+		return config.NewEncryptionConfig(passphrase, "")
+	}
+
+	keyFile, err = filepath.Abs(keyFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := os.Stat(keyFile); os.IsNotExist(err) {
+		identity, err := secret.GenerateIdentity()
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := os.WriteFile(keyFile, identity, 0600); err != nil {
+			return nil, nil, err
+		}
+		output.ErrPrintf(false, "Generated a new age identity in key file \"%s\".\n", keyFile)
+	}
+
+	return config.NewEncryptionConfig("", keyFile)
+}
+
+func promptNewPassphrase() (string, error) {
+	f := form.New(
+		form.Field{ID: "passphrase", Prompt: "New passphrase", IsHidden: true},
+		form.Field{ID: "confirm", Prompt: "Confirm passphrase", IsHidden: true},
+	)
+	if err := f.Prompt(form.NewPrompt()); err != nil {
+		return "", err
+	}
+
+	passphrase := f.Responses["passphrase"].(string)
+	if passphrase == "" {
+		return "", fmt.Errorf("the passphrase must not be empty")
+	}
+	if passphrase != f.Responses["confirm"].(string) {
+		return "", fmt.Errorf("the passphrases do not match")
+	}
+
+	return passphrase, nil
+}
+
+func describeEncryption(encryption *config.EncryptionConfig) string {
+	if encryption.KeyFile != "" {
+		return fmt.Sprintf(`key file "%s"`, encryption.KeyFile)
+	}
+	return "a passphrase"
+}
@@ -0,0 +1,53 @@
+package configuration
+
+import (
+	"bufio"
+	"encoding/base64"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"github.com/confluentinc/cli/v3/pkg/secret"
+)
+
+// newEncryptionAgentCommand is run in the background by `confluent configuration encryption unlock`, which passes it
+// the unlocked key on stdin.
+func (c *command) newEncryptionAgentCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:    "agent",
+		Short:  "Serve the key which protects secrets in the configuration file.",
+		Args:   cobra.NoArgs,
+		Hidden: true,
+		// The agent receives the key on stdin, so it must not ask for a passphrase to decrypt credentials first
+		PersistentPreRunE: func(_ *cobra.Command, _ []string) error { return nil },
+		RunE:              c.encryptionAgent,
+	}
+
+	cmd.Flags().Duration("timeout", 0, "How long to serve the key for.")
+
+	return cmd
+}
+
+func (c *command) encryptionAgent(cmd *cobra.Command, _ []string) error {
+	timeout, err := cmd.Flags().GetDuration("timeout")
+	if err != nil {
+		return err
+	}
+
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return err
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(line))
+	if err != nil {
+		return err
+	}
+
+	// Keep serving the key after the terminal which started the agent is closed
+	signal.Ignore(syscall.SIGHUP)
+
+	return secret.ServeAgent(c.cfg.GetAgentSocket(), key, timeout)
+}
@@ -0,0 +1,41 @@
+package configuration
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/confluentinc/cli/v3/pkg/output"
+	"github.com/confluentinc/cli/v3/pkg/secret"
+)
+
+func (c *command) newEncryptionDisableCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "disable",
+		Short: "Stop protecting secrets in the configuration file with a passphrase or key file.",
+		Long:  "Stop protecting secrets in the configuration file with a passphrase or key file, and encrypt them with a key derived from the machine instead.",
+		Args:  cobra.NoArgs,
+		RunE:  c.encryptionDisable,
+	}
+}
+
+func (c *command) encryptionDisable(_ *cobra.Command, _ []string) error {
+	cfg, err := c.loadConfig()
+	if err != nil {
+		return err
+	}
+
+	if cfg.Encryption == nil {
+		return encryptionNotEnabledErr
+	}
+
+	if _, err := unlock(cfg); err != nil {
+		return err
+	}
+
+	if err := cfg.SetEncryption(nil, nil); err != nil {
+		return err
+	}
+	secret.StopAgent(cfg.GetAgentSocket())
+
+	output.Println(c.Config.EnableColor, "Secrets in the configuration file are no longer protected by a passphrase or key file.")
+	return nil
+}
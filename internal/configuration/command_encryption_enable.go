@@ -0,0 +1,60 @@
+package configuration
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+func (c *command) newEncryptionEnableCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enable",
+		Short: "Protect secrets in the configuration file with a passphrase or key file.",
+		Args:  cobra.NoArgs,
+		RunE:  c.encryptionEnable,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Protect secrets in the configuration file with a passphrase.",
+				Code: "confluent configuration encryption enable",
+			},
+			examples.Example{
+				Text: "Protect secrets in the configuration file with a new key file.",
+				Code: "confluent configuration encryption enable --key-file ~/.confluent/key.txt",
+			},
+		),
+	}
+
+	addKeyFileFlag(cmd)
+
+	return cmd
+}
+
+func (c *command) encryptionEnable(cmd *cobra.Command, _ []string) error {
+	cfg, err := c.loadConfig()
+	if err != nil {
+		return err
+	}
+
+	if cfg.Encryption != nil {
+		return errors.NewErrorWithSuggestions(
+			fmt.Sprintf("secrets in the configuration file are already protected by %s", describeEncryption(cfg.Encryption)),
+			"Change the passphrase or key file with `confluent configuration encryption rekey`.",
+		)
+	}
+
+	encryption, key, err := newEncryptionConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := cfg.SetEncryption(encryption, key); err != nil {
+		return err
+	}
+
+	output.Printf(c.Config.EnableColor, "Protected secrets in the configuration file with %s.\n", describeEncryption(encryption))
+	return nil
+}
@@ -0,0 +1,27 @@
+package configuration
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/confluentinc/cli/v3/pkg/output"
+	"github.com/confluentinc/cli/v3/pkg/secret"
+)
+
+func (c *command) newEncryptionLockCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "lock",
+		Short: "Forget the key cached for the session.",
+		Long:  "Stop the background agent started by `confluent configuration encryption unlock`, so that the key which protects secrets in the configuration file is no longer cached.",
+		Args:  cobra.NoArgs,
+		RunE:  c.encryptionLock,
+	}
+}
+
+func (c *command) encryptionLock(_ *cobra.Command, _ []string) error {
+	if secret.StopAgent(c.cfg.GetAgentSocket()) {
+		output.Println(c.Config.EnableColor, "Locked the configuration file.")
+	} else {
+		output.Println(c.Config.EnableColor, "The configuration file is not unlocked.")
+	}
+	return nil
+}
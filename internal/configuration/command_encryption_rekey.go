@@ -0,0 +1,61 @@
+package configuration
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+	"github.com/confluentinc/cli/v3/pkg/secret"
+)
+
+func (c *command) newEncryptionRekeyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rekey",
+		Short: "Change the passphrase or key file which protects secrets in the configuration file.",
+		Long:  "Change the passphrase or key file which protects secrets in the configuration file, and re-encrypt every secret with the new key. The key cached for the session, if any, is forgotten.",
+		Args:  cobra.NoArgs,
+		RunE:  c.encryptionRekey,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Change the passphrase which protects secrets in the configuration file.",
+				Code: "confluent configuration encryption rekey",
+			},
+			examples.Example{
+				Text: "Switch from a passphrase to a key file.",
+				Code: "confluent configuration encryption rekey --key-file ~/.confluent/key.txt",
+			},
+		),
+	}
+
+	addKeyFileFlag(cmd)
+
+	return cmd
+}
+
+func (c *command) encryptionRekey(cmd *cobra.Command, _ []string) error {
+	cfg, err := c.loadConfig()
+	if err != nil {
+		return err
+	}
+
+	if cfg.Encryption == nil {
+		return encryptionNotEnabledErr
+	}
+
+	if _, err := unlock(cfg); err != nil {
+		return err
+	}
+
+	encryption, key, err := newEncryptionConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := cfg.SetEncryption(encryption, key); err != nil {
+		return err
+	}
+	secret.StopAgent(cfg.GetAgentSocket())
+
+	output.Printf(c.Config.EnableColor, "Protected secrets in the configuration file with %s.\n", describeEncryption(encryption))
+	return nil
+}
@@ -0,0 +1,107 @@
+package configuration
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+	"github.com/confluentinc/cli/v3/pkg/secret"
+)
+
+const agentStartTimeout = 5 * time.Second
+
+func (c *command) newEncryptionUnlockCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unlock",
+		Short: "Cache the key which protects secrets in the configuration file for a session.",
+		Long:  "Unlock the key which protects secrets in the configuration file, and cache it in a background agent, so that other commands do not ask for the passphrase until the timeout expires or the agent is stopped with `confluent configuration encryption lock`. The agent only accepts connections from the owner of the configuration directory.",
+		Args:  cobra.NoArgs,
+		RunE:  c.encryptionUnlock,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Cache the key for an hour.",
+				Code: "confluent configuration encryption unlock --timeout 1h",
+			},
+		),
+	}
+
+	cmd.Flags().Duration("timeout", 15*time.Minute, "How long to cache the key for, or 0 to cache it until the agent is stopped.")
+
+	return cmd
+}
+
+func (c *command) encryptionUnlock(cmd *cobra.Command, _ []string) error {
+	timeout, err := cmd.Flags().GetDuration("timeout")
+	if err != nil {
+		return err
+	}
+
+	cfg, err := c.loadConfig()
+	if err != nil {
+		return err
+	}
+
+	if cfg.Encryption == nil {
+		return encryptionNotEnabledErr
+	}
+
+	key, err := unlock(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Replace any running agent, so that its timeout is reset
+	secret.StopAgent(cfg.GetAgentSocket())
+
+	if err := startAgent(cfg, key, timeout); err != nil {
+		return err
+	}
+
+	if timeout > 0 {
+		output.Printf(c.Config.EnableColor, "Unlocked the configuration file for %s.\n", timeout)
+	} else {
+		output.Println(c.Config.EnableColor, "Unlocked the configuration file until it is locked.")
+	}
+	return nil
+}
+
+// startAgent runs the hidden agent command in the background, and waits for it to serve the key.
+func startAgent(cfg *config.Config, key []byte, timeout time.Duration) error {
+	executable, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	agent := exec.Command(executable, "configuration", "encryption", "agent", "--timeout", timeout.String())
+	stdin, err := agent.StdinPipe()
+	if err != nil {
+		return err
+	}
+	if err := agent.Start(); err != nil {
+		return fmt.Errorf("failed to start agent: %w", err)
+	}
+
+	if _, err := fmt.Fprintln(stdin, base64.StdEncoding.EncodeToString(key)); err != nil {
+		return err
+	}
+	if err := stdin.Close(); err != nil {
+		return err
+	}
+	if err := agent.Process.Release(); err != nil {
+		return err
+	}
+
+	for start := time.Now(); time.Since(start) < agentStartTimeout; time.Sleep(100 * time.Millisecond) {
+		if _, err := secret.GetKeyFromAgent(cfg.GetAgentSocket()); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("timed out waiting for agent to start")
+}
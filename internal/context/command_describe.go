@@ -11,16 +11,27 @@ import (
 )
 
 type out struct {
-	Name       string `human:"Name" serialized:"name"`
-	Platform   string `human:"Platform" serialized:"platform"`
-	Credential string `human:"Credential" serialized:"credential"`
+	Name                   string `human:"Name" serialized:"name"`
+	Platform               string `human:"Platform" serialized:"platform"`
+	Credential             string `human:"Credential" serialized:"credential"`
+	NameSource             string `human:"Name Source" serialized:"name_source"`
+	Environment            string `human:"Environment" serialized:"environment"`
+	EnvironmentSource      string `human:"Environment Source" serialized:"environment_source"`
+	KafkaCluster           string `human:"Kafka Cluster" serialized:"kafka_cluster"`
+	KafkaClusterSource     string `human:"Kafka Cluster Source" serialized:"kafka_cluster_source"`
+	FlinkComputePool       string `human:"Flink Compute Pool" serialized:"flink_compute_pool"`
+	FlinkComputePoolSource string `human:"Flink Compute Pool Source" serialized:"flink_compute_pool_source"`
+	Output                 string `human:"Output" serialized:"output"`
+	OutputSource           string `human:"Output Source" serialized:"output_source"`
 }
 
+const globalConfigSource = "global config"
+
 func (c *command) newDescribeCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:               "describe [context]",
 		Short:             "Describe a context.",
-		Long:              "Describe a context or a specific context field.",
+		Long:              fmt.Sprintf(`Describe a context or a specific context field. If a project configuration file ("%s") is found in the working directory or one of its parents, also describe the environment, Kafka cluster, Flink compute pool, and output format it may pin, and where each value came from.`, config.ProjectConfigFilename),
 		ValidArgsFunction: pcmd.NewValidArgsFunction(c.validArgs),
 		RunE:              c.describe,
 	}
@@ -64,6 +75,10 @@ func (c *command) describe(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	if c.Config.Project != nil {
+		return describeContextWithSources(cmd, ctx, c.Config.Project, len(args) == 0)
+	}
+
 	return describeContext(cmd, ctx)
 }
 
@@ -74,5 +89,59 @@ func describeContext(cmd *cobra.Command, ctx *config.Context) error {
 		Platform:   ctx.PlatformName,
 		Credential: ctx.CredentialName,
 	})
+	table.Filter([]string{"Name", "Platform", "Credential"})
+	return table.Print()
+}
+
+// describeContextWithSources describes a context along with the values which a project configuration file may pin, and
+// whether each value came from the project or the global configuration.
+func describeContextWithSources(cmd *cobra.Command, ctx *config.Context, project *config.ProjectConfig, isCurrent bool) error {
+	// Values pinned by the project configuration only apply to the context in use
+	pinned := isCurrent && ctx.GetCredentialType() != config.APIKey
+	if pinned {
+		if err := ctx.ParseFlagsIntoContext(cmd); err != nil {
+			return err
+		}
+	}
+
+	getSource := func(value string) string {
+		if pinned && value != "" {
+			return project.GetSource()
+		}
+		return globalConfigSource
+	}
+
+	var err error
+	o := &out{
+		Name:                   ctx.Name,
+		Platform:               ctx.PlatformName,
+		Credential:             ctx.CredentialName,
+		NameSource:             globalConfigSource,
+		Environment:            ctx.GetCurrentEnvironment(),
+		EnvironmentSource:      getSource(project.Environment),
+		KafkaCluster:           ctx.KafkaClusterContext.GetActiveKafkaClusterId(),
+		KafkaClusterSource:     getSource(project.KafkaCluster),
+		FlinkComputePool:       ctx.GetCurrentFlinkComputePool(),
+		FlinkComputePoolSource: getSource(project.FlinkComputePool),
+		OutputSource:           "default",
+	}
+
+	if !isCurrent {
+		o.NameSource = "argument"
+	} else if project.Context != "" {
+		o.NameSource = project.GetSource()
+	}
+
+	if o.Output, err = cmd.Flags().GetString(output.FlagName); err != nil {
+		return err
+	}
+	if cmd.Flags().Changed(output.FlagName) {
+		o.OutputSource = "flag"
+	} else if project.Output != "" || project.Flags[output.FlagName] != "" {
+		o.OutputSource = project.GetSource()
+	}
+
+	table := output.NewTable(cmd)
+	table.Add(o)
 	return table.Print()
 }
@@ -0,0 +1,82 @@
+package context
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+func (c *command) newExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "export [context-1] [context-2] ... [context-n]",
+		Short:             "Export one or more contexts to a file.",
+		Long:              "Export one or more contexts, or the current context if none are specified, along with their platforms, credentials, environments, and Kafka clusters, to a file which can be imported on another machine with `confluent context import`. Login state is never exported. API secrets are stripped, unless they are encrypted to the age X25519 recipient of whoever imports the file. Encrypted secrets are stored as an ASCII-armored age file in the \"secrets\" field, which can also be decrypted with `age --decrypt`.",
+		ValidArgsFunction: pcmd.NewValidArgsFunction(c.validArgsMultiple),
+		RunE:              c.export,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Export the "dev" and "prod" contexts without secrets.`,
+				Code: "confluent context export dev prod --file contexts.json",
+			},
+			examples.Example{
+				Text: "Export the current context, including API secrets encrypted to a teammate's age public key.",
+				Code: "confluent context export --file contexts.json --recipient age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p",
+			},
+		),
+	}
+
+	cmd.Flags().String("file", "", "Output filename. By default, the export is printed to stdout.")
+	cmd.Flags().String("recipient", "", "Include API secrets, encrypted to this age X25519 recipient.")
+	cobra.CheckErr(cmd.MarkFlagFilename("file", "json"))
+
+	return cmd
+}
+
+func (c *command) export(cmd *cobra.Command, args []string) error {
+	file, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	recipient, err := cmd.Flags().GetString("recipient")
+	if err != nil {
+		return err
+	}
+
+	names := args
+	if len(names) == 0 {
+		ctx, err := c.context(nil)
+		if err != nil {
+			return err
+		}
+		names = []string{ctx.Name}
+	}
+
+	export, err := c.Config.ExportContexts(names, recipient)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if file == "" {
+		output.Println(false, string(data))
+		return nil
+	}
+
+	if err := os.WriteFile(file, append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("failed to write context export: %w", err)
+	}
+
+	output.Printf(c.Config.EnableColor, "Exported %d context(s) to \"%s\".\n", len(export.Contexts), file)
+	return nil
+}
@@ -0,0 +1,111 @@
+package context
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"filippo.io/age"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+	"github.com/confluentinc/cli/v3/pkg/secret"
+)
+
+type importOut struct {
+	Name          string `human:"Name" serialized:"name"`
+	ImportedAs    string `human:"Imported As" serialized:"imported_as"`
+	Action        string `human:"Action" serialized:"action"`
+	KafkaClusters int    `human:"Kafka Clusters" serialized:"kafka_clusters"`
+}
+
+func (c *command) newImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import contexts from a file.",
+		Long: "Import the contexts in a file created by `confluent context export`. " +
+			"If a context with the same name already exists, its missing environments and Kafka clusters are merged in by default, without changing its existing settings. " +
+			"Imported contexts hold no login state, so a context which logs in with a username and password requires logging in again.",
+		Args: cobra.ExactArgs(1),
+		RunE: c.importContexts,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Import contexts, decrypting their API secrets with an age identity.",
+				Code: "confluent context import contexts.json --key-file ~/.confluent/key.txt",
+			},
+			examples.Example{
+				Text: "Import contexts, keeping existing contexts with the same names and importing the new ones under new names.",
+				Code: "confluent context import contexts.json --conflict rename",
+			},
+		),
+	}
+
+	cmd.Flags().String("conflict", config.ImportConflictMerge, fmt.Sprintf("How to import a context whose name is already taken (%s).", strings.Join(config.ImportConflictStrategies, ", ")))
+	cmd.Flags().String("key-file", "", "Path to a file containing the age X25519 identity that API secrets were encrypted to.")
+	pcmd.AddOutputFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagFilename("key-file"))
+
+	pcmd.RegisterFlagCompletionFunc(cmd, "conflict", func(_ *cobra.Command, _ []string) []string { return config.ImportConflictStrategies })
+
+	return cmd
+}
+
+func (c *command) importContexts(cmd *cobra.Command, args []string) error {
+	conflict, err := cmd.Flags().GetString("conflict")
+	if err != nil {
+		return err
+	}
+
+	keyFile, err := cmd.Flags().GetString("key-file")
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to read context export: %w", err)
+	}
+
+	export := new(config.ContextExport)
+	if err := json.Unmarshal(data, export); err != nil {
+		return fmt.Errorf("failed to parse context export: %w", err)
+	}
+
+	var identity *age.X25519Identity
+	if keyFile != "" {
+		data, err := os.ReadFile(keyFile)
+		if err != nil {
+			return fmt.Errorf("failed to read key file: %w", err)
+		}
+		identity, err = secret.ParseIdentity(data)
+		if err != nil {
+			return fmt.Errorf(`invalid key file "%s": %w`, keyFile, err)
+		}
+	}
+
+	imported, err := c.Config.ImportContexts(export, identity, conflict)
+	if err != nil {
+		return err
+	}
+
+	if identity == nil && export.Secrets != "" {
+		output.ErrPrintln(c.Config.EnableColor, "[WARN] The API secrets in the file were not imported, since the `--key-file` flag was not passed.")
+	}
+
+	list := output.NewList(cmd)
+	for _, context := range imported {
+		list.Add(&importOut{
+			Name:          context.Name,
+			ImportedAs:    context.ImportedAs,
+			Action:        context.Action,
+			KafkaClusters: context.KafkaClusters,
+		})
+	}
+	return list.Print()
+}
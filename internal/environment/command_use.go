@@ -33,8 +33,7 @@ func (c *command) use(_ *cobra.Command, args []string) error {
 		return errors.NewErrorWithSuggestions(err.Error(), fmt.Sprintf(errors.ListResourceSuggestions, resource.Environment, "confluent environment"))
 	}
 
-	c.Context.SetCurrentEnvironment(id)
-	if err := c.Config.Save(); err != nil {
+	if err := c.Config.UseEnvironment(id); err != nil {
 		return err
 	}
 
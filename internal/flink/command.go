@@ -2,12 +2,19 @@ package flink
 
 import (
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/confluentinc/cli/v3/pkg/ccloudv2"
 	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
 	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/flink/test/mock"
+	"github.com/confluentinc/cli/v3/pkg/flink/types"
+	"github.com/confluentinc/cli/v3/pkg/log"
+	"github.com/confluentinc/cli/v3/pkg/properties"
 )
 
 const serviceAccountWarning = "[WARN] No service account provided. To ensure that your statements run continuously, " +
@@ -26,15 +33,47 @@ func New(cfg *config.Config, prerunner pcmd.PreRunner) *cobra.Command {
 	}
 
 	c := &command{pcmd.NewAuthenticatedCLICommand(cmd, prerunner)}
+	cmd.PersistentPreRunE = c.persistentPreRunE(prerunner)
 
 	cmd.AddCommand(c.newComputePoolCommand())
+	cmd.AddCommand(c.newHistoryCommand(prerunner))
 	cmd.AddCommand(c.newRegionCommand())
 	cmd.AddCommand(c.newShellCommand(prerunner))
 	cmd.AddCommand(c.newStatementCommand())
+	cmd.AddCommand(newSqlCommand(prerunner))
 
 	return cmd
 }
 
+// persistentPreRunE authenticates, unless a recorded session is replayed with "--replay", which doesn't call the gateway
+// and so works without a login.
+func (c *command) persistentPreRunE(prerunner pcmd.PreRunner) func(*cobra.Command, []string) error {
+	authenticated := c.PersistentPreRunE
+	// The login requirement of Flink commands doesn't apply to a replay, so it isn't checked
+	anonymous := prerunner.Anonymous(c.CLICommand, true)
+
+	return func(cmd *cobra.Command, args []string) error {
+		if !isReplay(cmd) {
+			return authenticated(cmd, args)
+		}
+
+		if err := anonymous(cmd, args); err != nil {
+			return err
+		}
+
+		c.Context = c.Config.Context()
+		if c.Context == nil {
+			c.Context = &config.Context{
+				Environments: map[string]*config.EnvironmentContext{},
+				State:        new(config.ContextState),
+				Config:       c.Config,
+			}
+			c.Context.KafkaClusterContext = config.NewKafkaClusterContext(c.Context, "", nil)
+		}
+		return c.Context.ParseFlagsIntoContext(cmd)
+	}
+}
+
 func (c *command) addComputePoolFlag(cmd *cobra.Command) {
 	cmd.Flags().String("compute-pool", "", "Flink compute pool ID.")
 	pcmd.RegisterFlagCompletionFunc(cmd, "compute-pool", c.autocompleteComputePools)
@@ -89,6 +128,116 @@ func (c *command) autocompleteDatabases(cmd *cobra.Command, args []string) []str
 	return suggestions
 }
 
+func addVariableFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice("var", nil, `A comma-separated list of client-side variables ("key=value") which can be referenced as "${key}" in statements.`)
+	cmd.Flags().String("var-file", "", `A file of newline-separated client-side variables ("key=value"). Variables passed with "--var" take precedence.`)
+	cobra.CheckErr(cmd.MarkFlagFilename("var-file"))
+}
+
+func getVariables(cmd *cobra.Command) (map[string]string, error) {
+	variables := map[string]string{}
+
+	varFile, err := cmd.Flags().GetString("var-file")
+	if err != nil {
+		return nil, err
+	}
+	if varFile != "" {
+		fileVariables, err := properties.FileToMap(varFile)
+		if err != nil {
+			return nil, err
+		}
+		for name, value := range fileVariables {
+			variables[strings.TrimSpace(name)] = strings.TrimSpace(value)
+		}
+	}
+
+	vars, err := cmd.Flags().GetStringSlice("var")
+	if err != nil {
+		return nil, err
+	}
+	flagVariables, err := properties.ConfigFlagToMap(vars)
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range flagVariables {
+		variables[name] = value
+	}
+
+	return variables, nil
+}
+
+// substituteVariables replaces the variable references of the SQL with the variables passed with "--var" and "--var-file"
+func substituteVariables(cmd *cobra.Command, sql string) (string, error) {
+	variables, err := getVariables(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	sql, statementErr := types.SubstituteVariables(sql, variables)
+	if statementErr != nil {
+		return "", errors.NewErrorWithSuggestions(statementErr.Message, `Define variables with "--var" or "--var-file".`)
+	}
+	return sql, nil
+}
+
+func addRecordingFlags(cmd *cobra.Command) {
+	cmd.Flags().String("record", "", "Record the responses of the Flink gateway to this file.")
+	cmd.Flags().String("replay", "", `Serve the responses of the Flink gateway from a file written with "--record" instead of calling the gateway.`)
+	cmd.MarkFlagsMutuallyExclusive("record", "replay")
+	cobra.CheckErr(cmd.MarkFlagFilename("record", "jsonl"))
+	cobra.CheckErr(cmd.MarkFlagFilename("replay", "jsonl"))
+}
+
+func isReplay(cmd *cobra.Command) bool {
+	replay, _ := cmd.Flags().GetString("replay")
+	return replay != ""
+}
+
+// getEnvironmentId returns the current environment. A replay serves the recorded responses for any environment, so
+// it doesn't require one.
+func (c *command) getEnvironmentId(cmd *cobra.Command) (string, error) {
+	if isReplay(cmd) {
+		return c.Context.GetCurrentEnvironment(), nil
+	}
+	return c.Context.EnvironmentId()
+}
+
+// getGatewayClient returns the Flink gateway client, which records or replays its responses if "--record" or
+// "--replay" is passed. Replaying doesn't connect to the gateway. The client must be closed with closeGatewayClient.
+func (c *command) getGatewayClient(cmd *cobra.Command, computePoolOnly bool) (ccloudv2.GatewayClientInterface, error) {
+	replay, err := cmd.Flags().GetString("replay")
+	if err != nil {
+		return nil, err
+	}
+	if replay != "" {
+		return mock.NewReplayFlinkGatewayClient(replay)
+	}
+
+	client, err := c.GetFlinkGatewayClient(computePoolOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := cmd.Flags().GetString("record")
+	if err != nil {
+		return nil, err
+	}
+	if record != "" {
+		return mock.NewRecordingFlinkGatewayClient(client, record)
+	}
+
+	return client, nil
+}
+
+// closeGatewayClient closes the recording file of a gateway client which records its responses
+func closeGatewayClient(client ccloudv2.GatewayClientInterface) {
+	if closer, ok := client.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			log.CliLogger.Warn(err)
+		}
+	}
+}
+
 func (c *command) addRegionFlag(cmd *cobra.Command) {
 	cmd.Flags().String("region", "", `Cloud region for compute pool (use "confluent flink region list" to see all).`)
 	pcmd.RegisterFlagCompletionFunc(cmd, "region", c.autocompleteRegions)
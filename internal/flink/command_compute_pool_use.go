@@ -30,10 +30,7 @@ func (c *command) computePoolUse(_ *cobra.Command, args []string) error {
 		return err
 	}
 
-	if err := c.Context.SetCurrentFlinkComputePool(args[0]); err != nil {
-		return err
-	}
-	if err := c.Config.Save(); err != nil {
+	if err := c.Config.UseFlinkComputePool(args[0]); err != nil {
 		return err
 	}
 
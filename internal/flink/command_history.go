@@ -0,0 +1,69 @@
+package flink
+
+import (
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/config"
+	client "github.com/confluentinc/cli/v3/pkg/flink/app"
+)
+
+type historyEntryOut struct {
+	Index         int    `human:"Index" serialized:"index"`
+	Timestamp     string `human:"Timestamp" serialized:"timestamp,omitempty"`
+	StatementName string `human:"Statement Name" serialized:"statement_name,omitempty"`
+	Status        string `human:"Status" serialized:"status,omitempty"`
+	ComputePool   string `human:"Compute Pool" serialized:"compute_pool,omitempty"`
+	Environment   string `human:"Environment" serialized:"environment,omitempty"`
+	Catalog       string `human:"Catalog" serialized:"catalog,omitempty"`
+	Database      string `human:"Database" serialized:"database,omitempty"`
+	Statement     string `human:"Statement" serialized:"statement"`
+}
+
+func (c *command) newHistoryCommand(prerunner pcmd.PreRunner) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Manage the history of Flink SQL statements.",
+		Long:  "Manage the history of statements run in the Flink interactive SQL client. The history is stored separately for each context.",
+	}
+
+	cmd.AddCommand(newHistoryListCommand(prerunner))
+	cmd.AddCommand(c.newHistoryRerunCommand())
+
+	return cmd
+}
+
+func loadHistory(context *config.Context) *client.History {
+	var contextName string
+	if context != nil {
+		contextName = context.Name
+	}
+
+	history := client.LoadHistory(contextName)
+	if history == nil {
+		return &client.History{}
+	}
+	return history
+}
+
+func newHistoryEntryOut(index int, entry client.HistoryEntry) *historyEntryOut {
+	var timestamp string
+	if !entry.Timestamp.IsZero() {
+		timestamp = entry.Timestamp.Format(time.RFC3339)
+	}
+
+	return &historyEntryOut{
+		Index:         index,
+		Timestamp:     timestamp,
+		StatementName: entry.StatementName,
+		Status:        entry.Status,
+		ComputePool:   entry.ComputePool,
+		Environment:   entry.Environment,
+		Catalog:       entry.Catalog,
+		Database:      entry.Database,
+		Statement:     strings.Join(strings.Fields(entry.Statement), " "),
+	}
+}
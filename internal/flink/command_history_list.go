@@ -0,0 +1,53 @@
+package flink
+
+import (
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+type historyListCommand struct {
+	*pcmd.CLICommand
+}
+
+func newHistoryListCommand(prerunner pcmd.PreRunner) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "list",
+		Short:       "List past Flink SQL statements.",
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireNoLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `List past statements which contain "orders" in their SQL, statement name, status, compute pool, environment, catalog, or database.`,
+				Code: "confluent flink history list --search orders",
+			},
+		),
+	}
+
+	c := &historyListCommand{pcmd.NewAnonymousCLICommand(cmd, prerunner)}
+	cmd.RunE = c.list
+
+	cmd.Flags().String("search", "", "Only list statements which contain this text, ignoring case.")
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *historyListCommand) list(cmd *cobra.Command, _ []string) error {
+	search, err := cmd.Flags().GetString("search")
+	if err != nil {
+		return err
+	}
+
+	history := loadHistory(c.Config.Context())
+
+	list := output.NewList(cmd)
+	for _, index := range history.Search(search) {
+		list.Add(newHistoryEntryOut(index, history.Entries[index-1]))
+	}
+	list.Sort(false)
+	return list.Print()
+}
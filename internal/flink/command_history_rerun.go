@@ -0,0 +1,74 @@
+package flink
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/flink/types"
+)
+
+func (c *command) newHistoryRerunCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rerun <index|statement-name>",
+		Short: "Run a past Flink SQL statement again.",
+		Long:  "Run a past Flink SQL statement again as a new statement. The statement is referenced by its index in the history or by its statement name.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  c.historyRerun,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Run the third statement of the history again.",
+				Code: "confluent flink history rerun 3",
+			},
+			examples.Example{
+				Text: `Run the third statement of the history again, with the variable "env" set to "prod".`,
+				Code: "confluent flink history rerun 3 --var env=prod",
+			},
+			examples.Example{
+				Text: `Run the statement "my-statement" again as "my-statement-2".`,
+				Code: "confluent flink history rerun my-statement --name my-statement-2",
+			},
+		),
+	}
+
+	cmd.Flags().String("name", "", "Name of the new statement. By default, a name is generated.")
+	c.addComputePoolFlag(cmd)
+	pcmd.AddServiceAccountFlag(cmd, c.AuthenticatedCLICommand)
+	c.addDatabaseFlag(cmd)
+	addVariableFlags(cmd)
+	cmd.Flags().Bool("wait", false, "Block until the statement is running or has failed.")
+	addRecordingFlags(cmd)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *command) historyRerun(cmd *cobra.Command, args []string) error {
+	entry, ok := loadHistory(c.Context).Get(args[0])
+	if !ok {
+		return errors.NewErrorWithSuggestions(
+			fmt.Sprintf(`no statement "%s" found in the history`, args[0]),
+			"List past statements with `confluent flink history list`.",
+		)
+	}
+
+	name, err := cmd.Flags().GetString("name")
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		name = types.GenerateStatementName()
+	}
+
+	sql, err := substituteVariables(cmd, entry.Statement)
+	if err != nil {
+		return err
+	}
+
+	return c.createStatement(cmd, name, sql)
+}
@@ -8,6 +8,7 @@ import (
 	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
 	"github.com/confluentinc/cli/v3/pkg/config"
 	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
 	client "github.com/confluentinc/cli/v3/pkg/flink/app"
 	"github.com/confluentinc/cli/v3/pkg/flink/test/mock"
 	"github.com/confluentinc/cli/v3/pkg/flink/types"
@@ -25,11 +26,23 @@ func (c *command) newShellCommand(prerunner pcmd.PreRunner) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return c.startFlinkSqlClient(prerunner, cmd)
 		},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Record the responses of the Flink gateway during a session to "session.jsonl".`,
+				Code: "confluent flink shell --record session.jsonl",
+			},
+			examples.Example{
+				Text: `Replay the session recorded in "session.jsonl" without calling the Flink gateway.`,
+				Code: "confluent flink shell --replay session.jsonl",
+			},
+		),
 	}
 
 	c.addComputePoolFlag(cmd)
 	pcmd.AddServiceAccountFlag(cmd, c.AuthenticatedCLICommand)
 	c.addDatabaseFlag(cmd)
+	addVariableFlags(cmd)
+	addRecordingFlags(cmd)
 	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
 	pcmd.AddContextFlag(cmd, c.CLICommand)
 
@@ -83,7 +96,7 @@ func (c *command) startFlinkSqlClient(prerunner pcmd.PreRunner, cmd *cobra.Comma
 		return err
 	}
 	if environmentId == "" {
-		if c.Context.GetCurrentEnvironment() == "" {
+		if c.Context.GetCurrentEnvironment() == "" && !isReplay(cmd) {
 			return errors.NewErrorWithSuggestions(
 				"no environment provided",
 				"Provide an environment with `confluent environment use env-123456` or `--environment`.",
@@ -93,7 +106,9 @@ func (c *command) startFlinkSqlClient(prerunner pcmd.PreRunner, cmd *cobra.Comma
 	}
 
 	catalog := c.Context.GetCurrentFlinkCatalog()
-	if catalog == "" {
+	if catalog == "" && isReplay(cmd) {
+		catalog = environmentId
+	} else if catalog == "" {
 		environment, err := c.V2Client.GetOrgEnvironment(environmentId)
 		if err != nil {
 			return errors.NewErrorWithSuggestions(err.Error(), "List available environments with `confluent environment list`.")
@@ -102,7 +117,7 @@ func (c *command) startFlinkSqlClient(prerunner pcmd.PreRunner, cmd *cobra.Comma
 	}
 
 	computePool := c.Context.GetCurrentFlinkComputePool()
-	if computePool == "" {
+	if computePool == "" && !isReplay(cmd) {
 		return errors.NewErrorWithSuggestions(
 			"no compute pool selected",
 			"Select a compute pool with `confluent flink compute-pool use` or `--compute-pool`.",
@@ -132,17 +147,26 @@ func (c *command) startFlinkSqlClient(prerunner pcmd.PreRunner, cmd *cobra.Comma
 		}
 	}
 
+	variables, err := getVariables(cmd)
+	if err != nil {
+		return err
+	}
+
 	unsafeTrace, err := c.Command.Flags().GetBool("unsafe-trace")
 	if err != nil {
 		return err
 	}
 
-	flinkGatewayClient, err := c.GetFlinkGatewayClient(true)
+	flinkGatewayClient, err := c.getGatewayClient(cmd, true)
 	if err != nil {
 		return err
 	}
+	defer closeGatewayClient(flinkGatewayClient)
 
-	jwtValidator := pcmd.NewJWTValidator()
+	tokenRefreshFunc := c.authenticated(prerunner.Authenticated(c.AuthenticatedCLICommand), cmd, pcmd.NewJWTValidator())
+	if isReplay(cmd) {
+		tokenRefreshFunc = func() error { return nil }
+	}
 
 	verbose, _ := cmd.Flags().GetCount("verbose")
 
@@ -157,9 +181,10 @@ func (c *command) startFlinkSqlClient(prerunner pcmd.PreRunner, cmd *cobra.Comma
 		ComputePoolId:    computePool,
 		ServiceAccountId: serviceAccount,
 		Verbose:          verbose > 0,
+		Variables:        variables,
 	}
 
-	client.StartApp(flinkGatewayClient, c.authenticated(prerunner.Authenticated(c.AuthenticatedCLICommand), cmd, jwtValidator), opts, reportUsage(cmd, c.Config, unsafeTrace))
+	client.StartApp(flinkGatewayClient, tokenRefreshFunc, opts, reportUsage(cmd, c.Config, unsafeTrace))
 	return nil
 }
 
@@ -0,0 +1,27 @@
+package flink
+
+import (
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+)
+
+type sqlCommand struct {
+	*pcmd.CLICommand
+}
+
+func newSqlCommand(prerunner pcmd.PreRunner) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "sql",
+		Short:       "Format and lint Flink SQL files.",
+		Long:        "Format and lint Flink SQL files. These commands run locally without a login, so they can be used in pre-commit hooks.",
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireNoLogin},
+	}
+
+	c := &sqlCommand{pcmd.NewAnonymousCLICommand(cmd, prerunner)}
+
+	cmd.AddCommand(c.newFormatCommand())
+	cmd.AddCommand(c.newLintCommand())
+
+	return cmd
+}
@@ -0,0 +1,92 @@
+package flink
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	client "github.com/confluentinc/cli/v3/pkg/flink/app"
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+func (c *sqlCommand) newFormatCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "format <file-1> [file-2] ... [file-n]",
+		Short: "Format Flink SQL files.",
+		Long:  "Format Flink SQL files. Reserved keywords are upper-cased and every clause of a query starts on a new line. By default, the formatted SQL is printed.",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  c.format,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Format "query.sql" in place.`,
+				Code: "confluent flink sql format query.sql --write",
+			},
+			examples.Example{
+				Text: "Check that all SQL files in the current directory are formatted, for example in a pre-commit hook.",
+				Code: "confluent flink sql format *.sql --check",
+			},
+		),
+	}
+
+	cmd.Flags().Bool("write", false, "Overwrite the files with the formatted SQL.")
+	cmd.Flags().Bool("check", false, "Exit with an error if any of the files is not formatted, without changing them.")
+
+	cmd.MarkFlagsMutuallyExclusive("write", "check")
+
+	return cmd
+}
+
+func (c *sqlCommand) format(cmd *cobra.Command, args []string) error {
+	write, err := cmd.Flags().GetBool("write")
+	if err != nil {
+		return err
+	}
+
+	check, err := cmd.Flags().GetBool("check")
+	if err != nil {
+		return err
+	}
+
+	var unformatted []string
+	for _, file := range args {
+		sql, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		formatted, err := client.FormatStatements(string(sql))
+		if err != nil {
+			return fmt.Errorf("failed to format %s: %w", file, err)
+		}
+
+		switch {
+		case check:
+			if formatted != string(sql) {
+				output.Println(c.Config.EnableColor, file)
+				unformatted = append(unformatted, file)
+			}
+		case write:
+			if formatted == string(sql) {
+				continue
+			}
+			if err := os.WriteFile(file, []byte(formatted), 0644); err != nil {
+				return err
+			}
+			output.Printf(c.Config.EnableColor, "Formatted %s.\n", file)
+		default:
+			output.Print(c.Config.EnableColor, formatted)
+		}
+	}
+
+	if len(unformatted) > 0 {
+		return errors.NewErrorWithSuggestions(
+			fmt.Sprintf("%d file(s) are not formatted", len(unformatted)),
+			"Format the files with `confluent flink sql format --write`.",
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package flink
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	client "github.com/confluentinc/cli/v3/pkg/flink/app"
+	"github.com/confluentinc/cli/v3/pkg/flink/types"
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+type lintIssueOut struct {
+	File     string `human:"File" serialized:"file"`
+	Line     int    `human:"Line" serialized:"line"`
+	Column   int    `human:"Column" serialized:"column"`
+	Severity string `human:"Severity" serialized:"severity"`
+	Rule     string `human:"Rule" serialized:"rule"`
+	Message  string `human:"Message" serialized:"message"`
+}
+
+func (c *sqlCommand) newLintCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lint <file-1> [file-2] ... [file-n]",
+		Short: "Lint Flink SQL files.",
+		Long:  "Check Flink SQL files for unterminated quotes, missing statement terminators, SELECT * in INSERT statements, and unknown SET keys.",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  c.lint,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Lint all SQL files in the current directory.",
+				Code: "confluent flink sql lint *.sql",
+			},
+		),
+	}
+
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *sqlCommand) lint(cmd *cobra.Command, args []string) error {
+	list := output.NewList(cmd)
+	list.Sort(false)
+
+	errorCount := 0
+	for _, file := range args {
+		sql, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		for _, issue := range client.LintStatements(string(sql)) {
+			if issue.Severity == types.LintError {
+				errorCount++
+			}
+			list.Add(&lintIssueOut{
+				File:     file,
+				Line:     issue.Line,
+				Column:   issue.Column,
+				Severity: string(issue.Severity),
+				Rule:     issue.Rule,
+				Message:  issue.Message,
+			})
+		}
+	}
+
+	if err := list.Print(); err != nil {
+		return err
+	}
+
+	if errorCount > 0 {
+		return fmt.Errorf("found %d error(s)", errorCount)
+	}
+	return nil
+}
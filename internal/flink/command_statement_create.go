@@ -32,6 +32,10 @@ func (c *command) newStatementCreateCommand() *cobra.Command {
 				Text: `Create a Flink SQL statement named "my-statement" in compute pool "lfcp-123456" with service account "sa-123456" and using Kafka cluster "my-cluster" as the default database.`,
 				Code: `confluent flink statement create my-statement --sql "SELECT * FROM my-topic;" --compute-pool lfcp-123456 --service-account sa-123456 --database my-cluster`,
 			},
+			examples.Example{
+				Text: `Create a Flink SQL statement which reads from the topic "prod_orders" by substituting the variable "env".`,
+				Code: `confluent flink statement create --sql 'SELECT * FROM ${env}_orders;' --var env=prod`,
+			},
 		),
 	}
 
@@ -39,7 +43,9 @@ func (c *command) newStatementCreateCommand() *cobra.Command {
 	c.addComputePoolFlag(cmd)
 	pcmd.AddServiceAccountFlag(cmd, c.AuthenticatedCLICommand)
 	c.addDatabaseFlag(cmd)
+	addVariableFlags(cmd)
 	cmd.Flags().Bool("wait", false, "Block until the statement is running or has failed.")
+	addRecordingFlags(cmd)
 	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
 	pcmd.AddContextFlag(cmd, c.CLICommand)
 	pcmd.AddOutputFlag(cmd)
@@ -50,40 +56,55 @@ func (c *command) newStatementCreateCommand() *cobra.Command {
 }
 
 func (c *command) statementCreate(cmd *cobra.Command, args []string) error {
-	environmentId, err := c.Context.EnvironmentId()
+	name := types.GenerateStatementName()
+	if len(args) == 1 {
+		name = args[0]
+	}
+
+	sql, err := cmd.Flags().GetString("sql")
 	if err != nil {
 		return err
 	}
 
-	environment, err := c.V2Client.GetOrgEnvironment(environmentId)
+	sql, err = substituteVariables(cmd, sql)
 	if err != nil {
-		return errors.NewErrorWithSuggestions(err.Error(), "List available environments with `confluent environment list`.")
+		return err
+	}
+
+	return c.createStatement(cmd, name, sql)
+}
+
+// createStatement submits the SQL as a statement to the current compute pool and prints the created statement
+func (c *command) createStatement(cmd *cobra.Command, name, sql string) error {
+	environmentId, err := c.getEnvironmentId(cmd)
+	if err != nil {
+		return err
+	}
+
+	// The catalog isn't looked up when replaying, since a replay doesn't need cloud access
+	catalog := environmentId
+	if !isReplay(cmd) {
+		environment, err := c.V2Client.GetOrgEnvironment(environmentId)
+		if err != nil {
+			return errors.NewErrorWithSuggestions(err.Error(), "List available environments with `confluent environment list`.")
+		}
+		catalog = environment.GetDisplayName()
 	}
 
 	computePool := c.Context.GetCurrentFlinkComputePool()
-	if computePool == "" {
+	if computePool == "" && !isReplay(cmd) {
 		return errors.NewErrorWithSuggestions(
 			"no compute pool selected",
 			"Select a compute pool with `confluent flink compute-pool use` or `--compute-pool`.",
 		)
 	}
 
-	name := types.GenerateStatementName()
-	if len(args) == 1 {
-		name = args[0]
-	}
-
-	sql, err := cmd.Flags().GetString("sql")
-	if err != nil {
-		return err
-	}
-
 	database, err := cmd.Flags().GetString("database")
 	if err != nil {
 		return err
 	}
 
-	properties := map[string]string{config.KeyCatalog: environment.GetDisplayName()}
+	properties := map[string]string{config.KeyCatalog: catalog}
 	if database != "" {
 		properties[config.KeyDatabase] = database
 	}
@@ -97,10 +118,11 @@ func (c *command) statementCreate(cmd *cobra.Command, args []string) error {
 		},
 	}
 
-	client, err := c.GetFlinkGatewayClient(true)
+	client, err := c.getGatewayClient(cmd, true)
 	if err != nil {
 		return err
 	}
+	defer closeGatewayClient(client)
 
 	serviceAccount, err := cmd.Flags().GetString("service-account")
 	if err != nil {
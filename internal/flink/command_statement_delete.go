@@ -20,6 +20,7 @@ func (c *command) newStatementDeleteCommand() *cobra.Command {
 	pcmd.AddCloudFlag(cmd)
 	c.addRegionFlag(cmd)
 	pcmd.AddForceFlag(cmd)
+	addRecordingFlags(cmd)
 	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
 	pcmd.AddContextFlag(cmd, c.CLICommand)
 
@@ -27,15 +28,16 @@ func (c *command) newStatementDeleteCommand() *cobra.Command {
 }
 
 func (c *command) statementDelete(cmd *cobra.Command, args []string) error {
-	environmentId, err := c.Context.EnvironmentId()
+	environmentId, err := c.getEnvironmentId(cmd)
 	if err != nil {
 		return err
 	}
 
-	client, err := c.GetFlinkGatewayClient(false)
+	client, err := c.getGatewayClient(cmd, false)
 	if err != nil {
 		return err
 	}
+	defer closeGatewayClient(client)
 
 	existenceFunc := func(id string) bool {
 		_, err := client.GetStatement(environmentId, id, c.Context.GetCurrentOrganization())
@@ -18,6 +18,7 @@ func (c *command) newStatementDescribeCommand() *cobra.Command {
 
 	pcmd.AddCloudFlag(cmd)
 	c.addRegionFlag(cmd)
+	addRecordingFlags(cmd)
 	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
 	pcmd.AddContextFlag(cmd, c.CLICommand)
 	pcmd.AddOutputFlag(cmd)
@@ -26,15 +27,16 @@ func (c *command) newStatementDescribeCommand() *cobra.Command {
 }
 
 func (c *command) statementDescribe(cmd *cobra.Command, args []string) error {
-	environmentId, err := c.Context.EnvironmentId()
+	environmentId, err := c.getEnvironmentId(cmd)
 	if err != nil {
 		return err
 	}
 
-	client, err := c.GetFlinkGatewayClient(false)
+	client, err := c.getGatewayClient(cmd, false)
 	if err != nil {
 		return err
 	}
+	defer closeGatewayClient(client)
 
 	statement, err := client.GetStatement(environmentId, args[0], c.Context.GetCurrentOrganization())
 	if err != nil {
@@ -40,6 +40,7 @@ func (c *command) newStatementListCommand() *cobra.Command {
 	pcmd.AddCloudFlag(cmd)
 	c.addRegionFlag(cmd)
 	c.addComputePoolFlag(cmd)
+	addRecordingFlags(cmd)
 	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
 	pcmd.AddContextFlag(cmd, c.CLICommand)
 	pcmd.AddOutputFlag(cmd)
@@ -53,12 +54,13 @@ func (c *command) newStatementListCommand() *cobra.Command {
 }
 
 func (c *command) statementList(cmd *cobra.Command, _ []string) error {
-	client, err := c.GetFlinkGatewayClient(false)
+	client, err := c.getGatewayClient(cmd, false)
 	if err != nil {
 		return err
 	}
+	defer closeGatewayClient(client)
 
-	environmentId, err := c.Context.EnvironmentId()
+	environmentId, err := c.getEnvironmentId(cmd)
 	if err != nil {
 		return err
 	}
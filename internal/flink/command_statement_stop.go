@@ -21,22 +21,24 @@ func (c *command) newStatementStopCommand() *cobra.Command {
 
 	pcmd.AddCloudFlag(cmd)
 	c.addRegionFlag(cmd)
+	addRecordingFlags(cmd)
 	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
 	pcmd.AddContextFlag(cmd, c.CLICommand)
 
 	return cmd
 }
 
-func (c *command) statementStop(_ *cobra.Command, args []string) error {
-	environmentId, err := c.Context.EnvironmentId()
+func (c *command) statementStop(cmd *cobra.Command, args []string) error {
+	environmentId, err := c.getEnvironmentId(cmd)
 	if err != nil {
 		return err
 	}
 
-	client, err := c.GetFlinkGatewayClient(false)
+	client, err := c.getGatewayClient(cmd, false)
 	if err != nil {
 		return err
 	}
+	defer closeGatewayClient(client)
 
 	statement, err := client.GetStatement(environmentId, args[0], c.Context.GetCurrentOrganization())
 	if err != nil {
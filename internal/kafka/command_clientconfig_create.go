@@ -10,6 +10,7 @@ import (
 	"unicode"
 
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 
 	srcmv2 "github.com/confluentinc/ccloud-sdk-go-v2/srcm/v2"
 	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
@@ -20,13 +21,15 @@ import (
 	"github.com/confluentinc/cli/v3/pkg/examples"
 	"github.com/confluentinc/cli/v3/pkg/kafka"
 	"github.com/confluentinc/cli/v3/pkg/output"
+	"github.com/confluentinc/cli/v3/pkg/properties"
 )
 
 type clientConfig struct {
-	language         string // human-friendly language name
-	languageId       string // unique id for language used as CLI command
-	configId         string // config id used for fetching language config file from the examples Github repo
-	isSrApiAvailable bool   // whether SR key pair is supported in the language config file
+	language         string                                       // human-friendly language name
+	languageId       string                                       // unique id for language used as CLI command
+	configId         string                                       // config id used for fetching language config file from the examples Github repo
+	isSrApiAvailable bool                                         // whether SR key pair is supported in the language config file
+	convert          func(*cobra.Command, string) (string, error) // converts the config file to another format, if necessary
 }
 
 const (
@@ -55,30 +58,51 @@ const (
 	srEndpointProperty          = "schema.registry.url"
 	srCredentialsSourceProperty = "basic.auth.credentials.source"
 	srUserInfoProperty          = "basic.auth.user.info"
+
+	defaultKubernetesSecretName = "kafka-client-config"
 )
 
 var (
 	clientConfigurations = []*clientConfig{
-		{"C#", "csharp", librdKafkaConfig, false},
-		{"C/C++", "cpp", librdKafkaConfig, false},
-		{"Clojure", "clojure", javaConfig, false},
-		{"Go", "go", librdKafkaConfig, false},
-		{"Groovy", "groovy", javaConfig, false},
-		{"Java", "java", javaSRConfig, true},
-		{"Kotlin", "kotlin", javaConfig, false},
-		{"Ktor", "ktor", hoconSRConfig, true},
-		{"Node.js", "nodejs", librdKafkaConfig, false},
-		{"Python", "python", librdKafkaSRConfig, true},
-		{"REST API", "restapi", restproxySrConfig, true},
-		{"Ruby", "ruby", librdKafkaConfig, false},
-		{"Rust", "rust", librdKafkaConfig, false},
-		{"Scala", "scala", javaConfig, false},
-		{"Spring Boot", "springboot", springbootSrConfig, true},
+		{"C#", "csharp", librdKafkaConfig, false, nil},
+		{"C/C++", "cpp", librdKafkaConfig, false, nil},
+		{"Clojure", "clojure", javaConfig, false, nil},
+		{"Go", "go", librdKafkaConfig, false, nil},
+		{"Groovy", "groovy", javaConfig, false, nil},
+		{"Java", "java", javaSRConfig, true, nil},
+		{"Kotlin", "kotlin", javaConfig, false, nil},
+		{"Ktor", "ktor", hoconSRConfig, true, nil},
+		{"Kubernetes Secret", "kubernetes-secret", javaSRConfig, true, convertToKubernetesSecret},
+		{"librdkafka", "librdkafka", librdKafkaSRConfig, true, nil},
+		{"Node.js", "nodejs", librdKafkaConfig, false, nil},
+		{"Python", "python", librdKafkaSRConfig, true, nil},
+		{"REST API", "restapi", restproxySrConfig, true, nil},
+		{"Ruby", "ruby", librdKafkaConfig, false, nil},
+		{"Rust", "rust", librdKafkaConfig, false, nil},
+		{"Scala", "scala", javaConfig, false, nil},
+		{"Spring Boot", "springboot", springbootSrConfig, true, nil},
+		{"Spring Boot application.yaml", "springboot-yaml", javaSRConfig, true, toSpringBootYaml},
 	}
 
 	re = regexp.MustCompile(fmt.Sprintf("%s|%s|%s", srEndpointProperty, srCredentialsSourceProperty, srUserInfoProperty))
+
+	// connection properties of the client configuration files which are used to validate them
+	connectionProperties = []string{"bootstrap.servers", "security.protocol", "sasl.mechanism", "sasl.mechanisms", "sasl.username", "sasl.password"}
+
+	jaasCredentialsRegex = regexp.MustCompile(`username\s*=\s*['"]([^'"]*)['"]\s+password\s*=\s*['"]([^'"]*)['"]`)
 )
 
+// isPropertiesFile returns true if the configuration file is a Java or librdkafka properties file, from which a client
+// can be built
+func (c *clientConfig) isPropertiesFile() bool {
+	switch c.configId {
+	case javaConfig, javaSRConfig, librdKafkaConfig, librdKafkaSRConfig:
+		return true
+	default:
+		return false
+	}
+}
+
 func (c *clientConfigCommand) newCreateCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create",
@@ -107,7 +131,7 @@ func (c *clientConfigCommand) newCreateClientCommand(clientConfig *clientConfig)
 		Short: clientConfigDescription + ".",
 		Long:  clientConfigDescription + ", of which the client configuration file is printed to stdout and the warnings are printed to stderr. Please see our examples on how to redirect the command output.",
 		Args:  cobra.NoArgs,
-		RunE:  c.create(clientConfig.configId, clientConfig.isSrApiAvailable),
+		RunE:  c.create(clientConfig),
 		Example: examples.BuildExampleString(
 			examples.Example{
 				Text: clientConfigDescription + ".",
@@ -133,6 +157,12 @@ func (c *clientConfigCommand) newCreateClientCommand(clientConfig *clientConfig)
 	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
 	pcmd.AddApiKeyFlag(cmd, c.AuthenticatedCLICommand)
 	pcmd.AddApiSecretFlag(cmd)
+	if clientConfig.isPropertiesFile() {
+		cmd.Flags().Bool("validate", false, "Validate the connection to the Kafka cluster with a client built from the client configuration.")
+	}
+	if clientConfig.languageId == "kubernetes-secret" {
+		cmd.Flags().String("secret-name", defaultKubernetesSecretName, "Name of the Kubernetes Secret.")
+	}
 
 	if clientConfig.isSrApiAvailable {
 		cmd.Flags().String("schema-registry-api-key", "", "Schema registry API key.")
@@ -142,10 +172,10 @@ func (c *clientConfigCommand) newCreateClientCommand(clientConfig *clientConfig)
 	return cmd
 }
 
-func (c *clientConfigCommand) create(configId string, srApiAvailable bool) func(cmd *cobra.Command, _ []string) error {
+func (c *clientConfigCommand) create(clientConfig *clientConfig) func(cmd *cobra.Command, _ []string) error {
 	return func(cmd *cobra.Command, _ []string) error {
 		// fetch raw configuration file in which templates need to be replaced
-		configFile, err := fetchConfigFile(configId)
+		configFile, err := fetchConfigFile(clientConfig.configId)
 		if err != nil {
 			return err
 		}
@@ -157,13 +187,34 @@ func (c *clientConfigCommand) create(configId string, srApiAvailable bool) func(
 		}
 
 		// replace SR_ENDPOINT, SR_API_KEY, and SR_API_SECRET templates if necessary
-		if srApiAvailable {
+		if clientConfig.isSrApiAvailable {
 			configFile, err = c.setSchemaRegistryCluster(cmd, configFile)
 			if err != nil {
 				return err
 			}
 		}
 
+		// validate the connection with a client built from the configuration file, before it is converted
+		if clientConfig.isPropertiesFile() {
+			validate, err := cmd.Flags().GetBool("validate")
+			if err != nil {
+				return err
+			}
+			if validate {
+				if err := c.validateClientConfig(configFile); err != nil {
+					return err
+				}
+			}
+		}
+
+		// convert configuration file to the output format if necessary
+		if clientConfig.convert != nil {
+			configFile, err = clientConfig.convert(cmd, configFile)
+			if err != nil {
+				return err
+			}
+		}
+
 		// print configuration file to stdout
 		output.Println(c.Config.EnableColor, configFile)
 		return nil
@@ -292,17 +343,99 @@ func (c *clientConfigCommand) validateKafkaCredentials(kafkaCluster *config.Kafk
 	defer adminClient.Close()
 	timeout := 5 * time.Second
 	if _, err := adminClient.GetMetadata(nil, true, int(timeout.Milliseconds())); err != nil {
-		if err.Error() == ckafka.ErrTransport.String() {
-			err = errors.NewErrorWithSuggestions("failed to validate Kafka API credential", "Verify that the correct Kafka API credential is used.\n"+
-				"If you are using the stored Kafka API credential, verify that the secret is correct. If incorrect, override with `confluent api-key store --force`.\n"+
-				"If you are using the flags, verify that the correct Kafka API credential is passed to `--api-key` and `--api-secret`.")
-		}
+		return getKafkaValidationError(err, kafkaCluster.Bootstrap)
+	}
+
+	return nil
+}
+
+// validateClientConfig connects to the Kafka cluster with a client built from the connection properties of a Java or
+// librdkafka configuration file, so that the endpoint, security settings and credentials are checked as written.
+func (c *clientConfigCommand) validateClientConfig(configFile string) error {
+	configMap, err := toClientConfigMap(configFile)
+	if err != nil {
+		return err
+	}
+	if err := configMap.SetKey("client.id", c.clientId); err != nil {
+		return err
+	}
+	bootstrap, _ := configMap.Get("bootstrap.servers", "")
+
+	adminClient, err := ckafka.NewAdminClient(configMap)
+	if err != nil {
 		return err
 	}
+	defer adminClient.Close()
+	timeout := 5 * time.Second
+	if _, err := adminClient.GetMetadata(nil, true, int(timeout.Milliseconds())); err != nil {
+		return getKafkaValidationError(err, fmt.Sprint(bootstrap))
+	}
 
+	output.ErrPrintf(c.Config.EnableColor, "Validated the connection to \"%s\" with the client configuration.\n", bootstrap)
 	return nil
 }
 
+// toClientConfigMap reads the connection properties of a Java or librdkafka configuration file into a librdkafka
+// configuration. The credentials of Java's "sasl.jaas.config" are passed as "sasl.username" and "sasl.password".
+func toClientConfigMap(configFile string) (*ckafka.ConfigMap, error) {
+	configs, err := properties.StringToMap(configFile)
+	if err != nil {
+		return nil, err
+	}
+
+	configMap := &ckafka.ConfigMap{}
+	for _, key := range connectionProperties {
+		if value, ok := configs[key]; ok {
+			if err := configMap.SetKey(key, value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if jaasConfig, ok := configs["sasl.jaas.config"]; ok {
+		matches := jaasCredentialsRegex.FindStringSubmatch(jaasConfig)
+		if matches == nil {
+			return nil, fmt.Errorf(`failed to read the credentials of "sasl.jaas.config"`)
+		}
+		if err := configMap.SetKey("sasl.username", matches[1]); err != nil {
+			return nil, err
+		}
+		if err := configMap.SetKey("sasl.password", matches[2]); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, ok := configs["bootstrap.servers"]; !ok {
+		return nil, fmt.Errorf(`client configuration is missing "bootstrap.servers"`)
+	}
+	return configMap, nil
+}
+
+// getKafkaValidationError distinguishes authentication failures from connectivity failures where the client can tell
+// them apart. Failed SASL handshakes are often reported as transport failures, so those suggest checking both.
+func getKafkaValidationError(err error, bootstrap string) error {
+	credentialSuggestions := "Verify that the correct Kafka API credential is used.\n" +
+		"If you are using the stored Kafka API credential, verify that the secret is correct. If incorrect, override with `confluent api-key store --force`.\n" +
+		"If you are using the flags, verify that the correct Kafka API credential is passed to `--api-key` and `--api-secret`."
+	connectivitySuggestions := fmt.Sprintf(`Verify that the bootstrap server "%s" can be reached from this network, for example that it is not blocked by a firewall or proxy.`, bootstrap)
+
+	kafkaErr, ok := err.(ckafka.Error)
+	if !ok {
+		return err
+	}
+
+	switch kafkaErr.Code() {
+	case ckafka.ErrAuthentication, ckafka.ErrSaslAuthenticationFailed:
+		return errors.NewErrorWithSuggestions(fmt.Sprintf("failed to authenticate to Kafka cluster: %v", err), credentialSuggestions)
+	case ckafka.ErrTransport:
+		return errors.NewErrorWithSuggestions("failed to validate Kafka API credential", credentialSuggestions+"\n"+connectivitySuggestions)
+	case ckafka.ErrTimedOut, ckafka.ErrAllBrokersDown, ckafka.ErrResolve:
+		return errors.NewErrorWithSuggestions(fmt.Sprintf("failed to connect to Kafka cluster: %v", err), connectivitySuggestions)
+	default:
+		return err
+	}
+}
+
 func (c *clientConfigCommand) validateSchemaRegistryCredentials(cmd *cobra.Command) error {
 	client, err := c.GetSchemaRegistryClient(cmd)
 	if err != nil {
@@ -400,6 +533,82 @@ func commentSchemaRegistryLines(configFile string) string {
 	return strings.Join(lines, "\n")
 }
 
+// toSpringBootYaml converts a Java client configuration file into a Spring Boot application.yaml, in which the
+// properties are passed through "spring.kafka.properties". Commented out properties are dropped.
+func toSpringBootYaml(_ *cobra.Command, configFile string) (string, error) {
+	configs, err := properties.StringToMap(configFile)
+	if err != nil {
+		return "", err
+	}
+
+	kafkaProperties := make(map[string]string)
+	for key, value := range configs {
+		if key != "bootstrap.servers" {
+			// brackets keep the dots in the keys of Spring Boot maps
+			kafkaProperties[fmt.Sprintf("[%s]", key)] = value
+		}
+	}
+
+	applicationYaml := map[string]any{
+		"spring": map[string]any{
+			"kafka": map[string]any{
+				"bootstrap-servers": configs["bootstrap.servers"],
+				"properties":        kafkaProperties,
+			},
+		},
+	}
+
+	out, err := yaml.Marshal(applicationYaml)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
+
+type kubernetesSecret struct {
+	ApiVersion string                   `yaml:"apiVersion"`
+	Kind       string                   `yaml:"kind"`
+	Metadata   kubernetesSecretMetadata `yaml:"metadata"`
+	Type       string                   `yaml:"type"`
+	StringData map[string]string        `yaml:"stringData"`
+}
+
+type kubernetesSecretMetadata struct {
+	Name string `yaml:"name"`
+}
+
+func convertToKubernetesSecret(cmd *cobra.Command, configFile string) (string, error) {
+	name, err := cmd.Flags().GetString("secret-name")
+	if err != nil {
+		return "", err
+	}
+	return toKubernetesSecret(configFile, name)
+}
+
+// toKubernetesSecret wraps a Java client configuration file in a Kubernetes Secret manifest, to be mounted as the
+// "client.properties" file.
+func toKubernetesSecret(configFile, name string) (string, error) {
+	// trailing spaces would force the file into a quoted string instead of a readable block
+	lines := strings.Split(configFile, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRightFunc(line, unicode.IsSpace)
+	}
+
+	secret := kubernetesSecret{
+		ApiVersion: "v1",
+		Kind:       "Secret",
+		Metadata:   kubernetesSecretMetadata{Name: name},
+		Type:       "Opaque",
+		StringData: map[string]string{"client.properties": strings.Join(lines, "\n")},
+	}
+
+	out, err := yaml.Marshal(secret)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
+
 func getApiKey(cmd *cobra.Command) (string, error) {
 	if cmd.Flag("api-key") == nil || cmd.Flag("api-secret") == nil {
 		return "", nil
@@ -1,11 +1,25 @@
 package kafka
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+
+	"github.com/confluentinc/cli/v3/pkg/errors"
 )
 
+const javaClientConfig = "# Required connection configs for Kafka producer, consumer, and admin\n" +
+	"bootstrap.servers=pkc-12345.us-west-2.aws.confluent.cloud:9092\n" +
+	"security.protocol=SASL_SSL\n" +
+	"sasl.jaas.config=org.apache.kafka.common.security.plain.PlainLoginModule required username='key' password='secret';\n" +
+	"\n" +
+	"# Best practice for Kafka producer to prevent data loss \n" +
+	"acks=all\n" +
+	"#schema.registry.url=https://psrc-12345.us-west-2.aws.confluent.cloud\n"
+
 func TestCommentAndWarnAboutSr(t *testing.T) {
 	// comments should be at the beginning of the line
 	original := "# Required connection configs for Confluent Cloud Schema Registry\n" +
@@ -33,3 +47,80 @@ func TestCommentAndWarnAboutSr(t *testing.T) {
 		"    #basic.auth.user.info = \"{{ SR_API_KEY }}:{{ SR_API_SECRET }}\"\n"+
 		"  }", commented)
 }
+
+func TestToSpringBootYaml(t *testing.T) {
+	applicationYaml, err := toSpringBootYaml(nil, javaClientConfig)
+	require.NoError(t, err)
+	require.Equal(t, "spring:\n"+
+		"    kafka:\n"+
+		"        bootstrap-servers: pkc-12345.us-west-2.aws.confluent.cloud:9092\n"+
+		"        properties:\n"+
+		"            '[acks]': all\n"+
+		"            '[sasl.jaas.config]': org.apache.kafka.common.security.plain.PlainLoginModule required username='key' password='secret';\n"+
+		"            '[security.protocol]': SASL_SSL", applicationYaml)
+}
+
+func TestToKubernetesSecret(t *testing.T) {
+	secret, err := toKubernetesSecret(javaClientConfig, "my-secret")
+	require.NoError(t, err)
+	require.Equal(t, "apiVersion: v1\n"+
+		"kind: Secret\n"+
+		"metadata:\n"+
+		"    name: my-secret\n"+
+		"type: Opaque\n"+
+		"stringData:\n"+
+		"    client.properties: |\n"+
+		"        # Required connection configs for Kafka producer, consumer, and admin\n"+
+		"        bootstrap.servers=pkc-12345.us-west-2.aws.confluent.cloud:9092\n"+
+		"        security.protocol=SASL_SSL\n"+
+		"        sasl.jaas.config=org.apache.kafka.common.security.plain.PlainLoginModule required username='key' password='secret';\n"+
+		"\n"+
+		"        # Best practice for Kafka producer to prevent data loss\n"+
+		"        acks=all\n"+
+		"        #schema.registry.url=https://psrc-12345.us-west-2.aws.confluent.cloud", secret)
+}
+
+func TestToClientConfigMap(t *testing.T) {
+	configMap, err := toClientConfigMap(javaClientConfig + "sasl.mechanism=PLAIN\n")
+	require.NoError(t, err)
+	require.Equal(t, &ckafka.ConfigMap{
+		"bootstrap.servers": "pkc-12345.us-west-2.aws.confluent.cloud:9092",
+		"security.protocol": "SASL_SSL",
+		"sasl.mechanism":    "PLAIN",
+		"sasl.username":     "key",
+		"sasl.password":     "secret",
+	}, configMap)
+
+	librdkafkaConfig := "bootstrap.servers=pkc-12345.us-west-2.aws.confluent.cloud:9092\n" +
+		"security.protocol=SASL_SSL\n" +
+		"sasl.mechanisms=PLAIN\n" +
+		"sasl.username=key\n" +
+		"sasl.password=secret\n" +
+		"session.timeout.ms=45000\n" +
+		"schema.registry.url=https://psrc-12345.us-west-2.aws.confluent.cloud\n"
+	configMap, err = toClientConfigMap(librdkafkaConfig)
+	require.NoError(t, err)
+	require.Equal(t, &ckafka.ConfigMap{
+		"bootstrap.servers": "pkc-12345.us-west-2.aws.confluent.cloud:9092",
+		"security.protocol": "SASL_SSL",
+		"sasl.mechanisms":   "PLAIN",
+		"sasl.username":     "key",
+		"sasl.password":     "secret",
+	}, configMap)
+
+	_, err = toClientConfigMap("security.protocol=SASL_SSL\n")
+	require.ErrorContains(t, err, `missing "bootstrap.servers"`)
+}
+
+func TestGetKafkaValidationError(t *testing.T) {
+	err := getKafkaValidationError(ckafka.NewError(ckafka.ErrSaslAuthenticationFailed, "Authentication failed", false), "pkc-12345:9092")
+	require.ErrorContains(t, err, "failed to authenticate to Kafka cluster")
+	require.Contains(t, err.(errors.ErrorWithSuggestions).GetSuggestionsMsg(), "confluent api-key store --force")
+
+	err = getKafkaValidationError(ckafka.NewError(ckafka.ErrTimedOut, "Timed out", false), "pkc-12345:9092")
+	require.ErrorContains(t, err, "failed to connect to Kafka cluster")
+	require.Contains(t, err.(errors.ErrorWithSuggestions).GetSuggestionsMsg(), `"pkc-12345:9092"`)
+
+	err = fmt.Errorf("unexpected")
+	require.Equal(t, err, getKafkaValidationError(err, "pkc-12345:9092"))
+}
@@ -38,8 +38,7 @@ func (c *clusterCommand) use(_ *cobra.Command, args []string) error {
 		)
 	}
 
-	c.Context.KafkaClusterContext.SetActiveKafkaCluster(id)
-	if err := c.Config.Save(); err != nil {
+	if err := c.Config.UseKafkaCluster(id); err != nil {
 		return err
 	}
 
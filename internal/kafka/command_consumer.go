@@ -9,6 +9,7 @@ import (
 
 type consumerCommand struct {
 	*pcmd.AuthenticatedCLICommand
+	clientID string
 }
 
 type consumerOut struct {
@@ -25,7 +26,7 @@ func newConsumerCommand(cfg *config.Config, prerunner pcmd.PreRunner) *cobra.Com
 		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireNonAPIKeyCloudLoginOrOnPremLogin},
 	}
 
-	c := &consumerCommand{}
+	c := &consumerCommand{clientID: cfg.Version.ClientID}
 
 	if cfg.IsCloudLogin() {
 		c.AuthenticatedCLICommand = pcmd.NewAuthenticatedCLICommand(cmd, prerunner)
@@ -0,0 +1,275 @@
+package kafka
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/kafka"
+	"github.com/confluentinc/cli/v3/pkg/log"
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+const offsetTimeout = 10 * time.Second
+
+const inactiveConsumerGroupLongDescription = "The consumer group must be inactive. Offsets of a consumer group with active members are rejected by the Kafka cluster."
+
+type offsetOut struct {
+	Topic         string `human:"Topic" serialized:"topic"`
+	Partition     int32  `human:"Partition" serialized:"partition"`
+	CurrentOffset int64  `human:"Current Offset" serialized:"current_offset"`
+	NewOffset     int64  `human:"New Offset" serialized:"new_offset"`
+}
+
+// offsetClient contains the methods of a consumer used to read and commit the offsets of its consumer group
+type offsetClient interface {
+	Committed([]ckafka.TopicPartition, int) ([]ckafka.TopicPartition, error)
+	CommitOffsets([]ckafka.TopicPartition) ([]ckafka.TopicPartition, error)
+	GetMetadata(*string, bool, int) (*ckafka.Metadata, error)
+	OffsetsForTimes([]ckafka.TopicPartition, int) ([]ckafka.TopicPartition, error)
+	QueryWatermarkOffsets(string, int32, int) (int64, int64, error)
+}
+
+func (c *consumerCommand) newOffsetCommand(cfg *config.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offset",
+		Short: "Manage Kafka consumer group offsets.",
+	}
+
+	cmd.AddCommand(c.newOffsetExportCommand(cfg))
+	cmd.AddCommand(c.newOffsetImportCommand(cfg))
+	cmd.AddCommand(c.newOffsetResetCommand(cfg))
+	cmd.AddCommand(c.newOffsetShiftCommand(cfg))
+
+	return cmd
+}
+
+// addOffsetClientFlags adds the flags of the consumer client which reads and commits the offsets of a consumer group
+func (c *consumerCommand) addOffsetClientFlags(cmd *cobra.Command, cfg *config.Config) {
+	if cfg.IsCloudLogin() {
+		pcmd.AddApiKeyFlag(cmd, c.AuthenticatedCLICommand)
+		pcmd.AddApiSecretFlag(cmd)
+		pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+		pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	} else {
+		cmd.Flags().String("bootstrap", "", `Comma-separated list of broker hosts, each formatted as "host" or "host:port".`)
+		cmd.Flags().AddFlagSet(pcmd.OnPremAuthenticationSet())
+		pcmd.AddProtocolFlag(cmd)
+		pcmd.AddMechanismFlag(cmd, c.AuthenticatedCLICommand)
+		cobra.CheckErr(cmd.MarkFlagRequired("bootstrap"))
+	}
+	cmd.Flags().StringSlice("config", nil, `A comma-separated list of configuration overrides ("key=value") for the consumer client.`)
+	pcmd.AddConsumerConfigFileFlag(cmd)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+
+	cobra.CheckErr(cmd.MarkFlagFilename("config-file", "avsc", "json"))
+	cmd.MarkFlagsMutuallyExclusive("config", "config-file")
+}
+
+func addOffsetTopicFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice("topic", nil, "A comma-separated list of topics. Defaults to all topics with committed offsets for the consumer group.")
+	cmd.Flags().IntSlice("partitions", nil, "A comma-separated list of partitions of the topic. Defaults to all partitions. Requires a single topic.")
+}
+
+// newOffsetConsumer creates a consumer which is a member of the consumer group, but never subscribes to topics
+func (c *consumerCommand) newOffsetConsumer(cmd *cobra.Command, group string) (*ckafka.Consumer, error) {
+	configFile, err := cmd.Flags().GetString("config-file")
+	if err != nil {
+		return nil, err
+	}
+	configs, err := cmd.Flags().GetStringSlice("config")
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Config.IsCloudLogin() {
+		cluster, err := kafka.GetClusterForCommand(c.V2Client, c.Context)
+		if err != nil {
+			return nil, err
+		}
+		if err := addApiKeyToCluster(cmd, cluster); err != nil {
+			return nil, err
+		}
+
+		consumer, err := newConsumer(group, cluster, c.clientID, configFile, configs)
+		if err != nil {
+			return nil, fmt.Errorf(errors.FailedToCreateConsumerErrorMsg, err)
+		}
+		return consumer, nil
+	}
+
+	consumer, err := newOnPremConsumer(cmd, c.Context, group, c.clientID, configFile, configs)
+	if err != nil {
+		return nil, errors.NewErrorWithSuggestions(
+			fmt.Sprintf(errors.FailedToCreateConsumerErrorMsg, err),
+			errors.OnPremConfigGuideSuggestions,
+		)
+	}
+	if err := refreshOAuthBearerToken(cmd, consumer, c.Context); err != nil {
+		consumer.Close()
+		return nil, err
+	}
+	return consumer, nil
+}
+
+// getCommittedOffsets returns the partitions selected with the topic flags, with the committed offsets of the consumer
+// group. Without topics, the partitions of all topics with committed offsets are returned.
+func getCommittedOffsets(cmd *cobra.Command, client offsetClient) ([]ckafka.TopicPartition, error) {
+	topics, err := cmd.Flags().GetStringSlice("topic")
+	if err != nil {
+		return nil, err
+	}
+	partitionIds, err := cmd.Flags().GetIntSlice("partitions")
+	if err != nil {
+		return nil, err
+	}
+	if len(partitionIds) > 0 && len(topics) != 1 {
+		return nil, fmt.Errorf("`--partitions` requires a single topic")
+	}
+	partitions := make([]int32, len(partitionIds))
+	for i, id := range partitionIds {
+		partitions[i] = int32(id)
+	}
+
+	topicPartitions, err := getTopicPartitions(client, topics, partitions)
+	if err != nil {
+		return nil, err
+	}
+	if len(topicPartitions) == 0 {
+		return nil, nil
+	}
+
+	committed, err := client.Committed(topicPartitions, int(offsetTimeout.Milliseconds()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read committed offsets: %w", err)
+	}
+
+	if len(topics) == 0 {
+		committed = slices.DeleteFunc(committed, func(partition ckafka.TopicPartition) bool { return partition.Offset < 0 })
+	}
+	sortTopicPartitions(committed)
+	return committed, nil
+}
+
+func getTopicPartitions(client offsetClient, topics []string, partitions []int32) ([]ckafka.TopicPartition, error) {
+	metadata, err := client.GetMetadata(nil, true, int(offsetTimeout.Milliseconds()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain topics from client: %w", err)
+	}
+
+	for _, topic := range topics {
+		if _, ok := metadata.Topics[topic]; !ok {
+			return nil, fmt.Errorf(errors.UnknownTopicErrorMsg, topic)
+		}
+	}
+
+	var topicPartitions []ckafka.TopicPartition
+	for name, topic := range metadata.Topics {
+		if len(topics) > 0 && !slices.Contains(topics, name) {
+			continue
+		}
+		name := name
+		for _, partition := range topic.Partitions {
+			if len(partitions) > 0 && !slices.Contains(partitions, partition.ID) {
+				continue
+			}
+			topicPartitions = append(topicPartitions, ckafka.TopicPartition{Topic: &name, Partition: partition.ID})
+		}
+	}
+
+	for _, partition := range partitions {
+		if !slices.ContainsFunc(topicPartitions, func(topicPartition ckafka.TopicPartition) bool { return topicPartition.Partition == partition }) {
+			return nil, fmt.Errorf(`partition %d not found in topic "%s"`, partition, topics[0])
+		}
+	}
+
+	return topicPartitions, nil
+}
+
+// commitOffsets commits the new offsets of a plan, unless it is a dry run, and prints the plan
+func (c *consumerCommand) commitOffsets(cmd *cobra.Command, client offsetClient, group string, plan []*offsetOut) error {
+	if len(plan) == 0 {
+		return fmt.Errorf(`no committed offsets found for consumer group "%s"`, group)
+	}
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
+	if !dryRun {
+		offsets := make([]ckafka.TopicPartition, len(plan))
+		for i, partition := range plan {
+			offsets[i] = ckafka.TopicPartition{Topic: &partition.Topic, Partition: partition.Partition, Offset: ckafka.Offset(partition.NewOffset)}
+		}
+
+		committed, err := client.CommitOffsets(offsets)
+		if err != nil {
+			return catchCommitOffsetsError(err, group)
+		}
+		for _, partition := range committed {
+			if partition.Error != nil {
+				return catchCommitOffsetsError(partition.Error, group)
+			}
+		}
+		log.CliLogger.Tracef("Committed offsets of %d partitions for consumer group %s", len(committed), group)
+	}
+
+	list := output.NewList(cmd)
+	for _, partition := range plan {
+		list.Add(partition)
+	}
+	list.Sort(false)
+	return list.Print()
+}
+
+func catchCommitOffsetsError(err error, group string) error {
+	if kafkaErr, ok := err.(ckafka.Error); ok {
+		switch kafkaErr.Code() {
+		case ckafka.ErrUnknownMemberID, ckafka.ErrIllegalGeneration, ckafka.ErrRebalanceInProgress:
+			return errors.NewErrorWithSuggestions(
+				fmt.Sprintf(`failed to commit offsets: consumer group "%s" is active`, group),
+				"Stop all consumers of the consumer group and try again.",
+			)
+		}
+	}
+	return fmt.Errorf("failed to commit offsets: %w", err)
+}
+
+func sortTopicPartitions(partitions []ckafka.TopicPartition) {
+	sort.Slice(partitions, func(i, j int) bool {
+		if *partitions[i].Topic != *partitions[j].Topic {
+			return *partitions[i].Topic < *partitions[j].Topic
+		}
+		return partitions[i].Partition < partitions[j].Partition
+	})
+}
+
+// getWatermarkOffsets returns the earliest and latest offsets of a partition
+func getWatermarkOffsets(client offsetClient, partition ckafka.TopicPartition) (int64, int64, error) {
+	low, high, err := client.QueryWatermarkOffsets(*partition.Topic, partition.Partition, int(offsetTimeout.Milliseconds()))
+	if err != nil {
+		return 0, 0, fmt.Errorf(`failed to read offsets of partition %d of topic "%s": %w`, partition.Partition, *partition.Topic, err)
+	}
+	return low, high, nil
+}
+
+// getCurrentOffset returns the committed offset of a partition, or -1 if the consumer group has no committed offset
+func getCurrentOffset(partition ckafka.TopicPartition) int64 {
+	if partition.Offset < 0 {
+		return -1
+	}
+	return int64(partition.Offset)
+}
+
+func clampOffset(offset, low, high int64) int64 {
+	return min(max(offset, low), high)
+}
@@ -0,0 +1,121 @@
+package kafka
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+// groupOffset is the committed offset of a partition, as stored in an exported file
+type groupOffset struct {
+	Topic     string `json:"topic"`
+	Partition int32  `json:"partition"`
+	Offset    int64  `json:"offset"`
+}
+
+func (c *consumerCommand) newOffsetExportCommand(cfg *config.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "export <group> <file>",
+		Short:             "Export the offsets of a Kafka consumer group.",
+		Long:              `Export the committed offsets of a Kafka consumer group to a CSV or JSON file, depending on the file extension. Each line of a CSV file is formatted as "topic,partition,offset", like the files of "kafka-consumer-groups".`,
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: pcmd.NewValidArgsFunction(c.validGroupArgs),
+		RunE:              c.offsetExport,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Export the offsets of consumer group "my-consumer-group" to file "offsets.csv".`,
+				Code: "confluent kafka consumer group offset export my-consumer-group offsets.csv",
+			},
+			examples.Example{
+				Text: `Export the offsets of consumer group "my-consumer-group" for topic "my-topic" to file "offsets.json".`,
+				Code: "confluent kafka consumer group offset export my-consumer-group offsets.json --topic my-topic",
+			},
+		),
+	}
+
+	addOffsetTopicFlags(cmd)
+	c.addOffsetClientFlags(cmd, cfg)
+
+	return cmd
+}
+
+func (c *consumerCommand) offsetExport(cmd *cobra.Command, args []string) error {
+	group, path := args[0], args[1]
+	if err := validateOffsetFileExtension(path); err != nil {
+		return err
+	}
+
+	consumer, err := c.newOffsetConsumer(cmd, group)
+	if err != nil {
+		return err
+	}
+	defer consumer.Close()
+
+	committed, err := getCommittedOffsets(cmd, consumer)
+	if err != nil {
+		return err
+	}
+
+	var offsets []groupOffset
+	for _, partition := range committed {
+		if partition.Offset >= 0 {
+			offsets = append(offsets, groupOffset{Topic: *partition.Topic, Partition: partition.Partition, Offset: int64(partition.Offset)})
+		}
+	}
+	if len(offsets) == 0 {
+		return fmt.Errorf(`no committed offsets found for consumer group "%s"`, group)
+	}
+
+	if err := writeGroupOffsets(path, offsets); err != nil {
+		return err
+	}
+
+	output.Printf(c.Config.EnableColor, "Exported the offsets of %d partitions of consumer group \"%s\" to \"%s\".\n", len(offsets), group, path)
+	return nil
+}
+
+func validateOffsetFileExtension(path string) error {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv", ".json":
+		return nil
+	default:
+		return fmt.Errorf(`unsupported file extension "%s": use ".csv" or ".json"`, filepath.Ext(path))
+	}
+}
+
+func writeGroupOffsets(path string, offsets []groupOffset) error {
+	if strings.ToLower(filepath.Ext(path)) == ".json" {
+		out, err := json.MarshalIndent(offsets, "", "  ")
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(path, append(out, '\n'), 0644)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	w := csv.NewWriter(file)
+	for _, offset := range offsets {
+		record := []string{offset.Topic, strconv.FormatInt(int64(offset.Partition), 10), strconv.FormatInt(offset.Offset, 10)}
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
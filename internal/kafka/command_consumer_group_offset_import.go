@@ -0,0 +1,148 @@
+package kafka
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+)
+
+func (c *consumerCommand) newOffsetImportCommand(cfg *config.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "import <group> <file>",
+		Short:             "Import the offsets of a Kafka consumer group.",
+		Long:              "Commit the offsets of a CSV or JSON file exported with `confluent kafka consumer group offset export` for a Kafka consumer group. Only the partitions in the file are changed. " + inactiveConsumerGroupLongDescription,
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: pcmd.NewValidArgsFunction(c.validGroupArgs),
+		RunE:              c.offsetImport,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Show the plan to import the offsets of file "offsets.csv" for consumer group "my-consumer-group", without committing it.`,
+				Code: "confluent kafka consumer group offset import my-consumer-group offsets.csv --dry-run",
+			},
+		),
+	}
+
+	pcmd.AddDryRunFlag(cmd)
+	c.addOffsetClientFlags(cmd, cfg)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *consumerCommand) offsetImport(cmd *cobra.Command, args []string) error {
+	group, path := args[0], args[1]
+
+	offsets, err := readGroupOffsets(path)
+	if err != nil {
+		return err
+	}
+
+	consumer, err := c.newOffsetConsumer(cmd, group)
+	if err != nil {
+		return err
+	}
+	defer consumer.Close()
+
+	plan, err := getImportPlan(consumer, offsets)
+	if err != nil {
+		return err
+	}
+
+	return c.commitOffsets(cmd, consumer, group, plan)
+}
+
+func getImportPlan(client offsetClient, offsets []groupOffset) ([]*offsetOut, error) {
+	partitions := make([]ckafka.TopicPartition, len(offsets))
+	for i, offset := range offsets {
+		partitions[i] = ckafka.TopicPartition{Topic: &offsets[i].Topic, Partition: offset.Partition}
+	}
+
+	committed, err := client.Committed(partitions, int(offsetTimeout.Milliseconds()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read committed offsets: %w", err)
+	}
+	currentOffsets := make(map[string]map[int32]int64)
+	for _, partition := range committed {
+		if partition.Error != nil {
+			return nil, fmt.Errorf(`failed to read committed offset of partition %d of topic "%s": %w`, partition.Partition, *partition.Topic, partition.Error)
+		}
+		if currentOffsets[*partition.Topic] == nil {
+			currentOffsets[*partition.Topic] = make(map[int32]int64)
+		}
+		currentOffsets[*partition.Topic][partition.Partition] = getCurrentOffset(partition)
+	}
+
+	plan := make([]*offsetOut, len(offsets))
+	for i, offset := range offsets {
+		currentOffset, ok := currentOffsets[offset.Topic][offset.Partition]
+		if !ok {
+			currentOffset = -1
+		}
+		plan[i] = &offsetOut{
+			Topic:         offset.Topic,
+			Partition:     offset.Partition,
+			CurrentOffset: currentOffset,
+			NewOffset:     offset.Offset,
+		}
+	}
+	return plan, nil
+}
+
+func readGroupOffsets(path string) ([]groupOffset, error) {
+	if err := validateOffsetFileExtension(path); err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var offsets []groupOffset
+	if strings.ToLower(filepath.Ext(path)) == ".json" {
+		if err := json.Unmarshal(data, &offsets); err != nil {
+			return nil, fmt.Errorf(`failed to parse offsets file "%s": %w`, path, err)
+		}
+	} else {
+		r := csv.NewReader(strings.NewReader(string(data)))
+		r.FieldsPerRecord = 3
+		r.TrimLeadingSpace = true
+		records, err := r.ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf(`failed to parse offsets file "%s": %w`, path, err)
+		}
+		for i, record := range records {
+			partition, err := strconv.ParseInt(record[1], 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf(`invalid partition "%s" on line %d of offsets file "%s"`, record[1], i+1, path)
+			}
+			offset, err := strconv.ParseInt(record[2], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf(`invalid offset "%s" on line %d of offsets file "%s"`, record[2], i+1, path)
+			}
+			offsets = append(offsets, groupOffset{Topic: record[0], Partition: int32(partition), Offset: offset})
+		}
+	}
+
+	if len(offsets) == 0 {
+		return nil, fmt.Errorf(`no offsets found in file "%s"`, path)
+	}
+	for _, offset := range offsets {
+		if offset.Topic == "" || offset.Partition < 0 || offset.Offset < 0 {
+			return nil, fmt.Errorf(`invalid offset %d of partition %d of topic "%s" in file "%s"`, offset.Offset, offset.Partition, offset.Topic, path)
+		}
+	}
+	return offsets, nil
+}
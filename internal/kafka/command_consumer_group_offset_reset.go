@@ -0,0 +1,148 @@
+package kafka
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+)
+
+func (c *consumerCommand) newOffsetResetCommand(cfg *config.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "reset <group>",
+		Short:             "Reset the offsets of a Kafka consumer group.",
+		Long:              "Reset the committed offsets of a Kafka consumer group to the earliest or latest offsets, to the offsets of a point in time, or to a specific offset. Offsets outside of the range of a partition are moved to its earliest or latest offset. " + inactiveConsumerGroupLongDescription,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: pcmd.NewValidArgsFunction(c.validGroupArgs),
+		RunE:              c.offsetReset,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Show the plan to reset the offsets of consumer group "my-consumer-group" for topic "my-topic" to the earliest offsets, without committing it.`,
+				Code: "confluent kafka consumer group offset reset my-consumer-group --topic my-topic --to-earliest --dry-run",
+			},
+			examples.Example{
+				Text: `Reset the offsets of consumer group "my-consumer-group" for all of its topics to the first messages produced after midnight UTC on January 1, 2024.`,
+				Code: "confluent kafka consumer group offset reset my-consumer-group --to-datetime 2024-01-01T00:00:00Z",
+			},
+			examples.Example{
+				Text: `Reset the offset of consumer group "my-consumer-group" for partition 0 of topic "my-topic" to offset 100.`,
+				Code: "confluent kafka consumer group offset reset my-consumer-group --topic my-topic --partitions 0 --to-offset 100",
+			},
+		),
+	}
+
+	cmd.Flags().Bool("to-earliest", false, "Reset to the earliest offsets.")
+	cmd.Flags().Bool("to-latest", false, "Reset to the latest offsets.")
+	cmd.Flags().String("to-datetime", "", `Reset to the offsets of the first messages with a timestamp at or after a time in RFC 3339 format, such as "2024-01-01T00:00:00Z".`)
+	cmd.Flags().Int64("to-offset", 0, "Reset to an offset.")
+	addOffsetTopicFlags(cmd)
+	pcmd.AddDryRunFlag(cmd)
+	c.addOffsetClientFlags(cmd, cfg)
+	pcmd.AddOutputFlag(cmd)
+
+	cmd.MarkFlagsOneRequired("to-earliest", "to-latest", "to-datetime", "to-offset")
+	cmd.MarkFlagsMutuallyExclusive("to-earliest", "to-latest", "to-datetime", "to-offset")
+
+	return cmd
+}
+
+func (c *consumerCommand) offsetReset(cmd *cobra.Command, args []string) error {
+	getNewOffset, err := getResetStrategy(cmd)
+	if err != nil {
+		return err
+	}
+
+	consumer, err := c.newOffsetConsumer(cmd, args[0])
+	if err != nil {
+		return err
+	}
+	defer consumer.Close()
+
+	committed, err := getCommittedOffsets(cmd, consumer)
+	if err != nil {
+		return err
+	}
+
+	plan, err := getResetPlan(consumer, committed, getNewOffset)
+	if err != nil {
+		return err
+	}
+
+	return c.commitOffsets(cmd, consumer, args[0], plan)
+}
+
+// resetStrategy returns the new offset of a partition, given the client and the earliest and latest offsets of the partition
+type resetStrategy func(client offsetClient, partition ckafka.TopicPartition, low, high int64) (int64, error)
+
+func getResetStrategy(cmd *cobra.Command) (resetStrategy, error) {
+	if cmd.Flags().Changed("to-earliest") {
+		return func(_ offsetClient, _ ckafka.TopicPartition, low, _ int64) (int64, error) { return low, nil }, nil
+	}
+
+	if cmd.Flags().Changed("to-latest") {
+		return func(_ offsetClient, _ ckafka.TopicPartition, _, high int64) (int64, error) { return high, nil }, nil
+	}
+
+	if cmd.Flags().Changed("to-datetime") {
+		datetime, err := cmd.Flags().GetString("to-datetime")
+		if err != nil {
+			return nil, err
+		}
+		t, err := time.Parse(time.RFC3339, datetime)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid time "%s": use RFC 3339 format, such as "2024-01-01T00:00:00Z"`, datetime)
+		}
+		return func(client offsetClient, partition ckafka.TopicPartition, _, high int64) (int64, error) {
+			return getOffsetForTime(client, partition, t, high)
+		}, nil
+	}
+
+	offset, err := cmd.Flags().GetInt64("to-offset")
+	if err != nil {
+		return nil, err
+	}
+	return func(_ offsetClient, _ ckafka.TopicPartition, low, high int64) (int64, error) {
+		return clampOffset(offset, low, high), nil
+	}, nil
+}
+
+func getResetPlan(client offsetClient, committed []ckafka.TopicPartition, getNewOffset resetStrategy) ([]*offsetOut, error) {
+	plan := make([]*offsetOut, len(committed))
+	for i, partition := range committed {
+		low, high, err := getWatermarkOffsets(client, partition)
+		if err != nil {
+			return nil, err
+		}
+		newOffset, err := getNewOffset(client, partition, low, high)
+		if err != nil {
+			return nil, err
+		}
+		plan[i] = &offsetOut{
+			Topic:         *partition.Topic,
+			Partition:     partition.Partition,
+			CurrentOffset: getCurrentOffset(partition),
+			NewOffset:     newOffset,
+		}
+	}
+	return plan, nil
+}
+
+// getOffsetForTime returns the offset of the first message of a partition with a timestamp at or after a time, or the
+// latest offset if there is no such message
+func getOffsetForTime(client offsetClient, partition ckafka.TopicPartition, t time.Time, high int64) (int64, error) {
+	times := []ckafka.TopicPartition{{Topic: partition.Topic, Partition: partition.Partition, Offset: ckafka.Offset(t.UnixMilli())}}
+	offsets, err := client.OffsetsForTimes(times, int(offsetTimeout.Milliseconds()))
+	if err != nil {
+		return 0, fmt.Errorf(`failed to look up offsets of partition %d of topic "%s" by time: %w`, partition.Partition, *partition.Topic, err)
+	}
+	if len(offsets) == 0 || offsets[0].Offset < 0 {
+		return high, nil
+	}
+	return int64(offsets[0].Offset), nil
+}
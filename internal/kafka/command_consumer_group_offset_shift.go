@@ -0,0 +1,78 @@
+package kafka
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+)
+
+func (c *consumerCommand) newOffsetShiftCommand(cfg *config.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "shift <group>",
+		Short:             "Shift the offsets of a Kafka consumer group.",
+		Long:              "Shift the committed offsets of a Kafka consumer group forward or backward by a number of offsets. Offsets outside of the range of a partition are moved to its earliest or latest offset. " + inactiveConsumerGroupLongDescription,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: pcmd.NewValidArgsFunction(c.validGroupArgs),
+		RunE:              c.offsetShift,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Reprocess the last 100 messages of each partition of topic "my-topic" consumed by consumer group "my-consumer-group".`,
+				Code: "confluent kafka consumer group offset shift my-consumer-group --topic my-topic --by -100",
+			},
+			examples.Example{
+				Text: `Skip 10 messages of partition 2 of topic "my-topic" consumed by consumer group "my-consumer-group".`,
+				Code: "confluent kafka consumer group offset shift my-consumer-group --topic my-topic --partitions 2 --by 10",
+			},
+		),
+	}
+
+	cmd.Flags().Int64("by", 0, "Number of offsets to shift by. Negative numbers shift backward.")
+	addOffsetTopicFlags(cmd)
+	pcmd.AddDryRunFlag(cmd)
+	c.addOffsetClientFlags(cmd, cfg)
+	pcmd.AddOutputFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagRequired("by"))
+
+	return cmd
+}
+
+func (c *consumerCommand) offsetShift(cmd *cobra.Command, args []string) error {
+	by, err := cmd.Flags().GetInt64("by")
+	if err != nil {
+		return err
+	}
+
+	consumer, err := c.newOffsetConsumer(cmd, args[0])
+	if err != nil {
+		return err
+	}
+	defer consumer.Close()
+
+	committed, err := getCommittedOffsets(cmd, consumer)
+	if err != nil {
+		return err
+	}
+
+	plan, err := getResetPlan(consumer, committed, getShiftStrategy(by))
+	if err != nil {
+		return err
+	}
+
+	return c.commitOffsets(cmd, consumer, args[0], plan)
+}
+
+func getShiftStrategy(by int64) resetStrategy {
+	return func(_ offsetClient, partition ckafka.TopicPartition, low, high int64) (int64, error) {
+		if partition.Offset < 0 {
+			return 0, fmt.Errorf(`partition %d of topic "%s" has no committed offset to shift`, partition.Partition, *partition.Topic)
+		}
+		return clampOffset(int64(partition.Offset)+by, low, high), nil
+	}
+}
@@ -0,0 +1,181 @@
+package kafka
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+)
+
+// fakeOffsetClient serves topic "orders" with partitions 0 and 1, which contain offsets 10 to 100
+type fakeOffsetClient struct {
+	committed map[int32]ckafka.Offset
+}
+
+func (f *fakeOffsetClient) Committed(partitions []ckafka.TopicPartition, _ int) ([]ckafka.TopicPartition, error) {
+	committed := make([]ckafka.TopicPartition, len(partitions))
+	for i, partition := range partitions {
+		partition.Offset = ckafka.OffsetInvalid
+		if offset, ok := f.committed[partition.Partition]; ok && *partition.Topic == "orders" {
+			partition.Offset = offset
+		}
+		committed[i] = partition
+	}
+	return committed, nil
+}
+
+func (f *fakeOffsetClient) CommitOffsets(offsets []ckafka.TopicPartition) ([]ckafka.TopicPartition, error) {
+	return offsets, nil
+}
+
+func (f *fakeOffsetClient) GetMetadata(_ *string, _ bool, _ int) (*ckafka.Metadata, error) {
+	return &ckafka.Metadata{Topics: map[string]ckafka.TopicMetadata{
+		"orders":   {Topic: "orders", Partitions: []ckafka.PartitionMetadata{{ID: 0}, {ID: 1}}},
+		"payments": {Topic: "payments", Partitions: []ckafka.PartitionMetadata{{ID: 0}}},
+	}}, nil
+}
+
+func (f *fakeOffsetClient) OffsetsForTimes(times []ckafka.TopicPartition, _ int) ([]ckafka.TopicPartition, error) {
+	offsets := make([]ckafka.TopicPartition, len(times))
+	for i, partition := range times {
+		// Messages of partition 0 are produced every second from the Unix epoch, partition 1 has no new messages
+		partition.Offset = ckafka.OffsetEnd
+		if partition.Partition == 0 {
+			partition.Offset = ckafka.Offset(times[i].Offset/1000 + 10)
+		}
+		offsets[i] = partition
+	}
+	return offsets, nil
+}
+
+func (f *fakeOffsetClient) QueryWatermarkOffsets(_ string, _ int32, _ int) (int64, int64, error) {
+	return 10, 100, nil
+}
+
+func getOrdersPartitions(offsets ...ckafka.Offset) []ckafka.TopicPartition {
+	topic := "orders"
+	partitions := make([]ckafka.TopicPartition, len(offsets))
+	for i, offset := range offsets {
+		partitions[i] = ckafka.TopicPartition{Topic: &topic, Partition: int32(i), Offset: offset}
+	}
+	return partitions
+}
+
+func TestGetTopicPartitions(t *testing.T) {
+	client := &fakeOffsetClient{}
+
+	partitions, err := getTopicPartitions(client, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, partitions, 3)
+
+	partitions, err = getTopicPartitions(client, []string{"orders"}, []int32{1})
+	require.NoError(t, err)
+	require.Len(t, partitions, 1)
+	require.Equal(t, "orders", *partitions[0].Topic)
+	require.Equal(t, int32(1), partitions[0].Partition)
+
+	_, err = getTopicPartitions(client, []string{"shipments"}, nil)
+	require.EqualError(t, err, `unknown topic "shipments"`)
+
+	_, err = getTopicPartitions(client, []string{"orders"}, []int32{2})
+	require.EqualError(t, err, `partition 2 not found in topic "orders"`)
+}
+
+func TestGetResetPlan(t *testing.T) {
+	client := &fakeOffsetClient{}
+	committed := getOrdersPartitions(50, ckafka.OffsetInvalid)
+
+	tests := []struct {
+		strategy resetStrategy
+		expected []int64
+	}{
+		{func(_ offsetClient, _ ckafka.TopicPartition, low, _ int64) (int64, error) { return low, nil }, []int64{10, 10}},
+		{func(_ offsetClient, _ ckafka.TopicPartition, _, high int64) (int64, error) { return high, nil }, []int64{100, 100}},
+		{func(client offsetClient, partition ckafka.TopicPartition, _, high int64) (int64, error) {
+			return getOffsetForTime(client, partition, time.Unix(30, 0), high)
+		}, []int64{40, 100}},
+	}
+
+	for _, test := range tests {
+		plan, err := getResetPlan(client, committed, test.strategy)
+		require.NoError(t, err)
+		require.Equal(t, []*offsetOut{
+			{Topic: "orders", Partition: 0, CurrentOffset: 50, NewOffset: test.expected[0]},
+			{Topic: "orders", Partition: 1, CurrentOffset: -1, NewOffset: test.expected[1]},
+		}, plan)
+	}
+}
+
+func TestGetResetPlanShift(t *testing.T) {
+	client := &fakeOffsetClient{}
+
+	plan, err := getResetPlan(client, getOrdersPartitions(50, 95), getShiftStrategy(10))
+	require.NoError(t, err)
+	require.Equal(t, []*offsetOut{
+		{Topic: "orders", Partition: 0, CurrentOffset: 50, NewOffset: 60},
+		{Topic: "orders", Partition: 1, CurrentOffset: 95, NewOffset: 100},
+	}, plan)
+
+	plan, err = getResetPlan(client, getOrdersPartitions(50, 15), getShiftStrategy(-10))
+	require.NoError(t, err)
+	require.Equal(t, int64(40), plan[0].NewOffset)
+	require.Equal(t, int64(10), plan[1].NewOffset)
+
+	_, err = getResetPlan(client, getOrdersPartitions(ckafka.OffsetInvalid), getShiftStrategy(10))
+	require.EqualError(t, err, `partition 0 of topic "orders" has no committed offset to shift`)
+}
+
+func TestGetImportPlan(t *testing.T) {
+	client := &fakeOffsetClient{committed: map[int32]ckafka.Offset{0: 50}}
+
+	plan, err := getImportPlan(client, []groupOffset{
+		{Topic: "orders", Partition: 0, Offset: 20},
+		{Topic: "orders", Partition: 1, Offset: 30},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*offsetOut{
+		{Topic: "orders", Partition: 0, CurrentOffset: 50, NewOffset: 20},
+		{Topic: "orders", Partition: 1, CurrentOffset: -1, NewOffset: 30},
+	}, plan)
+}
+
+func TestGroupOffsetsFile(t *testing.T) {
+	offsets := []groupOffset{
+		{Topic: "orders", Partition: 0, Offset: 20},
+		{Topic: "orders", Partition: 1, Offset: 30},
+	}
+
+	for _, file := range []string{"offsets.csv", "offsets.json"} {
+		path := filepath.Join(t.TempDir(), file)
+		require.NoError(t, writeGroupOffsets(path, offsets))
+
+		read, err := readGroupOffsets(path)
+		require.NoError(t, err)
+		require.Equal(t, offsets, read)
+	}
+}
+
+func TestReadGroupOffsetsInvalid(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := readGroupOffsets(filepath.Join(dir, "offsets.txt"))
+	require.EqualError(t, err, `unsupported file extension ".txt": use ".csv" or ".json"`)
+
+	path := filepath.Join(dir, "offsets.csv")
+	require.NoError(t, os.WriteFile(path, []byte("orders,0,ten\n"), 0644))
+	_, err = readGroupOffsets(path)
+	require.ErrorContains(t, err, `invalid offset "ten" on line 1`)
+
+	require.NoError(t, os.WriteFile(path, []byte(""), 0644))
+	_, err = readGroupOffsets(path)
+	require.ErrorContains(t, err, "no offsets found in file")
+}
+
+func TestCatchCommitOffsetsError(t *testing.T) {
+	err := catchCommitOffsetsError(ckafka.NewError(ckafka.ErrUnknownMemberID, "", false), "my-group")
+	require.EqualError(t, err, `failed to commit offsets: consumer group "my-group" is active`)
+}
@@ -35,6 +35,7 @@ func newMirrorCommand(prerunner pcmd.PreRunner) *cobra.Command {
 	c := &mirrorCommand{pcmd.NewAuthenticatedCLICommand(cmd, prerunner)}
 
 	cmd.AddCommand(c.newCreateCommand())
+	cmd.AddCommand(c.newCutoverCommand())
 	cmd.AddCommand(c.newDescribeCommand())
 	cmd.AddCommand(c.newFailoverCommand())
 	cmd.AddCommand(c.newListCommand())
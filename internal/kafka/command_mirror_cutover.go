@@ -0,0 +1,320 @@
+package kafka
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+	"github.com/confluentinc/cli/v3/pkg/utils"
+)
+
+const (
+	cutoverPending   = "pending"
+	cutoverSucceeded = "succeeded"
+	cutoverFailed    = "failed"
+	cutoverSkipped   = "skipped"
+)
+
+var cutoverActions = []string{"failover", "promote"}
+
+// cutoverReport is the outcome of each mirror topic of a cutover, saved after each batch so that an interrupted
+// cutover can be resumed
+type cutoverReport struct {
+	Link   string          `json:"link"`
+	Action string          `json:"action"`
+	Topics []*cutoverTopic `json:"topics"`
+}
+
+type cutoverTopic struct {
+	MirrorTopicName string `json:"mirror_topic_name"`
+	MaxLag          int64  `json:"max_lag"`
+	Status          string `json:"status"`
+	ErrorMessage    string `json:"error_message,omitempty"`
+}
+
+type cutoverOut struct {
+	MirrorTopicName string `human:"Mirror Topic Name" serialized:"mirror_topic_name"`
+	MaxLag          int64  `human:"Max Per Partition Mirror Lag" serialized:"max_per_partition_mirror_lag"`
+	Status          string `human:"Status" serialized:"status"`
+	ErrorMessage    string `human:"Error Message" serialized:"error_message"`
+}
+
+type alterMirrorsFunc func(link string, validateOnly bool, data kafkarestv3.AlterMirrorsRequestData) ([]kafkarestv3.AlterMirrorStatusResponseData, error)
+
+func (c *mirrorCommand) newCutoverCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cutover",
+		Short: "Fail over or promote the mirror topics of a cluster link in batches.",
+		Long:  "Fail over or promote all mirror topics of a cluster link, or those matching a pattern, in batches. Mirror topics whose mirror lag exceeds a threshold, or which are not active or paused, are skipped.\n\nThe outcome of each mirror topic is saved to a JSON report file after each batch. If the report file already exists, the cutover resumes from it: mirror topics that succeeded are not altered again, and all others are retried.",
+		Args:  cobra.NoArgs,
+		RunE:  c.cutover,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Fail over all mirror topics of cluster link "my-link" whose mirror lag is at most 1000 messages per partition, and save the outcomes to "report.json".`,
+				Code: "confluent kafka mirror cutover --link my-link --max-lag 1000 --report-file report.json",
+			},
+			examples.Example{
+				Text: `Promote the mirror topics of cluster link "my-link" whose names start with "orders-", in batches of 5.`,
+				Code: `confluent kafka mirror cutover --link my-link --action promote --topic-pattern "orders-.*" --batch-size 5 --report-file report.json`,
+			},
+		),
+	}
+
+	pcmd.AddLinkFlag(cmd, c.AuthenticatedCLICommand)
+	cmd.Flags().String("report-file", "", "Path of the JSON report file to save the outcome of each mirror topic to, and to resume from.")
+	cmd.Flags().String("action", "failover", fmt.Sprintf("Action to take on mirror topics. Can be %s.", utils.ArrayToCommaDelimitedString(cutoverActions, "or")))
+	cmd.Flags().String("topic-pattern", "", "Regular expression that the names of mirror topics must fully match. Defaults to all mirror topics.")
+	cmd.Flags().Int64("max-lag", 0, "Maximum mirror lag of any partition of a mirror topic to fail it over or promote it.")
+	cmd.Flags().Int("batch-size", 10, "Number of mirror topics to fail over or promote per request.")
+	cmd.Flags().Bool(dryrunFlagName, false, "Validate the actions on mirror topics without taking them or writing the report file.")
+	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	pcmd.RegisterFlagCompletionFunc(cmd, "action", func(_ *cobra.Command, _ []string) []string { return cutoverActions })
+
+	cobra.CheckErr(cmd.MarkFlagFilename("report-file", "json"))
+
+	cobra.CheckErr(cmd.MarkFlagRequired("link"))
+	cobra.CheckErr(cmd.MarkFlagRequired("report-file"))
+
+	return cmd
+}
+
+func (c *mirrorCommand) cutover(cmd *cobra.Command, _ []string) error {
+	link, err := cmd.Flags().GetString("link")
+	if err != nil {
+		return err
+	}
+
+	reportFile, err := cmd.Flags().GetString("report-file")
+	if err != nil {
+		return err
+	}
+
+	action, err := cmd.Flags().GetString("action")
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(cutoverActions, action) {
+		return fmt.Errorf(`invalid action "%s": use %s`, action, utils.ArrayToCommaDelimitedString(cutoverActions, "or"))
+	}
+
+	topicPattern, err := cmd.Flags().GetString("topic-pattern")
+	if err != nil {
+		return err
+	}
+	pattern, err := regexp.Compile("^(?:" + topicPattern + ")$")
+	if err != nil {
+		return fmt.Errorf(`invalid topic pattern "%s": %w`, topicPattern, err)
+	}
+
+	maxLag, err := cmd.Flags().GetInt64("max-lag")
+	if err != nil {
+		return err
+	}
+
+	batchSize, err := cmd.Flags().GetInt("batch-size")
+	if err != nil {
+		return err
+	}
+	if batchSize < 1 {
+		return fmt.Errorf("batch size must be at least 1")
+	}
+
+	dryRun, err := cmd.Flags().GetBool(dryrunFlagName)
+	if err != nil {
+		return err
+	}
+
+	report, err := readCutoverReport(reportFile, link, action)
+	if err != nil {
+		return err
+	}
+
+	kafkaREST, err := c.GetKafkaREST()
+	if err != nil {
+		return err
+	}
+
+	mirrors, err := kafkaREST.CloudClient.ListKafkaMirrorTopicsUnderLink(link, nil)
+	if err != nil {
+		return err
+	}
+
+	planCutover(report, mirrors, pattern, maxLag)
+
+	alterMirrors := kafkaREST.CloudClient.UpdateKafkaMirrorTopicsFailover
+	if action == "promote" {
+		alterMirrors = kafkaREST.CloudClient.UpdateKafkaMirrorTopicsPromote
+	}
+
+	save := func() error {
+		if dryRun {
+			return nil
+		}
+		return writeCutoverReport(reportFile, report)
+	}
+
+	err = runCutover(report, alterMirrors, batchSize, dryRun, save, func(batch, batches int) {
+		output.ErrPrintf(c.Config.EnableColor, "Running batch %d of %d.\n", batch, batches)
+	})
+
+	list := output.NewList(cmd)
+	list.Sort(false)
+	for _, topic := range report.Topics {
+		list.Add(&cutoverOut{
+			MirrorTopicName: topic.MirrorTopicName,
+			MaxLag:          topic.MaxLag,
+			Status:          topic.Status,
+			ErrorMessage:    topic.ErrorMessage,
+		})
+	}
+	if err := list.Print(); err != nil {
+		return err
+	}
+
+	return err
+}
+
+func readCutoverReport(path, link, action string) (*cutoverReport, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &cutoverReport{Link: link, Action: action}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	report := new(cutoverReport)
+	if err := json.Unmarshal(data, report); err != nil {
+		return nil, fmt.Errorf(`failed to parse report file "%s": %w`, path, err)
+	}
+	if report.Link != link || report.Action != action {
+		return nil, fmt.Errorf(`report file "%s" belongs to the %s of cluster link "%s": use a different report file`, path, report.Action, report.Link)
+	}
+	return report, nil
+}
+
+func writeCutoverReport(path string, report *cutoverReport) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// planCutover marks the mirror topics matching the pattern as pending, or as skipped if they cannot be altered. Mirror
+// topics that already succeeded in a previous run are kept as they are.
+func planCutover(report *cutoverReport, mirrors []kafkarestv3.ListMirrorTopicsResponseData, pattern *regexp.Regexp, maxLag int64) {
+	topics := make(map[string]*cutoverTopic)
+	for _, topic := range report.Topics {
+		topics[topic.MirrorTopicName] = topic
+	}
+
+	for _, mirror := range mirrors {
+		name := mirror.GetMirrorTopicName()
+		if !pattern.MatchString(name) {
+			continue
+		}
+
+		topic, ok := topics[name]
+		if !ok {
+			topic = &cutoverTopic{MirrorTopicName: name}
+			topics[name] = topic
+			report.Topics = append(report.Topics, topic)
+		}
+		if topic.Status == cutoverSucceeded {
+			continue
+		}
+
+		topic.MaxLag = 0
+		for _, mirrorLag := range mirror.GetMirrorLags().Items {
+			topic.MaxLag = max(topic.MaxLag, mirrorLag.GetLag())
+		}
+
+		topic.Status = cutoverPending
+		topic.ErrorMessage = ""
+		if status := mirror.GetMirrorStatus(); status != kafkarestv3.ACTIVE && status != kafkarestv3.PAUSED {
+			topic.Status = cutoverSkipped
+			topic.ErrorMessage = fmt.Sprintf("mirror status is %s", status)
+		} else if topic.MaxLag > maxLag {
+			topic.Status = cutoverSkipped
+			topic.ErrorMessage = fmt.Sprintf("mirror lag %d exceeds the maximum of %d", topic.MaxLag, maxLag)
+		}
+	}
+
+	slices.SortFunc(report.Topics, func(a, b *cutoverTopic) int { return strings.Compare(a.MirrorTopicName, b.MirrorTopicName) })
+}
+
+// runCutover alters the pending mirror topics in batches, saving the report after each batch. A failed request stops
+// the cutover, so that it can be resumed from the report.
+func runCutover(report *cutoverReport, alterMirrors alterMirrorsFunc, batchSize int, dryRun bool, save func() error, progress func(int, int)) error {
+	var pending []*cutoverTopic
+	for _, topic := range report.Topics {
+		if topic.Status == cutoverPending {
+			pending = append(pending, topic)
+		}
+	}
+
+	if err := save(); err != nil {
+		return err
+	}
+
+	batches := (len(pending) + batchSize - 1) / batchSize
+	for i := 0; i < batches; i++ {
+		batch := pending[i*batchSize : min((i+1)*batchSize, len(pending))]
+		progress(i+1, batches)
+
+		names := make([]string, len(batch))
+		for j, topic := range batch {
+			names[j] = topic.MirrorTopicName
+		}
+
+		results, err := alterMirrors(report.Link, dryRun, kafkarestv3.AlterMirrorsRequestData{MirrorTopicNames: &names})
+		if err != nil {
+			for _, topic := range batch {
+				topic.Status = cutoverFailed
+				topic.ErrorMessage = err.Error()
+			}
+			if err := save(); err != nil {
+				return err
+			}
+			return fmt.Errorf("failed to %s batch %d of %d: %w", report.Action, i+1, batches, err)
+		}
+
+		errorMessages := make(map[string]string)
+		for _, result := range results {
+			errorMessages[result.GetMirrorTopicName()] = result.GetErrorMessage()
+		}
+		for _, topic := range batch {
+			errorMessage, ok := errorMessages[topic.MirrorTopicName]
+			switch {
+			case !ok:
+				topic.Status = cutoverFailed
+				topic.ErrorMessage = "no result was returned"
+			case errorMessage != "":
+				topic.Status = cutoverFailed
+				topic.ErrorMessage = errorMessage
+			default:
+				topic.Status = cutoverSucceeded
+			}
+		}
+
+		if err := save(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package kafka
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
+)
+
+func newMirror(name string, status kafkarestv3.MirrorTopicStatus, lags ...int64) kafkarestv3.ListMirrorTopicsResponseData {
+	mirror := kafkarestv3.ListMirrorTopicsResponseData{MirrorTopicName: name, MirrorStatus: status}
+	for i, lag := range lags {
+		mirror.MirrorLags.Items = append(mirror.MirrorLags.Items, kafkarestv3.MirrorLag{Partition: int32(i), Lag: lag})
+	}
+	return mirror
+}
+
+func TestPlanCutover(t *testing.T) {
+	report := &cutoverReport{
+		Link:   "my-link",
+		Action: "failover",
+		Topics: []*cutoverTopic{
+			{MirrorTopicName: "orders-1", Status: cutoverSucceeded},
+			{MirrorTopicName: "orders-2", Status: cutoverFailed, ErrorMessage: "Not authorized"},
+		},
+	}
+	mirrors := []kafkarestv3.ListMirrorTopicsResponseData{
+		newMirror("orders-1", kafkarestv3.STOPPED),
+		newMirror("orders-2", kafkarestv3.ACTIVE, 0, 5),
+		newMirror("orders-3", kafkarestv3.PAUSED, 20),
+		newMirror("orders-4", kafkarestv3.LINK_FAILED),
+		newMirror("payments", kafkarestv3.ACTIVE),
+	}
+
+	planCutover(report, mirrors, regexp.MustCompile("^(?:orders-.*)$"), 10)
+	require.Equal(t, []*cutoverTopic{
+		{MirrorTopicName: "orders-1", Status: cutoverSucceeded},
+		{MirrorTopicName: "orders-2", MaxLag: 5, Status: cutoverPending},
+		{MirrorTopicName: "orders-3", MaxLag: 20, Status: cutoverSkipped, ErrorMessage: "mirror lag 20 exceeds the maximum of 10"},
+		{MirrorTopicName: "orders-4", Status: cutoverSkipped, ErrorMessage: "mirror status is LINK_FAILED"},
+	}, report.Topics)
+}
+
+func TestRunCutover(t *testing.T) {
+	report := &cutoverReport{Link: "my-link", Action: "failover"}
+	for i := 1; i <= 5; i++ {
+		report.Topics = append(report.Topics, &cutoverTopic{MirrorTopicName: fmt.Sprintf("topic-%d", i), Status: cutoverPending})
+	}
+
+	var requests [][]string
+	alterMirrors := func(link string, validateOnly bool, data kafkarestv3.AlterMirrorsRequestData) ([]kafkarestv3.AlterMirrorStatusResponseData, error) {
+		require.Equal(t, "my-link", link)
+		require.False(t, validateOnly)
+		names := data.GetMirrorTopicNames()
+		requests = append(requests, names)
+		if names[0] == "topic-5" {
+			return nil, fmt.Errorf("connection refused")
+		}
+		results := make([]kafkarestv3.AlterMirrorStatusResponseData, len(names))
+		for i, name := range names {
+			results[i] = kafkarestv3.AlterMirrorStatusResponseData{MirrorTopicName: name}
+		}
+		results[0].ErrorMessage = *kafkarestv3.NewNullableString(kafkarestv3.PtrString("Not authorized"))
+		return results, nil
+	}
+
+	saves := 0
+	save := func() error {
+		saves++
+		return nil
+	}
+
+	err := runCutover(report, alterMirrors, 2, false, save, func(int, int) {})
+	require.EqualError(t, err, "failed to failover batch 3 of 3: connection refused")
+	require.Equal(t, [][]string{{"topic-1", "topic-2"}, {"topic-3", "topic-4"}, {"topic-5"}}, requests)
+	require.Equal(t, 4, saves)
+
+	var statuses []string
+	for _, topic := range report.Topics {
+		statuses = append(statuses, topic.Status)
+	}
+	require.Equal(t, []string{cutoverFailed, cutoverSucceeded, cutoverFailed, cutoverSucceeded, cutoverFailed}, statuses)
+	require.Equal(t, "connection refused", report.Topics[4].ErrorMessage)
+}
+
+func TestCutoverReportFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.json")
+
+	report, err := readCutoverReport(path, "my-link", "failover")
+	require.NoError(t, err)
+	require.Equal(t, &cutoverReport{Link: "my-link", Action: "failover"}, report)
+
+	report.Topics = []*cutoverTopic{{MirrorTopicName: "orders", MaxLag: 5, Status: cutoverSucceeded}}
+	require.NoError(t, writeCutoverReport(path, report))
+
+	read, err := readCutoverReport(path, "my-link", "failover")
+	require.NoError(t, err)
+	require.Equal(t, report, read)
+
+	_, err = readCutoverReport(path, "my-link", "promote")
+	require.ErrorContains(t, err, `belongs to the failover of cluster link "my-link"`)
+}
@@ -1,14 +1,188 @@
 package kafka
 
-import "github.com/spf13/cobra"
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/antihax/optional"
+	"github.com/spf13/cobra"
+
+	"github.com/confluentinc/kafka-rest-sdk-go/kafkarestv3"
+
+	"github.com/confluentinc/cli/v3/pkg/kafkarest"
+)
+
+const (
+	leaderThrottledRate       = "leader.replication.throttled.rate"
+	followerThrottledRate     = "follower.replication.throttled.rate"
+	leaderThrottledReplicas   = "leader.replication.throttled.replicas"
+	followerThrottledReplicas = "follower.replication.throttled.replicas"
+)
+
+// reassignmentPlan is the JSON file format of "kafka-reassign-partitions"
+type reassignmentPlan struct {
+	Version    int                   `json:"version"`
+	Partitions []partitionAssignment `json:"partitions"`
+}
+
+type partitionAssignment struct {
+	Topic     string  `json:"topic"`
+	Partition int32   `json:"partition"`
+	Replicas  []int32 `json:"replicas"`
+}
 
 func (c *partitionCommand) newReassignmentCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "reassignment",
-		Short: "Manage ongoing partition reassignments.",
+		Short: "Manage partition reassignments.",
 	}
 
+	cmd.AddCommand(c.newReassignmentGenerateCommand())
 	cmd.AddCommand(c.newReassignmentListCommand())
+	cmd.AddCommand(c.newReassignmentThrottleCommand())
 
 	return cmd
 }
+
+func readReassignmentPlan(path string) (*reassignmentPlan, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := new(reassignmentPlan)
+	if err := json.Unmarshal(data, plan); err != nil {
+		return nil, fmt.Errorf(`failed to parse reassignment plan "%s": %w`, path, err)
+	}
+	if len(plan.Partitions) == 0 {
+		return nil, fmt.Errorf(`reassignment plan "%s" doesn't contain any partitions`, path)
+	}
+	return plan, nil
+}
+
+// getCurrentPartitionAssignments returns the replicas of each partition of the cluster, with the leader first
+func getCurrentPartitionAssignments(restClient *kafkarestv3.APIClient, restContext context.Context, clusterId string) ([]partitionAssignment, error) {
+	replicas, resp, err := restClient.ReplicaStatusApi.ClustersClusterIdTopicsPartitionsReplicaStatusGet(restContext, clusterId)
+	if err != nil {
+		return nil, kafkarest.NewError(restClient.GetConfig().BasePath, err, resp)
+	}
+	return getPartitionAssignments(replicas.Data), nil
+}
+
+// setThrottle limits the rate at which replicas are moved, like "kafka-reassign-partitions". The rate applies to the
+// current replicas of the moved partitions, which send data as leaders, and to their new replicas, which fetch it.
+func setThrottle(restClient *kafkarestv3.APIClient, restContext context.Context, clusterId string, rate int64, current, proposed []partitionAssignment) error {
+	if err := setThrottleRate(restClient, restContext, clusterId, rate); err != nil {
+		return err
+	}
+
+	leaders, followers := getThrottledReplicas(current, proposed)
+	for _, topic := range getPlanTopics(proposed) {
+		leaderReplicas := leaders[topic]
+		followerReplicas := followers[topic]
+		configs := []kafkarestv3.AlterConfigBatchRequestDataData{
+			{Name: leaderThrottledReplicas, Value: &leaderReplicas},
+			{Name: followerThrottledReplicas, Value: &followerReplicas},
+		}
+		if err := alterTopicConfigs(restClient, restContext, clusterId, topic, configs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getThrottledReplicas returns the values of the leader and follower throttled replicas configs of each topic, which
+// list replicas as "<partition>:<broker>"
+func getThrottledReplicas(current, proposed []partitionAssignment) (map[string]string, map[string]string) {
+	currentReplicas := make(map[string]map[int32][]int32)
+	for _, assignment := range current {
+		if currentReplicas[assignment.Topic] == nil {
+			currentReplicas[assignment.Topic] = make(map[int32][]int32)
+		}
+		currentReplicas[assignment.Topic][assignment.Partition] = assignment.Replicas
+	}
+
+	leaders := make(map[string][]string)
+	followers := make(map[string][]string)
+	for _, assignment := range proposed {
+		replicas := currentReplicas[assignment.Topic][assignment.Partition]
+		for _, broker := range replicas {
+			leaders[assignment.Topic] = append(leaders[assignment.Topic], fmt.Sprintf("%d:%d", assignment.Partition, broker))
+		}
+		for _, broker := range assignment.Replicas {
+			if !slices.Contains(replicas, broker) {
+				followers[assignment.Topic] = append(followers[assignment.Topic], fmt.Sprintf("%d:%d", assignment.Partition, broker))
+			}
+		}
+	}
+
+	leaderReplicas := make(map[string]string)
+	followerReplicas := make(map[string]string)
+	for _, topic := range getPlanTopics(proposed) {
+		leaderReplicas[topic] = strings.Join(leaders[topic], ",")
+		followerReplicas[topic] = strings.Join(followers[topic], ",")
+	}
+	return leaderReplicas, followerReplicas
+}
+
+func setThrottleRate(restClient *kafkarestv3.APIClient, restContext context.Context, clusterId string, rate int64) error {
+	value := fmt.Sprint(rate)
+	return alterBrokerConfigs(restClient, restContext, clusterId, []kafkarestv3.AlterConfigBatchRequestDataData{
+		{Name: leaderThrottledRate, Value: &value},
+		{Name: followerThrottledRate, Value: &value},
+	})
+}
+
+// removeThrottle removes the throttle rate of the brokers and the throttled replicas of the topics in the plan
+func removeThrottle(restClient *kafkarestv3.APIClient, restContext context.Context, clusterId string, partitions []partitionAssignment) error {
+	deleteOperation := "DELETE"
+	err := alterBrokerConfigs(restClient, restContext, clusterId, []kafkarestv3.AlterConfigBatchRequestDataData{
+		{Name: leaderThrottledRate, Operation: &deleteOperation},
+		{Name: followerThrottledRate, Operation: &deleteOperation},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, topic := range getPlanTopics(partitions) {
+		configs := []kafkarestv3.AlterConfigBatchRequestDataData{
+			{Name: leaderThrottledReplicas, Operation: &deleteOperation},
+			{Name: followerThrottledReplicas, Operation: &deleteOperation},
+		}
+		if err := alterTopicConfigs(restClient, restContext, clusterId, topic, configs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func alterBrokerConfigs(restClient *kafkarestv3.APIClient, restContext context.Context, clusterId string, configs []kafkarestv3.AlterConfigBatchRequestDataData) error {
+	opts := &kafkarestv3.UpdateKafkaClusterConfigsOpts{AlterConfigBatchRequestData: optional.NewInterface(kafkarestv3.AlterConfigBatchRequestData{Data: configs})}
+	if resp, err := restClient.ConfigsV3Api.UpdateKafkaClusterConfigs(restContext, clusterId, opts); err != nil {
+		return kafkarest.NewError(restClient.GetConfig().BasePath, err, resp)
+	}
+	return nil
+}
+
+func alterTopicConfigs(restClient *kafkarestv3.APIClient, restContext context.Context, clusterId, topic string, configs []kafkarestv3.AlterConfigBatchRequestDataData) error {
+	opts := &kafkarestv3.UpdateKafkaTopicConfigBatchOpts{AlterConfigBatchRequestData: optional.NewInterface(kafkarestv3.AlterConfigBatchRequestData{Data: configs})}
+	if resp, err := restClient.ConfigsV3Api.UpdateKafkaTopicConfigBatch(restContext, clusterId, topic, opts); err != nil {
+		return kafkarest.NewError(restClient.GetConfig().BasePath, err, resp)
+	}
+	return nil
+}
+
+func getPlanTopics(partitions []partitionAssignment) []string {
+	var topics []string
+	for _, partition := range partitions {
+		if !slices.Contains(topics, partition.Topic) {
+			topics = append(topics, partition.Topic)
+		}
+	}
+	slices.Sort(topics)
+	return topics
+}
@@ -0,0 +1,363 @@
+package kafka
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/confluentinc/kafka-rest-sdk-go/kafkarestv3"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/kafkarest"
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+type reassignmentPlanOutHuman struct {
+	TopicName        string `human:"Topic Name"`
+	PartitionId      int32  `human:"Partition ID"`
+	CurrentReplicas  string `human:"Current Replicas"`
+	ProposedReplicas string `human:"Proposed Replicas"`
+}
+
+type reassignmentPlanOutSerialized struct {
+	TopicName        string  `serialized:"topic_name"`
+	PartitionId      int32   `serialized:"partition_id"`
+	CurrentReplicas  []int32 `serialized:"current_replicas"`
+	ProposedReplicas []int32 `serialized:"proposed_replicas"`
+}
+
+type reassignmentOptions struct {
+	topics            []string
+	balance           bool
+	removeBrokers     []int32
+	replicationFactor int
+}
+
+func (c *partitionCommand) newReassignmentGenerateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate",
+		Short: "Generate a partition reassignment plan.",
+		Long:  "Generate a plan to spread replicas evenly across brokers, to move replicas off brokers being decommissioned, or to change the replication factor of topics.\n\nA plan saved with `--output-file` is in the format of `kafka-reassign-partitions`, and is executed with `kafka-reassign-partitions --execute --reassignment-json-file`. Replicas of the plan are moved at a limited rate after `confluent kafka partition reassignment throttle --rate --input-file`. Ongoing reassignments are listed with `confluent kafka partition reassignment list`.",
+		Args:  cobra.NoArgs,
+		RunE:  c.reassignmentGenerate,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Generate a plan to spread the replicas of all topics evenly across brokers, and save it to "plan.json".`,
+				Code: "confluent kafka partition reassignment generate --balance --output-file plan.json",
+			},
+			examples.Example{
+				Text: "Generate a plan to move all replicas off brokers 4 and 5.",
+				Code: "confluent kafka partition reassignment generate --remove-brokers 4,5",
+			},
+			examples.Example{
+				Text: `Generate a plan to change the replication factor of topic "my_topic" to 3.`,
+				Code: "confluent kafka partition reassignment generate --topics my_topic --replication-factor 3",
+			},
+		),
+	}
+
+	cmd.Flags().StringSlice("topics", nil, "A comma-separated list of topics to reassign. Defaults to all topics.")
+	cmd.Flags().Bool("balance", false, "Spread replicas evenly across brokers.")
+	cmd.Flags().IntSlice("remove-brokers", nil, "A comma-separated list of broker IDs to move all replicas off.")
+	cmd.Flags().Int("replication-factor", 0, "New replication factor of the topics.")
+	cmd.Flags().String("output-file", "", "Path of the JSON file to save the plan to.")
+	cmd.Flags().AddFlagSet(pcmd.OnPremKafkaRestSet())
+	pcmd.AddOutputFlag(cmd)
+
+	cmd.MarkFlagsOneRequired("balance", "remove-brokers", "replication-factor")
+
+	return cmd
+}
+
+func (c *partitionCommand) reassignmentGenerate(cmd *cobra.Command, _ []string) error {
+	opts, err := getReassignmentOptions(cmd)
+	if err != nil {
+		return err
+	}
+
+	outputFile, err := cmd.Flags().GetString("output-file")
+	if err != nil {
+		return err
+	}
+
+	restClient, restContext, clusterId, err := initKafkaRest(c.AuthenticatedCLICommand, cmd)
+	if err != nil {
+		return err
+	}
+
+	brokers, resp, err := restClient.BrokerV3Api.ClustersClusterIdBrokersGet(restContext, clusterId)
+	if err != nil {
+		return kafkarest.NewError(restClient.GetConfig().BasePath, err, resp)
+	}
+	brokerIds := make([]int32, len(brokers.Data))
+	for i, broker := range brokers.Data {
+		brokerIds[i] = broker.BrokerId
+	}
+
+	current, err := getCurrentPartitionAssignments(restClient, restContext, clusterId)
+	if err != nil {
+		return err
+	}
+
+	proposed, err := generateReassignment(brokerIds, current, opts)
+	if err != nil {
+		return err
+	}
+
+	if outputFile != "" {
+		out, err := json.MarshalIndent(reassignmentPlan{Version: 1, Partitions: proposed}, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(outputFile, append(out, '\n'), 0644); err != nil {
+			return err
+		}
+	}
+
+	currentReplicas := make(map[string]map[int32][]int32)
+	for _, assignment := range current {
+		if currentReplicas[assignment.Topic] == nil {
+			currentReplicas[assignment.Topic] = make(map[int32][]int32)
+		}
+		currentReplicas[assignment.Topic][assignment.Partition] = assignment.Replicas
+	}
+
+	list := output.NewList(cmd)
+	list.Sort(false)
+	for _, assignment := range proposed {
+		replicas := currentReplicas[assignment.Topic][assignment.Partition]
+		if output.GetFormat(cmd) == output.Human {
+			list.Add(&reassignmentPlanOutHuman{
+				TopicName:        assignment.Topic,
+				PartitionId:      assignment.Partition,
+				CurrentReplicas:  join(replicas),
+				ProposedReplicas: join(assignment.Replicas),
+			})
+		} else {
+			list.Add(&reassignmentPlanOutSerialized{
+				TopicName:        assignment.Topic,
+				PartitionId:      assignment.Partition,
+				CurrentReplicas:  replicas,
+				ProposedReplicas: assignment.Replicas,
+			})
+		}
+	}
+	if err := list.Print(); err != nil {
+		return err
+	}
+
+	if outputFile != "" && output.GetFormat(cmd) == output.Human {
+		output.Printf(c.Config.EnableColor, "Saved the reassignment plan of %d partitions to \"%s\".\n", len(proposed), outputFile)
+	}
+	return nil
+}
+
+func getReassignmentOptions(cmd *cobra.Command) (*reassignmentOptions, error) {
+	topics, err := cmd.Flags().GetStringSlice("topics")
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := cmd.Flags().GetBool("balance")
+	if err != nil {
+		return nil, err
+	}
+
+	removeBrokers, err := cmd.Flags().GetIntSlice("remove-brokers")
+	if err != nil {
+		return nil, err
+	}
+
+	replicationFactor, err := cmd.Flags().GetInt("replication-factor")
+	if err != nil {
+		return nil, err
+	}
+	if cmd.Flags().Changed("replication-factor") && replicationFactor < 1 {
+		return nil, fmt.Errorf("replication factor must be at least 1")
+	}
+
+	opts := &reassignmentOptions{
+		topics:            topics,
+		balance:           balance,
+		removeBrokers:     make([]int32, len(removeBrokers)),
+		replicationFactor: replicationFactor,
+	}
+	for i, broker := range removeBrokers {
+		opts.removeBrokers[i] = int32(broker)
+	}
+	return opts, nil
+}
+
+// getPartitionAssignments returns the replicas of each partition, with the leader first
+func getPartitionAssignments(replicas []kafkarestv3.ReplicaStatusData) []partitionAssignment {
+	var assignments []partitionAssignment
+	indexes := make(map[string]map[int32]int)
+
+	for _, replica := range replicas {
+		if indexes[replica.TopicName] == nil {
+			indexes[replica.TopicName] = make(map[int32]int)
+		}
+
+		i, ok := indexes[replica.TopicName][replica.PartitionId]
+		if !ok {
+			i = len(assignments)
+			indexes[replica.TopicName][replica.PartitionId] = i
+			assignments = append(assignments, partitionAssignment{Topic: replica.TopicName, Partition: replica.PartitionId})
+		}
+
+		if replica.IsLeader {
+			assignments[i].Replicas = append([]int32{replica.BrokerId}, assignments[i].Replicas...)
+		} else {
+			assignments[i].Replicas = append(assignments[i].Replicas, replica.BrokerId)
+		}
+	}
+
+	sortPartitionAssignments(assignments)
+	return assignments
+}
+
+// generateReassignment returns the new replicas of the partitions of the selected topics whose replicas change.
+// Replicas are placed on the brokers with the fewest replicas across all topics, and the first replica of a partition,
+// which is its preferred leader, is kept in place when its broker is not removed.
+func generateReassignment(brokers []int32, current []partitionAssignment, opts *reassignmentOptions) ([]partitionAssignment, error) {
+	var available []int32
+	for _, broker := range brokers {
+		if !slices.Contains(opts.removeBrokers, broker) {
+			available = append(available, broker)
+		}
+	}
+	slices.Sort(available)
+	if len(available) == 0 {
+		return nil, fmt.Errorf("no brokers are available to host replicas")
+	}
+	if opts.replicationFactor > len(available) {
+		return nil, fmt.Errorf("replication factor %d is larger than the number of available brokers (%d)", opts.replicationFactor, len(available))
+	}
+
+	load := make(map[int32]int)
+	for _, broker := range available {
+		load[broker] = 0
+	}
+
+	proposed := make([]partitionAssignment, len(current))
+	for i, assignment := range current {
+		proposed[i] = partitionAssignment{Topic: assignment.Topic, Partition: assignment.Partition, Replicas: slices.Clone(assignment.Replicas)}
+		for _, broker := range assignment.Replicas {
+			load[broker]++
+		}
+	}
+
+	for _, topic := range opts.topics {
+		if !slices.ContainsFunc(current, func(assignment partitionAssignment) bool { return assignment.Topic == topic }) {
+			return nil, fmt.Errorf(`unknown topic "%s"`, topic)
+		}
+	}
+
+	var selected []*partitionAssignment
+	for i := range proposed {
+		if len(opts.topics) == 0 || slices.Contains(opts.topics, proposed[i].Topic) {
+			selected = append(selected, &proposed[i])
+		}
+	}
+
+	for _, assignment := range selected {
+		replicationFactor := len(assignment.Replicas)
+		if opts.replicationFactor > 0 {
+			replicationFactor = opts.replicationFactor
+		}
+		if replicationFactor > len(available) {
+			return nil, fmt.Errorf(`replication factor %d of topic "%s" is larger than the number of available brokers (%d)`, replicationFactor, assignment.Topic, len(available))
+		}
+
+		assignment.Replicas = slices.DeleteFunc(assignment.Replicas, func(broker int32) bool {
+			if slices.Contains(opts.removeBrokers, broker) {
+				load[broker]--
+				return true
+			}
+			return false
+		})
+
+		for len(assignment.Replicas) > replicationFactor {
+			i := 1
+			for j := 2; j < len(assignment.Replicas); j++ {
+				if load[assignment.Replicas[j]] >= load[assignment.Replicas[i]] {
+					i = j
+				}
+			}
+			load[assignment.Replicas[i]]--
+			assignment.Replicas = slices.Delete(assignment.Replicas, i, i+1)
+		}
+
+		for len(assignment.Replicas) < replicationFactor {
+			broker := getLeastLoadedBroker(available, load, assignment.Replicas)
+			load[broker]++
+			assignment.Replicas = append(assignment.Replicas, broker)
+		}
+	}
+
+	if opts.balance {
+		balanceReplicas(available, load, selected)
+	}
+
+	var changed []partitionAssignment
+	for i, assignment := range proposed {
+		if !slices.Equal(assignment.Replicas, current[i].Replicas) {
+			changed = append(changed, assignment)
+		}
+	}
+	return changed, nil
+}
+
+// balanceReplicas moves followers from brokers with more replicas to brokers with fewer replicas, until no follower can
+// be moved to a broker with at least two fewer replicas. Leaders are never moved, so the preferred leader of every
+// partition stays in place.
+func balanceReplicas(available []int32, load map[int32]int, selected []*partitionAssignment) {
+	for moveFollower(available, load, selected) {
+	}
+}
+
+// moveFollower moves a single follower, trying the brokers with the most replicas first
+func moveFollower(available []int32, load map[int32]int, selected []*partitionAssignment) bool {
+	brokers := slices.Clone(available)
+	slices.SortStableFunc(brokers, func(a, b int32) int { return cmp.Compare(load[b], load[a]) })
+
+	for _, from := range brokers {
+		for j := len(brokers) - 1; j >= 0 && load[from]-load[brokers[j]] > 1; j-- {
+			to := brokers[j]
+			for _, assignment := range selected {
+				i := slices.Index(assignment.Replicas, from)
+				if i > 0 && !slices.Contains(assignment.Replicas, to) {
+					assignment.Replicas[i] = to
+					load[from]--
+					load[to]++
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func getLeastLoadedBroker(available []int32, load map[int32]int, exclude []int32) int32 {
+	broker := int32(-1)
+	for _, candidate := range available {
+		if !slices.Contains(exclude, candidate) && (broker == -1 || load[candidate] < load[broker]) {
+			broker = candidate
+		}
+	}
+	return broker
+}
+
+func sortPartitionAssignments(assignments []partitionAssignment) {
+	slices.SortFunc(assignments, func(a, b partitionAssignment) int {
+		if a.Topic != b.Topic {
+			return cmp.Compare(a.Topic, b.Topic)
+		}
+		return cmp.Compare(a.Partition, b.Partition)
+	})
+}
@@ -0,0 +1,115 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/confluentinc/kafka-rest-sdk-go/kafkarestv3"
+)
+
+func TestGetPartitionAssignments(t *testing.T) {
+	assignments := getPartitionAssignments([]kafkarestv3.ReplicaStatusData{
+		{TopicName: "orders", PartitionId: 1, BrokerId: 1},
+		{TopicName: "orders", PartitionId: 1, BrokerId: 2, IsLeader: true},
+		{TopicName: "orders", PartitionId: 0, BrokerId: 3, IsLeader: true},
+		{TopicName: "carts", PartitionId: 0, BrokerId: 1, IsLeader: true},
+	})
+	require.Equal(t, []partitionAssignment{
+		{Topic: "carts", Partition: 0, Replicas: []int32{1}},
+		{Topic: "orders", Partition: 0, Replicas: []int32{3}},
+		{Topic: "orders", Partition: 1, Replicas: []int32{2, 1}},
+	}, assignments)
+}
+
+func TestGenerateReassignmentBalance(t *testing.T) {
+	current := []partitionAssignment{
+		{Topic: "orders", Partition: 0, Replicas: []int32{1, 2}},
+		{Topic: "orders", Partition: 1, Replicas: []int32{1, 2}},
+		{Topic: "orders", Partition: 2, Replicas: []int32{1, 2}},
+	}
+
+	proposed, err := generateReassignment([]int32{1, 2, 3}, current, &reassignmentOptions{balance: true})
+	require.NoError(t, err)
+	require.Equal(t, []partitionAssignment{
+		{Topic: "orders", Partition: 0, Replicas: []int32{1, 3}},
+	}, proposed)
+
+	// Leaders stay in place, even when their broker has the most replicas
+	leaders := []partitionAssignment{
+		{Topic: "orders", Partition: 0, Replicas: []int32{1}},
+		{Topic: "orders", Partition: 1, Replicas: []int32{1}},
+		{Topic: "orders", Partition: 2, Replicas: []int32{1, 2}},
+	}
+	proposed, err = generateReassignment([]int32{1, 2, 3}, leaders, &reassignmentOptions{balance: true})
+	require.NoError(t, err)
+	require.Empty(t, proposed)
+
+	proposed, err = generateReassignment([]int32{1, 2}, current, &reassignmentOptions{balance: true})
+	require.NoError(t, err)
+	require.Empty(t, proposed)
+}
+
+func TestGenerateReassignmentRemoveBrokers(t *testing.T) {
+	current := []partitionAssignment{
+		{Topic: "orders", Partition: 0, Replicas: []int32{1, 2}},
+		{Topic: "orders", Partition: 1, Replicas: []int32{2, 3}},
+		{Topic: "payments", Partition: 0, Replicas: []int32{3, 1}},
+	}
+
+	proposed, err := generateReassignment([]int32{1, 2, 3, 4}, current, &reassignmentOptions{removeBrokers: []int32{3}})
+	require.NoError(t, err)
+	require.Equal(t, []partitionAssignment{
+		{Topic: "orders", Partition: 1, Replicas: []int32{2, 4}},
+		{Topic: "payments", Partition: 0, Replicas: []int32{1, 4}},
+	}, proposed)
+
+	proposed, err = generateReassignment([]int32{1, 2, 3, 4}, current, &reassignmentOptions{topics: []string{"orders"}, removeBrokers: []int32{3}})
+	require.NoError(t, err)
+	require.Equal(t, []partitionAssignment{{Topic: "orders", Partition: 1, Replicas: []int32{2, 4}}}, proposed)
+
+	_, err = generateReassignment([]int32{1, 2, 3}, current, &reassignmentOptions{removeBrokers: []int32{2, 3}})
+	require.EqualError(t, err, `replication factor 2 of topic "orders" is larger than the number of available brokers (1)`)
+}
+
+func TestGenerateReassignmentReplicationFactor(t *testing.T) {
+	current := []partitionAssignment{
+		{Topic: "orders", Partition: 0, Replicas: []int32{1}},
+		{Topic: "orders", Partition: 1, Replicas: []int32{2}},
+		{Topic: "payments", Partition: 0, Replicas: []int32{3, 1, 2}},
+	}
+
+	proposed, err := generateReassignment([]int32{1, 2, 3}, current, &reassignmentOptions{topics: []string{"orders"}, replicationFactor: 2})
+	require.NoError(t, err)
+	require.Equal(t, []partitionAssignment{
+		{Topic: "orders", Partition: 0, Replicas: []int32{1, 3}},
+		{Topic: "orders", Partition: 1, Replicas: []int32{2, 1}},
+	}, proposed)
+
+	proposed, err = generateReassignment([]int32{1, 2, 3}, current, &reassignmentOptions{topics: []string{"payments"}, replicationFactor: 1})
+	require.NoError(t, err)
+	require.Equal(t, []partitionAssignment{{Topic: "payments", Partition: 0, Replicas: []int32{3}}}, proposed)
+
+	_, err = generateReassignment([]int32{1, 2, 3}, current, &reassignmentOptions{replicationFactor: 4})
+	require.EqualError(t, err, "replication factor 4 is larger than the number of available brokers (3)")
+
+	_, err = generateReassignment([]int32{1, 2, 3}, current, &reassignmentOptions{topics: []string{"shipments"}, replicationFactor: 2})
+	require.EqualError(t, err, `unknown topic "shipments"`)
+}
+
+func TestGetThrottledReplicas(t *testing.T) {
+	current := []partitionAssignment{
+		{Topic: "orders", Partition: 0, Replicas: []int32{1, 2}},
+		{Topic: "orders", Partition: 1, Replicas: []int32{2, 3}},
+		{Topic: "payments", Partition: 0, Replicas: []int32{3}},
+	}
+	proposed := []partitionAssignment{
+		{Topic: "orders", Partition: 0, Replicas: []int32{1, 3}},
+		{Topic: "orders", Partition: 1, Replicas: []int32{2, 1}},
+		{Topic: "payments", Partition: 0, Replicas: []int32{3, 4}},
+	}
+
+	leaders, followers := getThrottledReplicas(current, proposed)
+	require.Equal(t, map[string]string{"orders": "0:1,0:2,1:2,1:3", "payments": "0:3"}, leaders)
+	require.Equal(t, map[string]string{"orders": "0:3,1:1", "payments": "0:4"}, followers)
+}
@@ -0,0 +1,112 @@
+package kafka
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+func (c *partitionCommand) newReassignmentThrottleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "throttle",
+		Short: "Change or remove the throttle of partition reassignments.",
+		Long:  "Change the rate at which replicas are moved by partition reassignments, or remove the throttle of a reassignment plan once it has completed. With `--input-file`, the replicas of a plan generated with `confluent kafka partition reassignment generate` are throttled as well. Run it before the plan is executed, as the throttled replicas are computed from the current replicas of the partitions.",
+		Args:  cobra.NoArgs,
+		RunE:  c.reassignmentThrottle,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Move replicas at up to 50 MB per second.",
+				Code: "confluent kafka partition reassignment throttle --rate 52428800",
+			},
+			examples.Example{
+				Text: `Throttle the replicas of the reassignment plan saved to "plan.json" at up to 10 MB per second.`,
+				Code: "confluent kafka partition reassignment throttle --rate 10485760 --input-file plan.json",
+			},
+			examples.Example{
+				Text: `Remove the throttle of the reassignment plan saved to "plan.json".`,
+				Code: "confluent kafka partition reassignment throttle --remove --input-file plan.json",
+			},
+		),
+	}
+
+	cmd.Flags().Int64("rate", 0, "Maximum rate in bytes per second at which replicas are moved.")
+	cmd.Flags().Bool("remove", false, "Remove the throttle of the reassignment plan.")
+	cmd.Flags().String("input-file", "", "Path of the JSON file of the reassignment plan.")
+	cmd.Flags().AddFlagSet(pcmd.OnPremKafkaRestSet())
+
+	cobra.CheckErr(cmd.MarkFlagFilename("input-file", "json"))
+
+	cmd.MarkFlagsOneRequired("rate", "remove")
+	cmd.MarkFlagsMutuallyExclusive("rate", "remove")
+
+	return cmd
+}
+
+func (c *partitionCommand) reassignmentThrottle(cmd *cobra.Command, _ []string) error {
+	remove, err := cmd.Flags().GetBool("remove")
+	if err != nil {
+		return err
+	}
+
+	rate, err := cmd.Flags().GetInt64("rate")
+	if err != nil {
+		return err
+	}
+	if !remove && rate < 1 {
+		return fmt.Errorf("rate must be at least 1 byte per second")
+	}
+
+	inputFile, err := cmd.Flags().GetString("input-file")
+	if err != nil {
+		return err
+	}
+	if remove && inputFile == "" {
+		return fmt.Errorf(`the "--input-file" flag is required to remove the throttle of a reassignment plan`)
+	}
+
+	var plan *reassignmentPlan
+	if inputFile != "" {
+		plan, err = readReassignmentPlan(inputFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	restClient, restContext, clusterId, err := initKafkaRest(c.AuthenticatedCLICommand, cmd)
+	if err != nil {
+		return err
+	}
+
+	if remove {
+		if err := removeThrottle(restClient, restContext, clusterId, plan.Partitions); err != nil {
+			return err
+		}
+
+		output.Println(c.Config.EnableColor, "Removed the throttle of partition reassignments.")
+		return nil
+	}
+
+	if plan != nil {
+		current, err := getCurrentPartitionAssignments(restClient, restContext, clusterId)
+		if err != nil {
+			return err
+		}
+		if err := setThrottle(restClient, restContext, clusterId, rate, current, plan.Partitions); err != nil {
+			return err
+		}
+
+		output.Printf(c.Config.EnableColor, "Throttled the replicas of %d partitions at %d bytes per second.\n", len(plan.Partitions), rate)
+		return nil
+	}
+
+	if err := setThrottleRate(restClient, restContext, clusterId, rate); err != nil {
+		return err
+	}
+
+	output.Printf(c.Config.EnableColor, "Set the throttle of partition reassignments to %d bytes per second.\n", rate)
+	return nil
+}
@@ -43,6 +43,8 @@ func newTopicCommand(cfg *config.Config, prerunner pcmd.PreRunner) *cobra.Comman
 	if cfg.IsCloudLogin() {
 		c.AuthenticatedCLICommand = pcmd.NewAuthenticatedCLICommand(cmd, prerunner)
 
+		cmd.AddCommand(c.newApplyCommand())
+		cmd.AddCommand(c.newBrowseCommand())
 		cmd.AddCommand(c.newCreateCommand())
 		cmd.AddCommand(c.newDeleteCommand())
 		cmd.AddCommand(c.newDescribeCommand())
@@ -52,6 +54,7 @@ func newTopicCommand(cfg *config.Config, prerunner pcmd.PreRunner) *cobra.Comman
 		c.AuthenticatedCLICommand = pcmd.NewAuthenticatedWithMDSCLICommand(cmd, prerunner)
 		c.PersistentPreRunE = prerunner.InitializeOnPremKafkaRest(c.AuthenticatedCLICommand)
 
+		cmd.AddCommand(c.newApplyCommandOnPrem())
 		cmd.AddCommand(c.newCreateCommandOnPrem())
 		cmd.AddCommand(c.newDeleteCommandOnPrem())
 		cmd.AddCommand(c.newDescribeCommandOnPrem())
@@ -59,6 +62,7 @@ func newTopicCommand(cfg *config.Config, prerunner pcmd.PreRunner) *cobra.Comman
 		cmd.AddCommand(c.newUpdateCommandOnPrem())
 	}
 
+	cmd.AddCommand(c.newBenchmarkCommand())
 	cmd.AddCommand(c.newConsumeCommand())
 	cmd.AddCommand(c.newProduceCommand())
 
@@ -195,7 +199,7 @@ func addApiKeyToCluster(cmd *cobra.Command, cluster *config.KafkaClusterConfig)
 		return &errors.UnspecifiedAPIKeyError{ClusterID: cluster.ID}
 	}
 
-	if pair, ok := cluster.APIKeys[cluster.APIKey]; !ok || pair.Secret == "" {
+	if pair, ok := cluster.APIKeys[cluster.APIKey]; !ok || (pair.Secret == "" && pair.CredentialHelper == "") {
 		return errors.NewErrorWithSuggestions(
 			fmt.Sprintf(`no secret for API key "%s" of resource "%s" passed via flag or stored in local CLI state`, apiKey, cluster.ID),
 			fmt.Sprintf("Pass the API secret with flag `--api-secret` or store with `confluent api-key store %s --resource %s`.", apiKey, cluster.ID),
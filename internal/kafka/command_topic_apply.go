@@ -0,0 +1,369 @@
+package kafka
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/kafkarest"
+	"github.com/confluentinc/cli/v3/pkg/output"
+	"github.com/confluentinc/cli/v3/pkg/utils"
+)
+
+const (
+	topicActionCreate      = "create"
+	topicActionUpdate      = "update"
+	topicActionNone        = "none"
+	topicActionClusterOnly = "cluster-only"
+)
+
+const topicManifestLongDescription = "Compare the topics of a YAML or JSON manifest with the topics of a Kafka cluster, then create missing topics, update changed configuration values, and increase partition counts. Only the configuration values listed in the manifest are compared. Topics that exist only on the cluster are reported, but not changed. Use `--dry-run` to show the plan without applying it.\n\nA manifest is formatted as follows:\n\n" + `  topics:
+    - name: orders
+      partitions: 6
+      configs:
+        cleanup.policy: compact
+        retention.ms: "604800000"`
+
+type topicManifest struct {
+	Topics []manifestTopic `yaml:"topics"`
+}
+
+type manifestTopic struct {
+	Name       string            `yaml:"name"`
+	Partitions int32             `yaml:"partitions"`
+	Configs    map[string]string `yaml:"configs"`
+}
+
+type clusterTopic struct {
+	name       string
+	partitions int32
+	isInternal bool
+}
+
+type topicPlan struct {
+	topic             manifestTopic
+	action            string
+	changes           []string
+	changedConfigs    map[string]string
+	currentPartitions int32
+}
+
+type topicPlanOutHuman struct {
+	TopicName string `human:"Topic Name"`
+	Action    string `human:"Action"`
+	Changes   string `human:"Changes"`
+}
+
+type topicPlanOutSerialized struct {
+	TopicName string   `serialized:"topic_name"`
+	Action    string   `serialized:"action"`
+	Changes   []string `serialized:"changes"`
+}
+
+// topicManifestClient reads and changes the topics of a Kafka cluster through Kafka REST
+type topicManifestClient interface {
+	listTopics() ([]clusterTopic, error)
+	listTopicConfigs(topic string) (map[string]string, error)
+	createTopic(topic manifestTopic) error
+	updateTopicConfigs(topic string, configs map[string]string) error
+	updatePartitionCount(topic string, partitions int32) error
+	canUpdatePartitionCount() bool
+}
+
+type cloudTopicManifestClient struct {
+	kafkaREST *pcmd.KafkaREST
+}
+
+func (c *command) newApplyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply <manifest>",
+		Short: "Apply a manifest of Kafka topics.",
+		Long:  topicManifestLongDescription,
+		Args:  cobra.ExactArgs(1),
+		RunE:  c.apply,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Show the differences between the topics of manifest "topics.yaml" and the current cluster.`,
+				Code: "confluent kafka topic apply topics.yaml --dry-run",
+			},
+			examples.Example{
+				Text: `Apply the topics of manifest "topics.yaml" to the current cluster.`,
+				Code: "confluent kafka topic apply topics.yaml",
+			},
+		),
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireNonAPIKeyCloudLogin},
+	}
+
+	pcmd.AddDryRunFlag(cmd)
+	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *command) apply(cmd *cobra.Command, args []string) error {
+	manifest, err := readTopicManifest(args[0])
+	if err != nil {
+		return err
+	}
+
+	kafkaREST, err := c.GetKafkaREST()
+	if err != nil {
+		return err
+	}
+
+	if err := c.provisioningClusterCheck(kafkaREST.GetClusterId()); err != nil {
+		return err
+	}
+
+	return applyTopicManifest(cmd, &cloudTopicManifestClient{kafkaREST: kafkaREST}, manifest)
+}
+
+func readTopicManifest(path string) (*topicManifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := new(topicManifest)
+	if err := yaml.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf(`failed to parse manifest "%s": %w`, path, err)
+	}
+
+	names := make(map[string]bool)
+	for _, topic := range manifest.Topics {
+		if topic.Name == "" {
+			return nil, fmt.Errorf(`topic without a name in manifest "%s"`, path)
+		}
+		if names[topic.Name] {
+			return nil, fmt.Errorf(`topic "%s" is listed more than once in manifest "%s"`, topic.Name, path)
+		}
+		if topic.Partitions < 0 {
+			return nil, fmt.Errorf(`invalid partition count %d of topic "%s" in manifest "%s"`, topic.Partitions, topic.Name, path)
+		}
+		names[topic.Name] = true
+	}
+	return manifest, nil
+}
+
+func applyTopicManifest(cmd *cobra.Command, client topicManifestClient, manifest *topicManifest) error {
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
+	plans, err := getTopicPlans(client, manifest)
+	if err != nil {
+		return err
+	}
+
+	if !dryRun {
+		if err := executeTopicPlans(client, plans); err != nil {
+			return err
+		}
+	}
+
+	list := output.NewList(cmd)
+	for _, plan := range plans {
+		if output.GetFormat(cmd) == output.Human {
+			list.Add(&topicPlanOutHuman{
+				TopicName: plan.topic.Name,
+				Action:    plan.action,
+				Changes:   strings.Join(plan.changes, ", "),
+			})
+		} else {
+			list.Add(&topicPlanOutSerialized{
+				TopicName: plan.topic.Name,
+				Action:    plan.action,
+				Changes:   plan.changes,
+			})
+		}
+	}
+	return list.Print()
+}
+
+// getTopicPlans compares the topics of a manifest with the topics of a cluster
+func getTopicPlans(client topicManifestClient, manifest *topicManifest) ([]*topicPlan, error) {
+	topics, err := client.listTopics()
+	if err != nil {
+		return nil, err
+	}
+	clusterTopics := make(map[string]clusterTopic)
+	for _, topic := range topics {
+		clusterTopics[topic.name] = topic
+	}
+
+	var plans []*topicPlan
+	for _, topic := range manifest.Topics {
+		plan := &topicPlan{topic: topic, changedConfigs: make(map[string]string)}
+		plans = append(plans, plan)
+
+		current, ok := clusterTopics[topic.Name]
+		if !ok {
+			plan.action = topicActionCreate
+			if topic.Partitions > 0 {
+				plan.changes = append(plan.changes, fmt.Sprintf("partitions: %d", topic.Partitions))
+			}
+			for _, name := range sortedKeys(topic.Configs) {
+				plan.changes = append(plan.changes, fmt.Sprintf("%s: %s", name, topic.Configs[name]))
+			}
+			continue
+		}
+
+		plan.action = topicActionNone
+		plan.currentPartitions = current.partitions
+		if topic.Partitions > 0 && topic.Partitions != current.partitions {
+			if topic.Partitions < current.partitions {
+				return nil, fmt.Errorf(`the partition count of topic "%s" cannot be decreased from %d to %d`, topic.Name, current.partitions, topic.Partitions)
+			}
+			if !client.canUpdatePartitionCount() {
+				return nil, errors.NewErrorWithSuggestions(
+					fmt.Sprintf(`the partition count of topic "%s" cannot be increased from %d to %d through Kafka REST`, topic.Name, current.partitions, topic.Partitions),
+					"Increase the partition count with `kafka-topics --alter --partitions`, then apply the manifest again.",
+				)
+			}
+			plan.action = topicActionUpdate
+			plan.changes = append(plan.changes, fmt.Sprintf("partitions: %d (currently %d)", topic.Partitions, current.partitions))
+		}
+
+		if len(topic.Configs) == 0 {
+			continue
+		}
+		configs, err := client.listTopicConfigs(topic.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range sortedKeys(topic.Configs) {
+			value, ok := configs[name]
+			if ok && value == topic.Configs[name] {
+				continue
+			}
+			if !ok {
+				value = "unset"
+			}
+			plan.action = topicActionUpdate
+			plan.changedConfigs[name] = topic.Configs[name]
+			plan.changes = append(plan.changes, fmt.Sprintf("%s: %s (currently %s)", name, topic.Configs[name], value))
+		}
+	}
+
+	for _, topic := range topics {
+		isInManifest := slices.ContainsFunc(manifest.Topics, func(manifestTopic manifestTopic) bool { return manifestTopic.Name == topic.name })
+		if !isInManifest && !topic.isInternal {
+			plans = append(plans, &topicPlan{topic: manifestTopic{Name: topic.name}, action: topicActionClusterOnly})
+		}
+	}
+
+	return plans, nil
+}
+
+func executeTopicPlans(client topicManifestClient, plans []*topicPlan) error {
+	for _, plan := range plans {
+		switch plan.action {
+		case topicActionCreate:
+			if err := client.createTopic(plan.topic); err != nil {
+				return fmt.Errorf(`failed to create topic "%s": %w`, plan.topic.Name, err)
+			}
+		case topicActionUpdate:
+			if plan.topic.Partitions > plan.currentPartitions {
+				if err := client.updatePartitionCount(plan.topic.Name, plan.topic.Partitions); err != nil {
+					return fmt.Errorf(`failed to update the partition count of topic "%s": %w`, plan.topic.Name, err)
+				}
+			}
+			if len(plan.changedConfigs) > 0 {
+				if err := client.updateTopicConfigs(plan.topic.Name, plan.changedConfigs); err != nil {
+					return fmt.Errorf(`failed to update the configuration of topic "%s": %w`, plan.topic.Name, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (c *cloudTopicManifestClient) listTopics() ([]clusterTopic, error) {
+	topics, err := c.kafkaREST.CloudClient.ListKafkaTopics()
+	if err != nil {
+		return nil, err
+	}
+
+	clusterTopics := make([]clusterTopic, len(topics.Data))
+	for i, topic := range topics.Data {
+		clusterTopics[i] = clusterTopic{
+			name:       topic.GetTopicName(),
+			partitions: topic.GetPartitionsCount(),
+			isInternal: topic.GetIsInternal(),
+		}
+	}
+	return clusterTopics, nil
+}
+
+func (c *cloudTopicManifestClient) listTopicConfigs(topic string) (map[string]string, error) {
+	configs, err := c.kafkaREST.CloudClient.ListKafkaTopicConfigs(topic)
+	if err != nil {
+		return nil, err
+	}
+
+	configMap := make(map[string]string)
+	for _, config := range configs {
+		configMap[config.GetName()] = config.GetValue()
+	}
+	return configMap, nil
+}
+
+func (c *cloudTopicManifestClient) createTopic(topic manifestTopic) error {
+	configs := make([]kafkarestv3.CreateTopicRequestDataConfigs, 0, len(topic.Configs))
+	for _, name := range sortedKeys(topic.Configs) {
+		value := topic.Configs[name]
+		configs = append(configs, kafkarestv3.CreateTopicRequestDataConfigs{
+			Name:  name,
+			Value: *kafkarestv3.NewNullableString(&value),
+		})
+	}
+
+	data := kafkarestv3.CreateTopicRequestData{
+		TopicName: topic.Name,
+		Configs:   &configs,
+	}
+	if topic.Partitions > 0 {
+		data.PartitionsCount = utils.Int32Ptr(topic.Partitions)
+	}
+
+	_, httpResp, err := c.kafkaREST.CloudClient.CreateKafkaTopic(data)
+	return kafkarest.NewError(c.kafkaREST.CloudClient.GetUrl(), err, httpResp)
+}
+
+func (c *cloudTopicManifestClient) updateTopicConfigs(topic string, configs map[string]string) error {
+	data := kafkarestv3.AlterConfigBatchRequestData{Data: toAlterConfigBatchRequestData(configs)}
+	httpResp, err := c.kafkaREST.CloudClient.UpdateKafkaTopicConfigBatch(topic, data)
+	return kafkarest.NewError(c.kafkaREST.CloudClient.GetUrl(), err, httpResp)
+}
+
+func (c *cloudTopicManifestClient) updatePartitionCount(topic string, partitions int32) error {
+	_, err := c.kafkaREST.CloudClient.UpdateKafkaTopicPartitionCount(topic, kafkarestv3.UpdatePartitionCountRequestData{PartitionsCount: partitions})
+	return err
+}
+
+func (c *cloudTopicManifestClient) canUpdatePartitionCount() bool {
+	return true
+}
@@ -0,0 +1,142 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antihax/optional"
+	"github.com/spf13/cobra"
+
+	"github.com/confluentinc/kafka-rest-sdk-go/kafkarestv3"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/kafkarest"
+)
+
+type onPremTopicManifestClient struct {
+	restClient  *kafkarestv3.APIClient
+	restContext context.Context
+	clusterId   string
+}
+
+func (c *command) newApplyCommandOnPrem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply <manifest>",
+		Short: "Apply a manifest of Kafka topics.",
+		Long:  topicManifestLongDescription + "\n\nThe partition count of existing topics cannot be increased through the Confluent Platform Kafka REST API.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  c.applyOnPrem,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Show the differences between the topics of manifest "topics.yaml" and the specified cluster (providing Kafka REST Proxy endpoint).`,
+				Code: "confluent kafka topic apply topics.yaml --url http://localhost:8082 --dry-run",
+			},
+			examples.Example{
+				Text: `Apply the topics of manifest "topics.yaml" to the specified cluster (providing Kafka REST Proxy endpoint).`,
+				Code: "confluent kafka topic apply topics.yaml --url http://localhost:8082",
+			},
+		),
+	}
+
+	cmd.Flags().AddFlagSet(pcmd.OnPremKafkaRestSet())
+	pcmd.AddDryRunFlag(cmd)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *command) applyOnPrem(cmd *cobra.Command, args []string) error {
+	manifest, err := readTopicManifest(args[0])
+	if err != nil {
+		return err
+	}
+
+	restClient, restContext, clusterId, err := initKafkaRest(c.AuthenticatedCLICommand, cmd)
+	if err != nil {
+		return err
+	}
+
+	client := &onPremTopicManifestClient{
+		restClient:  restClient,
+		restContext: restContext,
+		clusterId:   clusterId,
+	}
+	return applyTopicManifest(cmd, client, manifest)
+}
+
+func (c *onPremTopicManifestClient) listTopics() ([]clusterTopic, error) {
+	topics, resp, err := c.restClient.TopicV3Api.ListKafkaTopics(c.restContext, c.clusterId)
+	if err != nil {
+		return nil, kafkarest.NewError(c.restClient.GetConfig().BasePath, err, resp)
+	}
+
+	clusterTopics := make([]clusterTopic, len(topics.Data))
+	for i, topic := range topics.Data {
+		clusterTopics[i] = clusterTopic{
+			name:       topic.TopicName,
+			partitions: topic.PartitionsCount,
+			isInternal: topic.IsInternal,
+		}
+	}
+	return clusterTopics, nil
+}
+
+func (c *onPremTopicManifestClient) listTopicConfigs(topic string) (map[string]string, error) {
+	configs, resp, err := c.restClient.ConfigsV3Api.ListKafkaTopicConfigs(c.restContext, c.clusterId, topic)
+	if err != nil {
+		return nil, kafkarest.NewError(c.restClient.GetConfig().BasePath, err, resp)
+	}
+
+	configMap := make(map[string]string)
+	for _, config := range configs.Data {
+		if config.Value != nil {
+			configMap[config.Name] = *config.Value
+		}
+	}
+	return configMap, nil
+}
+
+func (c *onPremTopicManifestClient) createTopic(topic manifestTopic) error {
+	configs := make([]kafkarestv3.CreateTopicRequestDataConfigs, 0, len(topic.Configs))
+	for _, name := range sortedKeys(topic.Configs) {
+		value := topic.Configs[name]
+		configs = append(configs, kafkarestv3.CreateTopicRequestDataConfigs{
+			Name:  name,
+			Value: &value,
+		})
+	}
+
+	data := kafkarestv3.CreateTopicRequestData{
+		TopicName:       topic.Name,
+		PartitionsCount: topic.Partitions,
+		Configs:         configs,
+	}
+
+	opts := &kafkarestv3.CreateKafkaTopicOpts{CreateTopicRequestData: optional.NewInterface(data)}
+	_, resp, err := c.restClient.TopicV3Api.CreateKafkaTopic(c.restContext, c.clusterId, opts)
+	return kafkarest.NewError(c.restClient.GetConfig().BasePath, err, resp)
+}
+
+func (c *onPremTopicManifestClient) updateTopicConfigs(topic string, configs map[string]string) error {
+	data := make([]kafkarestv3.AlterConfigBatchRequestDataData, 0, len(configs))
+	for _, name := range sortedKeys(configs) {
+		value := configs[name]
+		data = append(data, kafkarestv3.AlterConfigBatchRequestDataData{
+			Name:  name,
+			Value: &value,
+		})
+	}
+
+	opts := &kafkarestv3.UpdateKafkaTopicConfigBatchOpts{AlterConfigBatchRequestData: optional.NewInterface(kafkarestv3.AlterConfigBatchRequestData{Data: data})}
+	resp, err := c.restClient.ConfigsV3Api.UpdateKafkaTopicConfigBatch(c.restContext, c.clusterId, topic, opts)
+	return kafkarest.NewError(c.restClient.GetConfig().BasePath, err, resp)
+}
+
+func (c *onPremTopicManifestClient) updatePartitionCount(topic string, _ int32) error {
+	return fmt.Errorf(`the partition count of topic "%s" cannot be increased through Kafka REST`, topic)
+}
+
+func (c *onPremTopicManifestClient) canUpdatePartitionCount() bool {
+	return false
+}
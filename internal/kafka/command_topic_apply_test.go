@@ -0,0 +1,113 @@
+package kafka
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTopicManifestClient struct {
+	topics            []clusterTopic
+	configs           map[string]map[string]string
+	partitionsEnabled bool
+	created           []string
+	updatedConfigs    map[string]map[string]string
+	updatedPartitions map[string]int32
+}
+
+func (f *fakeTopicManifestClient) listTopics() ([]clusterTopic, error) {
+	return f.topics, nil
+}
+
+func (f *fakeTopicManifestClient) listTopicConfigs(topic string) (map[string]string, error) {
+	return f.configs[topic], nil
+}
+
+func (f *fakeTopicManifestClient) createTopic(topic manifestTopic) error {
+	f.created = append(f.created, topic.Name)
+	return nil
+}
+
+func (f *fakeTopicManifestClient) updateTopicConfigs(topic string, configs map[string]string) error {
+	f.updatedConfigs[topic] = configs
+	return nil
+}
+
+func (f *fakeTopicManifestClient) updatePartitionCount(topic string, partitions int32) error {
+	f.updatedPartitions[topic] = partitions
+	return nil
+}
+
+func (f *fakeTopicManifestClient) canUpdatePartitionCount() bool {
+	return f.partitionsEnabled
+}
+
+func newFakeTopicManifestClient(partitionsEnabled bool) *fakeTopicManifestClient {
+	return &fakeTopicManifestClient{
+		topics: []clusterTopic{
+			{name: "orders", partitions: 3},
+			{name: "payments", partitions: 6},
+			{name: "legacy", partitions: 1},
+			{name: "_schemas", partitions: 1, isInternal: true},
+		},
+		configs: map[string]map[string]string{
+			"orders":   {"cleanup.policy": "delete", "retention.ms": "604800000"},
+			"payments": {"cleanup.policy": "compact"},
+		},
+		partitionsEnabled: partitionsEnabled,
+		updatedConfigs:    make(map[string]map[string]string),
+		updatedPartitions: make(map[string]int32),
+	}
+}
+
+func TestTopicPlans(t *testing.T) {
+	client := newFakeTopicManifestClient(true)
+	manifest := &topicManifest{Topics: []manifestTopic{
+		{Name: "orders", Partitions: 6, Configs: map[string]string{"cleanup.policy": "compact", "retention.ms": "604800000", "min.insync.replicas": "2"}},
+		{Name: "payments", Partitions: 6, Configs: map[string]string{"cleanup.policy": "compact"}},
+		{Name: "shipments", Partitions: 2, Configs: map[string]string{"retention.ms": "1000"}},
+	}}
+
+	plans, err := getTopicPlans(client, manifest)
+	require.NoError(t, err)
+	require.Len(t, plans, 4)
+
+	require.Equal(t, topicActionUpdate, plans[0].action)
+	require.Equal(t, []string{"partitions: 6 (currently 3)", "cleanup.policy: compact (currently delete)", "min.insync.replicas: 2 (currently unset)"}, plans[0].changes)
+	require.Equal(t, topicActionNone, plans[1].action)
+	require.Empty(t, plans[1].changes)
+	require.Equal(t, topicActionCreate, plans[2].action)
+	require.Equal(t, []string{"partitions: 2", "retention.ms: 1000"}, plans[2].changes)
+	require.Equal(t, "legacy", plans[3].topic.Name)
+	require.Equal(t, topicActionClusterOnly, plans[3].action)
+
+	require.NoError(t, executeTopicPlans(client, plans))
+	require.Equal(t, []string{"shipments"}, client.created)
+	require.Equal(t, map[string]int32{"orders": 6}, client.updatedPartitions)
+	require.Equal(t, map[string]map[string]string{"orders": {"cleanup.policy": "compact", "min.insync.replicas": "2"}}, client.updatedConfigs)
+}
+
+func TestTopicPlansPartitions(t *testing.T) {
+	_, err := getTopicPlans(newFakeTopicManifestClient(true), &topicManifest{Topics: []manifestTopic{{Name: "payments", Partitions: 3}}})
+	require.EqualError(t, err, `the partition count of topic "payments" cannot be decreased from 6 to 3`)
+
+	_, err = getTopicPlans(newFakeTopicManifestClient(false), &topicManifest{Topics: []manifestTopic{{Name: "orders", Partitions: 6}}})
+	require.EqualError(t, err, `the partition count of topic "orders" cannot be increased from 3 to 6 through Kafka REST`)
+}
+
+func TestReadTopicManifest(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "topics.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("topics:\n  - name: orders\n    partitions: 6\n    configs:\n      retention.ms: 1000\n"), 0644))
+	manifest, err := readTopicManifest(path)
+	require.NoError(t, err)
+	require.Equal(t, &topicManifest{Topics: []manifestTopic{{Name: "orders", Partitions: 6, Configs: map[string]string{"retention.ms": "1000"}}}}, manifest)
+
+	path = filepath.Join(dir, "topics.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"topics": [{"name": "orders"}, {"name": "orders"}]}`), 0644))
+	_, err = readTopicManifest(path)
+	require.ErrorContains(t, err, `topic "orders" is listed more than once`)
+}
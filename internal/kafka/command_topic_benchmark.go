@@ -0,0 +1,362 @@
+package kafka
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/kafka"
+	"github.com/confluentinc/cli/v3/pkg/log"
+	"github.com/confluentinc/cli/v3/pkg/output"
+	"github.com/confluentinc/cli/v3/pkg/schemaregistry"
+	"github.com/confluentinc/cli/v3/pkg/serdes"
+	"github.com/confluentinc/cli/v3/pkg/utils"
+)
+
+func (c *command) newBenchmarkCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "benchmark <topic>",
+		Short:             "Measure the produce and end-to-end performance of a Kafka topic.",
+		Long:              "Measure the produce and end-to-end performance of a Kafka topic.\n\nProduce synthetic records to a topic at a target rate, and report the throughput and the latency percentiles of produce acknowledgements and of end-to-end delivery to a consumer. String values are random payloads of `--record-size` bytes. If a schema is passed with `--schema` or `--subject`, values are random records which are valid for the schema.\n\nRecords are consumed without joining a consumer group or committing offsets. The records produced by the benchmark are not deleted from the topic.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: pcmd.NewValidArgsFunction(c.validArgs),
+		RunE:              c.benchmark,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Produce 100,000 records of 1 KB to topic "my-topic" at 5,000 records per second.`,
+				Code: "confluent kafka topic benchmark my-topic --num-records 100000 --record-size 1024 --rate 5000",
+			},
+			examples.Example{
+				Text: `Produce random Avro records with keys following a Zipf distribution to topic "my-topic".`,
+				Code: "confluent kafka topic benchmark my-topic --value-format avro --schema order.avsc --key-distribution zipf",
+			},
+		),
+	}
+
+	cmd.Flags().String("bootstrap", "", `Kafka cluster endpoint (Confluent Cloud); or comma-separated list of broker hosts (Confluent Platform), each formatted as "host" or "host:port".`)
+	cmd.Flags().Int("num-records", 10000, "Number of records to produce.")
+	cmd.Flags().Int("record-size", 100, "Size in bytes of the values of records in the string format.")
+	cmd.Flags().Int("rate", 0, "Target number of records produced per second. Defaults to no limit.")
+	cmd.Flags().String("key-distribution", "none", fmt.Sprintf("Distribution of record keys. Can be %s.", utils.ArrayToCommaDelimitedString(keyDistributions, "or")))
+	cmd.Flags().Int("num-keys", 1000, "Number of distinct keys, if records have keys.")
+	cmd.Flags().Bool("produce-only", false, "Skip consuming the produced records to measure the end-to-end latency.")
+	cmd.Flags().String("schema", "", "The ID or filepath of the message value schema.")
+	pcmd.AddValueFormatFlag(cmd)
+	cmd.Flags().String("references", "", "The path to the message value schema references file.")
+	cmd.Flags().String("schema-message", "", "The fully qualified name of the message type in the Protobuf message value schema. Defaults to the first message.")
+	cmd.Flags().StringSlice("config", nil, `A comma-separated list of configuration overrides ("key=value") for the producer client.`)
+	pcmd.AddProducerConfigFileFlag(cmd)
+	cmd.Flags().String("schema-registry-endpoint", "", "Endpoint for Schema Registry cluster.")
+
+	// cloud-only flags
+	cmd.Flags().String("subject", "", "The Schema Registry subject whose latest schema is the message value schema.")
+	pcmd.AddApiKeyFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddApiSecretFlag(cmd)
+	cmd.Flags().String("schema-registry-api-key", "", "Schema registry API key.")
+	cmd.Flags().String("schema-registry-api-secret", "", "Schema registry API secret.")
+	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+
+	// on-prem only flags
+	cmd.Flags().AddFlagSet(pcmd.OnPremAuthenticationSet())
+	pcmd.AddProtocolFlag(cmd)
+	pcmd.AddMechanismFlag(cmd, c.AuthenticatedCLICommand)
+
+	pcmd.AddOutputFlag(cmd)
+
+	pcmd.RegisterFlagCompletionFunc(cmd, "key-distribution", func(_ *cobra.Command, _ []string) []string { return keyDistributions })
+
+	cobra.CheckErr(cmd.MarkFlagFilename("schema", "avsc", "json", "proto"))
+	cobra.CheckErr(cmd.MarkFlagFilename("references", "json"))
+	cobra.CheckErr(cmd.MarkFlagFilename("config-file", "avsc", "json"))
+
+	cmd.MarkFlagsMutuallyExclusive("schema", "subject")
+	cmd.MarkFlagsMutuallyExclusive("config", "config-file")
+
+	return cmd
+}
+
+func (c *command) benchmark(cmd *cobra.Command, args []string) error {
+	b, err := getBenchmarkFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	b.topic = args[0]
+
+	if c.Config.IsCloudLogin() {
+		err = c.prepareBenchmarkCloud(cmd, b)
+	} else {
+		if !cmd.Flags().Changed("bootstrap") {
+			return fmt.Errorf(errors.RequiredFlagNotSetErrorMsg, "bootstrap")
+		}
+		if !cmd.Flags().Changed("ca-location") {
+			return fmt.Errorf(errors.RequiredFlagNotSetErrorMsg, "ca-location")
+		}
+		err = c.prepareBenchmarkOnPrem(cmd, b)
+	}
+	if b.producer != nil {
+		defer b.producer.Close()
+	}
+	if b.consumer != nil {
+		defer func() {
+			_ = b.consumer.Close()
+		}()
+	}
+	if err != nil {
+		return err
+	}
+
+	output.ErrPrintf(c.Config.EnableColor, "Producing %d records to topic \"%s\".\n", b.numRecords, b.topic)
+
+	outs, err := b.run()
+	if err != nil {
+		return err
+	}
+
+	list := output.NewList(cmd)
+	list.Sort(false)
+	for _, out := range outs {
+		list.Add(out)
+	}
+	return list.Print()
+}
+
+func getBenchmarkFromFlags(cmd *cobra.Command) (*benchmark, error) {
+	numRecords, err := cmd.Flags().GetInt("num-records")
+	if err != nil {
+		return nil, err
+	}
+	if numRecords < 1 {
+		return nil, fmt.Errorf("number of records must be at least 1")
+	}
+
+	rate, err := cmd.Flags().GetInt("rate")
+	if err != nil {
+		return nil, err
+	}
+	if rate < 0 {
+		return nil, fmt.Errorf("rate must not be negative")
+	}
+
+	keyDistribution, err := cmd.Flags().GetString("key-distribution")
+	if err != nil {
+		return nil, err
+	}
+	numKeys, err := cmd.Flags().GetInt("num-keys")
+	if err != nil {
+		return nil, err
+	}
+	nextKey, err := newKeyGenerator(keyDistribution, numKeys, rand.New(rand.NewSource(time.Now().UnixNano())))
+	if err != nil {
+		return nil, err
+	}
+
+	return &benchmark{numRecords: numRecords, rate: rate, nextKey: nextKey}, nil
+}
+
+func (c *command) prepareBenchmarkCloud(cmd *cobra.Command, b *benchmark) error {
+	cluster, err := kafka.GetClusterForCommand(c.V2Client, c.Context)
+	if err != nil {
+		return err
+	}
+
+	if err := addApiKeyToCluster(cmd, cluster); err != nil {
+		return err
+	}
+
+	serializer, metaInfo, err := c.initSchemaAndGetInfo(cmd, b.topic, "value")
+	if err != nil {
+		return err
+	}
+	if err := setBenchmarkValueGenerator(cmd, b, serializer, metaInfo); err != nil {
+		return err
+	}
+
+	configFile, err := cmd.Flags().GetString("config-file")
+	if err != nil {
+		return err
+	}
+	config, err := cmd.Flags().GetStringSlice("config")
+	if err != nil {
+		return err
+	}
+
+	b.producer, err = newProducer(cluster, c.clientID, configFile, config)
+	if err != nil {
+		return fmt.Errorf(errors.FailedToCreateProducerErrorMsg, err)
+	}
+	log.CliLogger.Tracef("Create producer succeeded")
+
+	adminClient, err := ckafka.NewAdminClientFromProducer(b.producer)
+	if err != nil {
+		return fmt.Errorf(errors.FailedToCreateAdminClientErrorMsg, err)
+	}
+	defer adminClient.Close()
+
+	if err := c.validateTopic(adminClient, b.topic, cluster); err != nil {
+		return err
+	}
+
+	produceOnly, err := cmd.Flags().GetBool("produce-only")
+	if err != nil {
+		return err
+	}
+	if produceOnly {
+		return nil
+	}
+
+	b.consumer, err = newConsumer(fmt.Sprintf("confluent_cli_benchmark_%s", uuid.New()), cluster, c.clientID, "", nil)
+	if err != nil {
+		return fmt.Errorf(errors.FailedToCreateConsumerErrorMsg, err)
+	}
+	return nil
+}
+
+func (c *command) prepareBenchmarkOnPrem(cmd *cobra.Command, b *benchmark) error {
+	configFile, err := cmd.Flags().GetString("config-file")
+	if err != nil {
+		return err
+	}
+	config, err := cmd.Flags().GetStringSlice("config")
+	if err != nil {
+		return err
+	}
+
+	b.producer, err = newOnPremProducer(cmd, c.Context, c.clientID, configFile, config)
+	if err != nil {
+		return errors.NewErrorWithSuggestions(
+			fmt.Sprintf(errors.FailedToCreateProducerErrorMsg, err),
+			errors.OnPremConfigGuideSuggestions,
+		)
+	}
+	log.CliLogger.Tracef("Create producer succeeded")
+
+	if err := refreshOAuthBearerToken(cmd, b.producer, c.Context); err != nil {
+		return err
+	}
+
+	adminClient, err := ckafka.NewAdminClientFromProducer(b.producer)
+	if err != nil {
+		return fmt.Errorf(errors.FailedToCreateAdminClientErrorMsg, err)
+	}
+	defer adminClient.Close()
+
+	if err := ValidateTopic(adminClient, b.topic); err != nil {
+		return err
+	}
+
+	format, subject, serializer, err := prepareSerializer(cmd, b.topic, "value")
+	if err != nil {
+		return err
+	}
+
+	schema, err := cmd.Flags().GetString("schema")
+	if err != nil {
+		return err
+	}
+
+	references, err := cmd.Flags().GetString("references")
+	if err != nil {
+		return err
+	}
+	refs, err := schemaregistry.ReadSchemaReferences(references)
+	if err != nil {
+		return err
+	}
+
+	dir, err := createTempDir()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	schemaConfigs := &schemaregistry.RegisterSchemaConfigs{
+		Subject:    subject,
+		SchemaDir:  dir,
+		SchemaType: serializer.GetSchemaName(),
+		Format:     format,
+		SchemaPath: schema,
+		Refs:       refs,
+	}
+	metaInfo, referencePathMap, err := c.registerSchemaOnPrem(cmd, schemaConfigs)
+	if err != nil {
+		return err
+	}
+	if err := serializer.LoadSchema(schema, referencePathMap); err != nil {
+		return err
+	}
+	if err := setBenchmarkValueGenerator(cmd, b, serializer, metaInfo); err != nil {
+		return err
+	}
+
+	produceOnly, err := cmd.Flags().GetBool("produce-only")
+	if err != nil {
+		return err
+	}
+	if produceOnly {
+		return nil
+	}
+
+	b.consumer, err = newOnPremConsumer(cmd, c.Context, "", c.clientID, "", nil)
+	if err != nil {
+		return errors.NewErrorWithSuggestions(
+			fmt.Sprintf(errors.FailedToCreateConsumerErrorMsg, err),
+			errors.OnPremConfigGuideSuggestions,
+		)
+	}
+	return refreshOAuthBearerToken(cmd, b.consumer, c.Context)
+}
+
+// setBenchmarkValueGenerator sets the values of a benchmark's records to random payloads of the record size for the
+// string format, or to random records which are valid for the serializer's format and schema otherwise
+func setBenchmarkValueGenerator(cmd *cobra.Command, b *benchmark, serializer serdes.SerializationProvider, metaInfo []byte) error {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	if _, ok := serializer.(*serdes.StringSerializationProvider); ok {
+		recordSize, err := cmd.Flags().GetInt("record-size")
+		if err != nil {
+			return err
+		}
+		if recordSize < 0 {
+			return fmt.Errorf("record size must not be negative")
+		}
+
+		// like the Kafka perf tools, every record shares one random payload so that generating values is not measured
+		payload := make([]byte, recordSize)
+		for i := range payload {
+			payload[i] = byte('a' + r.Intn(26))
+		}
+		b.nextValue = func() ([]byte, error) { return payload, nil }
+		return nil
+	}
+
+	generator, err := serdes.NewGenerator(serializer, r, nil)
+	if err != nil {
+		return err
+	}
+	b.nextValue = func() ([]byte, error) {
+		record, err := generator.Generate()
+		if err != nil {
+			return nil, err
+		}
+		value, err := serializer.Serialize(record)
+		if err != nil {
+			return nil, err
+		}
+		return append(slices.Clone(metaInfo), value...), nil
+	}
+	return nil
+}
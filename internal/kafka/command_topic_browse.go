@@ -0,0 +1,154 @@
+package kafka
+
+import (
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/kafka"
+	"github.com/confluentinc/cli/v3/pkg/schemaregistry"
+	"github.com/confluentinc/cli/v3/pkg/serdes"
+)
+
+func (c *command) newBrowseCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "browse [topic]",
+		Short:             "Browse the topics and messages of Kafka clusters in an interactive terminal UI.",
+		Long:              "Browse the topics and messages of Kafka clusters in an interactive terminal UI.\n\nPick a Kafka cluster and topic from lists, page through the messages of each partition, inspect the key, value, headers, and schemas of a message, and jump to an offset or timestamp. If a topic is passed, or the `--cluster` flag is used, the Kafka cluster of the context or flag is browsed instead of picking one from the list.\n\nMessages are read without joining a consumer group or committing offsets. The API key of each Kafka cluster must be stored with `confluent api-key store` and selected with `confluent api-key use`, unless the `--api-key` and `--api-secret` flags are used.",
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: pcmd.NewValidArgsFunction(c.validArgs),
+		RunE:              c.browse,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Pick a Kafka cluster and topic to browse.",
+				Code: "confluent kafka topic browse",
+			},
+			examples.Example{
+				Text: `Browse the Avro messages of topic "my-topic" in Kafka cluster "lkc-123456".`,
+				Code: "confluent kafka topic browse my-topic --cluster lkc-123456 --value-format avro",
+			},
+		),
+	}
+
+	cmd.Flags().Int("page-size", 50, "Number of messages per page.")
+	pcmd.AddKeyFormatFlag(cmd)
+	pcmd.AddValueFormatFlag(cmd)
+	pcmd.AddApiKeyFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddApiSecretFlag(cmd)
+	cmd.Flags().String("schema-registry-context", "", "The Schema Registry context under which to look up schema ID.")
+	cmd.Flags().String("schema-registry-endpoint", "", "Endpoint for Schema Registry cluster.")
+	cmd.Flags().String("schema-registry-api-key", "", "Schema registry API key.")
+	cmd.Flags().String("schema-registry-api-secret", "", "Schema registry API secret.")
+	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+
+	return cmd
+}
+
+func (c *command) browse(cmd *cobra.Command, args []string) error {
+	pageSize, err := cmd.Flags().GetInt("page-size")
+	if err != nil {
+		return err
+	}
+	if pageSize < 1 {
+		return fmt.Errorf("page size must be at least 1")
+	}
+
+	keyFormat, err := cmd.Flags().GetString("key-format")
+	if err != nil {
+		return err
+	}
+
+	valueFormat, err := cmd.Flags().GetString("value-format")
+	if err != nil {
+		return err
+	}
+
+	var srClient *schemaregistry.Client
+	if slices.Contains(serdes.SchemaBasedFormats, valueFormat) || slices.Contains(serdes.SchemaBasedFormats, keyFormat) {
+		srClient, err = c.GetSchemaRegistryClient(cmd)
+		if err != nil {
+			if err.Error() == errors.NotLoggedInErrorMsg {
+				return new(errors.SRNotAuthenticatedError)
+			}
+			return err
+		}
+	}
+
+	schemaPath, err := createTempDir()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.RemoveAll(schemaPath)
+	}()
+
+	schemaRegistryContext, err := cmd.Flags().GetString("schema-registry-context")
+	if err != nil {
+		return err
+	}
+
+	// without a Schema Registry context, the subject is set to each topic as it is browsed
+	handler := &GroupHandler{
+		SrClient:    srClient,
+		KeyFormat:   keyFormat,
+		ValueFormat: valueFormat,
+		Subject:     schemaRegistryContext,
+		Properties:  ConsumerProperties{SchemaPath: schemaPath},
+	}
+
+	var clusterId, topic string
+	if len(args) > 0 || cmd.Flags().Changed("cluster") {
+		cluster, err := kafka.GetClusterForCommand(c.V2Client, c.Context)
+		if err != nil {
+			return err
+		}
+		clusterId = cluster.ID
+	}
+	if len(args) > 0 {
+		topic = args[0]
+	}
+
+	openCluster := func(id string) (recordSource, error) {
+		cluster, err := kafka.FindCluster(c.V2Client, c.Context, id)
+		if err != nil {
+			return nil, err
+		}
+		if err := addApiKeyToCluster(cmd, cluster); err != nil {
+			return nil, err
+		}
+
+		consumer, err := newConsumer(fmt.Sprintf("confluent_cli_browser_%s", uuid.New()), cluster, c.clientID, "", nil)
+		if err != nil {
+			return nil, fmt.Errorf(errors.FailedToCreateConsumerErrorMsg, err)
+		}
+		return &consumerRecordSource{consumer: consumer}, nil
+	}
+
+	return newTopicBrowser(c.listBrowsedClusters, openCluster, handler, pageSize).run(clusterId, topic)
+}
+
+func (c *command) listBrowsedClusters() ([]browsedCluster, error) {
+	environmentId, err := c.Context.EnvironmentId()
+	if err != nil {
+		return nil, err
+	}
+
+	clusters, err := c.V2Client.ListKafkaClusters(environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	browsedClusters := make([]browsedCluster, len(clusters))
+	for i, cluster := range clusters {
+		browsedClusters[i] = browsedCluster{id: cluster.GetId(), name: cluster.Spec.GetDisplayName()}
+	}
+	return browsedClusters, nil
+}
@@ -300,7 +300,12 @@ func (c *command) consumeOnPrem(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	consumer, err := newOnPremConsumer(cmd, c.clientID, configFile, config)
+	group, err := cmd.Flags().GetString("group")
+	if err != nil {
+		return err
+	}
+
+	consumer, err := newOnPremConsumer(cmd, c.Context, group, c.clientID, configFile, config)
 	if err != nil {
 		return errors.NewErrorWithSuggestions(
 			fmt.Sprintf(errors.FailedToCreateConsumerErrorMsg, err),
@@ -309,7 +314,7 @@ func (c *command) consumeOnPrem(cmd *cobra.Command, args []string) error {
 	}
 	log.CliLogger.Tracef("Create consumer succeeded")
 
-	if err := c.refreshOAuthBearerToken(cmd, consumer); err != nil {
+	if err := refreshOAuthBearerToken(cmd, consumer, c.Context); err != nil {
 		return err
 	}
 
@@ -374,6 +379,7 @@ func (c *command) consumeOnPrem(cmd *cobra.Command, args []string) error {
 			SchemaPath:  dir,
 			Timestamp:   timestamp,
 		},
+		Context: c.Context,
 	}
 	return RunConsumer(consumer, groupHandler)
 }
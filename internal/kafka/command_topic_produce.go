@@ -5,11 +5,13 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"math/rand"
 	"os"
 	"path/filepath"
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/antihax/optional"
 	"github.com/spf13/cobra"
@@ -37,7 +39,7 @@ func (c *command) newProduceCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:               "produce <topic>",
 		Short:             "Produce messages to a Kafka topic.",
-		Long:              "Produce messages to a Kafka topic.\n\nWhen using this command, you cannot modify the message header, and the message header will not be printed out.",
+		Long:              "Produce messages to a Kafka topic.\n\nWhen using this command, you cannot modify the message header, and the message header will not be printed out.\n\nWith `--generate`, random messages which are valid for the key and value schemas are produced instead of reading messages from stdin. Schemas are passed with `--schema` and `--key-schema`, or looked up as the latest schemas of `--subject` and `--key-subject`. Pass the same `--seed` to reproduce the same messages, and `--override` to constrain the values of fields.",
 		Args:              cobra.ExactArgs(1),
 		ValidArgsFunction: pcmd.NewValidArgsFunction(c.validArgs),
 		RunE:              c.produce,
@@ -46,17 +48,29 @@ func (c *command) newProduceCommand() *cobra.Command {
 				Text: `Produce to topic "my_topic" in Confluent Cloud with a Confluent Cloud API key.`,
 				Code: "confluent kafka topic consume my_topic --api-key 0000000000000000 --api-secret <API_SECRET> --bootstrap SASL_SSL://pkc-12345.us-west-2.aws.confluent.cloud:9092 --value-format avro --schema test.avsc --schema-registry-endpoint https://psrc-12345.us-west-2.aws.confluent.cloud --schema-registry-api-key 0000000000000000 --schema-registry-api-secret <SCHEMA_REGISTRY_API_SECRET>",
 			},
+			examples.Example{
+				Text: `Produce 100 reproducible random messages for an Avro schema to topic "my_topic", constraining the values of fields "status" and "quantity".`,
+				Code: `confluent kafka topic produce my_topic --generate 100 --value-format avro --schema order.avsc --seed 42 --override "status=enum:NEW,SHIPPED" --override "quantity=range:1..10"`,
+			},
 		),
 	}
 
 	cmd.Flags().String("bootstrap", "", `Kafka cluster endpoint (Confluent Cloud); or comma-separated list of broker hosts (Confluent Platform), each formatted as "host" or "host:port".`)
 	cmd.Flags().String("key-schema", "", "The ID or filepath of the message key schema.")
 	cmd.Flags().String("schema", "", "The ID or filepath of the message value schema.")
+	cmd.Flags().String("subject", "", "The Schema Registry subject whose latest schema is the message value schema.")
+	cmd.Flags().String("key-subject", "", "The Schema Registry subject whose latest schema is the message key schema.")
 	pcmd.AddKeyFormatFlag(cmd)
 	pcmd.AddValueFormatFlag(cmd)
 	cmd.Flags().String("references", "", "The path to the message value schema references file.")
+	cmd.Flags().String("schema-message", "", "The fully qualified name of the message type in the Protobuf message value schema. Defaults to the first message.")
+	cmd.Flags().String("key-schema-message", "", "The fully qualified name of the message type in the Protobuf message key schema. Defaults to the first message.")
 	cmd.Flags().Bool("parse-key", false, "Parse key from the message.")
 	cmd.Flags().String("delimiter", ":", "The delimiter separating each key and value.")
+	cmd.Flags().Int("generate", 0, "Number of random messages to generate from the key and value schemas, instead of reading messages from stdin.")
+	cmd.Flags().Int64("seed", 0, "Seed for generating random messages, which reproduces the same messages for the same schemas. Defaults to a random seed.")
+	cmd.Flags().StringArray("override", nil, `Constrain the generated values of a message value field, as "<field>=enum:<value>,<value>", "<field>=range:<min>..<max>", or "<field>=regex:<pattern>". Nested fields are separated by ".". May be passed multiple times.`)
+	cmd.Flags().StringArray("key-override", nil, "Constrain the generated values of a message key field, in the same form as `--override`. May be passed multiple times.")
 	cmd.Flags().StringSlice("config", nil, `A comma-separated list of configuration overrides ("key=value") for the producer client.`)
 	pcmd.AddProducerConfigFileFlag(cmd)
 	cmd.Flags().String("schema-registry-endpoint", "", "Endpoint for Schema Registry cluster.")
@@ -87,7 +101,10 @@ func (c *command) newProduceCommand() *cobra.Command {
 	cobra.CheckErr(cmd.MarkFlagFilename("config-file", "avsc", "json"))
 
 	cmd.MarkFlagsMutuallyExclusive("schema", "schema-id")
+	cmd.MarkFlagsMutuallyExclusive("schema", "subject")
+	cmd.MarkFlagsMutuallyExclusive("key-schema", "key-subject")
 	cmd.MarkFlagsMutuallyExclusive("config", "config-file")
+	cmd.MarkFlagsMutuallyExclusive("generate", "parse-key")
 
 	return cmd
 }
@@ -144,7 +161,7 @@ func (c *command) produceCloud(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	if cmd.Flags().Changed("key-format") && !parseKey {
+	if cmd.Flags().Changed("key-format") && !parseKey && !cmd.Flags().Changed("generate") {
 		return fmt.Errorf("`--parse-key` must be set when `key-format` is set")
 	}
 
@@ -174,6 +191,10 @@ func (c *command) produceCloud(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if cmd.Flags().Changed("generate") {
+		return c.produceGeneratedMessages(cmd, keyMetaInfo, valueMetaInfo, topic, keySerializer, valueSerializer, producer)
+	}
+
 	return ProduceToTopic(cmd, keyMetaInfo, valueMetaInfo, topic, keySerializer, valueSerializer, producer)
 }
 
@@ -187,7 +208,7 @@ func (c *command) produceOnPrem(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	producer, err := newOnPremProducer(cmd, c.clientID, configFile, config)
+	producer, err := newOnPremProducer(cmd, c.Context, c.clientID, configFile, config)
 	if err != nil {
 		return errors.NewErrorWithSuggestions(
 			fmt.Sprintf(errors.FailedToCreateProducerErrorMsg, err),
@@ -197,9 +218,10 @@ func (c *command) produceOnPrem(cmd *cobra.Command, args []string) error {
 	defer producer.Close()
 	log.CliLogger.Tracef("Create producer succeeded")
 
-	if err := c.refreshOAuthBearerToken(cmd, producer); err != nil {
+	if err := refreshOAuthBearerToken(cmd, producer, c.Context); err != nil {
 		return err
 	}
+	go handleProducerEvents(producer, c.Context)
 
 	adminClient, err := ckafka.NewAdminClientFromProducer(producer)
 	if err != nil {
@@ -227,7 +249,7 @@ func (c *command) produceOnPrem(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	if cmd.Flags().Changed("key-format") && !parseKey {
+	if cmd.Flags().Changed("key-format") && !parseKey && !cmd.Flags().Changed("generate") {
 		return fmt.Errorf("`--parse-key` must be set when `key-format` is set")
 	}
 
@@ -266,7 +288,13 @@ func (c *command) produceOnPrem(cmd *cobra.Command, args []string) error {
 		SchemaPath: keySchema,
 		Refs:       refs,
 	}
-	keyMetaInfo, keyReferencePathMap, err := c.registerSchemaOnPrem(cmd, keySchemaConfigs)
+	var keyMetaInfo []byte
+	var keyReferencePathMap map[string]string
+	if keySchema == "" && cmd.Flags().Changed("key-subject") {
+		keySchema, keyMetaInfo, keyReferencePathMap, err = c.getLatestSchemaOnPrem(cmd, "key-subject", keyFormat, dir)
+	} else {
+		keyMetaInfo, keyReferencePathMap, err = c.registerSchemaOnPrem(cmd, keySchemaConfigs)
+	}
 	if err != nil {
 		return err
 	}
@@ -282,7 +310,13 @@ func (c *command) produceOnPrem(cmd *cobra.Command, args []string) error {
 		SchemaPath: schema,
 		Refs:       refs,
 	}
-	valueMetaInfo, referencePathMap, err := c.registerSchemaOnPrem(cmd, valueSchemaConfigs)
+	var valueMetaInfo []byte
+	var referencePathMap map[string]string
+	if schema == "" && cmd.Flags().Changed("subject") {
+		schema, valueMetaInfo, referencePathMap, err = c.getLatestSchemaOnPrem(cmd, "subject", valueFormat, dir)
+	} else {
+		valueMetaInfo, referencePathMap, err = c.registerSchemaOnPrem(cmd, valueSchemaConfigs)
+	}
 	if err != nil {
 		return err
 	}
@@ -290,9 +324,146 @@ func (c *command) produceOnPrem(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if cmd.Flags().Changed("generate") {
+		return c.produceGeneratedMessages(cmd, keyMetaInfo, valueMetaInfo, topic, keySerializer, valueSerializer, producer)
+	}
+
 	return ProduceToTopic(cmd, keyMetaInfo, valueMetaInfo, topic, keySerializer, valueSerializer, producer)
 }
 
+// produceGeneratedMessages produces random messages which are valid for the key and value schemas. Keys are only
+// generated if a key format, schema, or override is passed.
+func (c *command) produceGeneratedMessages(cmd *cobra.Command, keyMetaInfo, valueMetaInfo []byte, topic string, keySerializer, valueSerializer serdes.SerializationProvider, producer *ckafka.Producer) error {
+	count, err := cmd.Flags().GetInt("generate")
+	if err != nil {
+		return err
+	}
+	if count < 1 {
+		return fmt.Errorf("number of generated messages must be at least 1")
+	}
+
+	seed := time.Now().UnixNano()
+	if cmd.Flags().Changed("seed") {
+		seed, err = cmd.Flags().GetInt64("seed")
+		if err != nil {
+			return err
+		}
+	}
+	r := rand.New(rand.NewSource(seed))
+
+	valueGenerator, err := newMessageGenerator(cmd, "override", valueSerializer, r)
+	if err != nil {
+		return err
+	}
+
+	var keyGenerator serdes.Generator
+	if cmd.Flags().Changed("key-format") || cmd.Flags().Changed("key-schema") || cmd.Flags().Changed("key-subject") || cmd.Flags().Changed("key-override") {
+		keyGenerator, err = newMessageGenerator(cmd, "key-override", keySerializer, r)
+		if err != nil {
+			return err
+		}
+	}
+
+	output.ErrPrintf(c.Config.EnableColor, "Producing %d generated messages to topic \"%s\" with seed %d.\n", count, topic, seed)
+
+	deliveryChan := make(chan ckafka.Event, count)
+	failed := 0
+	done := make(chan struct{})
+	go func() {
+		for e := range deliveryChan {
+			if m, ok := e.(*ckafka.Message); ok && m.TopicPartition.Error != nil {
+				output.ErrPrintf(c.Config.EnableColor, errors.FailedToProduceErrorMsg, m.TopicPartition.Offset, m.TopicPartition.Error)
+				failed++
+			}
+		}
+		close(done)
+	}()
+
+	produceErr := produceMessages(producer, deliveryChan, count, func(_ int) (*ckafka.Message, error) {
+		message := &ckafka.Message{TopicPartition: ckafka.TopicPartition{Topic: &topic, Partition: ckafka.PartitionAny}}
+
+		var err error
+		if keyGenerator != nil {
+			message.Key, err = generateMessagePart(keyGenerator, keySerializer, keyMetaInfo)
+			if err != nil {
+				return nil, err
+			}
+		}
+		message.Value, err = generateMessagePart(valueGenerator, valueSerializer, valueMetaInfo)
+		if err != nil {
+			return nil, err
+		}
+		return message, nil
+	})
+	close(deliveryChan)
+	<-done
+
+	if produceErr != nil {
+		_, err := errors.CatchProduceToCompactedTopicError(produceErr, topic)
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to produce %d of %d generated messages", failed, count)
+	}
+	return nil
+}
+
+// produceMessages produces the messages returned by next, waiting for the producer's queue to drain when it is full.
+// The producer is then flushed until every message is delivered, or has failed after the producer's message timeout.
+func produceMessages(producer *ckafka.Producer, deliveries chan ckafka.Event, count int, next func(int) (*ckafka.Message, error)) error {
+	produceErr := func() error {
+		for i := 0; i < count; i++ {
+			message, err := next(i)
+			if err != nil {
+				return err
+			}
+
+			for {
+				err := producer.Produce(message, deliveries)
+				if err == nil {
+					break
+				}
+				// wait for the producer's queue to drain
+				if kafkaErr, ok := err.(ckafka.Error); ok && kafkaErr.Code() == ckafka.ErrQueueFull {
+					producer.Flush(100)
+					continue
+				}
+				return err
+			}
+		}
+		return nil
+	}()
+
+	for remaining := 1; remaining > 0; {
+		remaining = producer.Flush(1000)
+	}
+	return produceErr
+}
+
+func newMessageGenerator(cmd *cobra.Command, overrideFlagName string, serializer serdes.SerializationProvider, r *rand.Rand) (serdes.Generator, error) {
+	overrides, err := cmd.Flags().GetStringArray(overrideFlagName)
+	if err != nil {
+		return nil, err
+	}
+	fieldOverrides, err := serdes.ParseFieldOverrides(overrides)
+	if err != nil {
+		return nil, err
+	}
+	return serdes.NewGenerator(serializer, r, fieldOverrides)
+}
+
+func generateMessagePart(generator serdes.Generator, serializer serdes.SerializationProvider, metaInfo []byte) ([]byte, error) {
+	record, err := generator.Generate()
+	if err != nil {
+		return nil, err
+	}
+	data, err := serializer.Serialize(record)
+	if err != nil {
+		return nil, err
+	}
+	return append(slices.Clone(metaInfo), data...), nil
+}
+
 func prepareSerializer(cmd *cobra.Command, topic, mode string) (string, string, serdes.SerializationProvider, error) {
 	valueFormat, err := cmd.Flags().GetString(fmt.Sprintf("%s-format", mode))
 	if err != nil {
@@ -304,6 +475,10 @@ func prepareSerializer(cmd *cobra.Command, topic, mode string) (string, string,
 		return "", "", nil, err
 	}
 
+	if err := setSchemaMessage(cmd, mode, serializer); err != nil {
+		return "", "", nil, err
+	}
+
 	return valueFormat, topicNameStrategy(topic, mode), serializer, nil
 }
 
@@ -346,6 +521,53 @@ func (c *command) registerSchemaOnPrem(cmd *cobra.Command, schemaCfg *schemaregi
 	return metaInfo, referencePathMap, nil
 }
 
+// getLatestSchemaOnPrem stores the latest schema of the subject passed to the flag in the directory, and returns its
+// path, the message meta info and the paths of its references. The schema must be of the passed format.
+func (c *command) getLatestSchemaOnPrem(cmd *cobra.Command, subjectFlagName, format, dir string) (string, []byte, map[string]string, error) {
+	if c.Context.State == nil { // require log-in to use oauthbearer token
+		return "", nil, nil, errors.NewErrorWithSuggestions(errors.NotLoggedInErrorMsg, errors.AuthTokenSuggestions)
+	}
+
+	subject, err := cmd.Flags().GetString(subjectFlagName)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	client, err := c.GetSchemaRegistryClient(cmd)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	latest, err := client.GetSchemaByVersion(subject, "latest", false)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	schemaString, err := client.GetSchema(latest.GetId(), subject)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	schemaFormat, err := serdes.FormatTranslation(schemaString.GetSchemaType())
+	if err != nil {
+		return "", nil, nil, err
+	}
+	if schemaFormat != format {
+		formatFlagName := "value-format"
+		if subjectFlagName == "key-subject" {
+			formatFlagName = "key-format"
+		}
+		return "", nil, nil, fmt.Errorf(`the latest schema of subject "%s" is in format "%s", but "%s" was passed to `+"`--%s`", subject, schemaFormat, format, formatFlagName)
+	}
+
+	schemaPath, referencePathMap, err := setSchemaPathRef(schemaString, dir, subject, latest.GetId(), client)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	return schemaPath, getMetaInfoFromSchemaId(latest.GetId()), referencePathMap, nil
+}
+
 func (c *command) registerSchema(cmd *cobra.Command, schemaCfg *schemaregistry.RegisterSchemaConfigs) ([]byte, map[string]string, error) {
 	// Registering schema and fill metaInfo array.
 	var metaInfo []byte // Meta info contains a magic byte and schema ID (4 bytes).
@@ -516,6 +738,29 @@ func (c *command) initSchemaAndGetInfo(cmd *cobra.Command, topic, mode string) (
 		schemaId = optional.NewInt32(int32(id))
 	}
 
+	subjectFlagName := "subject"
+	if mode == "key" {
+		subjectFlagName = "key-subject"
+	}
+	if schema == "" && cmd.Flags().Changed(subjectFlagName) {
+		// use the latest schema of the subject
+		subject, err = cmd.Flags().GetString(subjectFlagName)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		client, err := c.GetSchemaRegistryClient(cmd)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		latest, err := client.GetSchemaByVersion(subject, "latest", false)
+		if err != nil {
+			return nil, nil, err
+		}
+		schemaId = optional.NewInt32(latest.GetId())
+	}
+
 	var format string
 	referencePathMap := map[string]string{}
 	metaInfo := []byte{}
@@ -554,6 +799,10 @@ func (c *command) initSchemaAndGetInfo(cmd *cobra.Command, topic, mode string) (
 		return nil, nil, err
 	}
 
+	if err := setSchemaMessage(cmd, mode, serializationProvider); err != nil {
+		return nil, nil, err
+	}
+
 	if schema != "" && !schemaId.IsSet() {
 		// read schema info from local file and register schema
 		schemaCfg := &schemaregistry.RegisterSchemaConfigs{
@@ -591,6 +840,28 @@ func (c *command) initSchemaAndGetInfo(cmd *cobra.Command, topic, mode string) (
 	return serializationProvider, metaInfo, nil
 }
 
+// setSchemaMessage selects the message type of a Protobuf schema
+func setSchemaMessage(cmd *cobra.Command, mode string, serializationProvider serdes.SerializationProvider) error {
+	flag := "schema-message"
+	if mode == "key" {
+		flag = "key-schema-message"
+	}
+	messageName, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return err
+	}
+	if messageName == "" {
+		return nil
+	}
+
+	protobufProvider, ok := serializationProvider.(*serdes.ProtobufSerializationProvider)
+	if !ok {
+		return fmt.Errorf("`--%s` is only supported for Protobuf schemas", flag)
+	}
+	protobufProvider.SetMessageName(messageName)
+	return nil
+}
+
 func getMetaInfoFromSchemaId(id int32) []byte {
 	metaInfo := []byte{0x0}
 	schemaIdBuffer := make([]byte, 4)
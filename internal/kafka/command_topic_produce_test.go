@@ -1,10 +1,13 @@
 package kafka
 
 import (
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/confluentinc/cli/v3/pkg/serdes"
 )
 
 type splitTest struct {
@@ -100,3 +103,20 @@ func TestGetMetaInfoFromSchemaId(t *testing.T) {
 	metaInfo := getMetaInfoFromSchemaId(100004)
 	require.Equal(t, []byte{0x0, 0x0, 0x1, 0x86, 0xa4}, metaInfo)
 }
+
+func TestGenerateMessagePart(t *testing.T) {
+	serializer, err := serdes.GetSerializationProvider("string")
+	require.NoError(t, err)
+	generator, err := serdes.NewGenerator(serializer, rand.New(rand.NewSource(1)), nil)
+	require.NoError(t, err)
+
+	metaInfo := make([]byte, 1, 16)
+	first, err := generateMessagePart(generator, serializer, metaInfo)
+	require.NoError(t, err)
+	second, err := generateMessagePart(generator, serializer, metaInfo)
+	require.NoError(t, err)
+
+	require.Equal(t, byte(0), first[0])
+	require.Equal(t, byte(0), second[0])
+	require.NotEqual(t, first, second)
+}
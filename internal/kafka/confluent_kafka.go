@@ -13,11 +13,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/go-jose/go-jose/v3/jwt"
 	"github.com/spf13/cobra"
 
 	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
 	srsdk "github.com/confluentinc/schema-registry-sdk-go"
 
+	pauth "github.com/confluentinc/cli/v3/pkg/auth"
 	"github.com/confluentinc/cli/v3/pkg/config"
 	"github.com/confluentinc/cli/v3/pkg/errors"
 	"github.com/confluentinc/cli/v3/pkg/log"
@@ -34,6 +36,9 @@ const (
 	principalClaimNameKey = "principalClaimName"
 	principalKey          = "principal"
 	oauthConfig           = "principalClaimName=confluent principal=admin"
+
+	// Lifetime of SASL/OAUTHBEARER tokens whose expiration is unknown
+	defaultTokenLifetime = time.Hour
 )
 
 var (
@@ -61,9 +66,11 @@ type GroupHandler struct {
 	Out         io.Writer
 	Subject     string
 	Properties  ConsumerProperties
+	// Context is used to refresh the token of consumers which authenticate with SASL/OAUTHBEARER
+	Context *config.Context
 }
 
-func (c *command) refreshOAuthBearerToken(cmd *cobra.Command, client ckafka.Handle) error {
+func refreshOAuthBearerToken(cmd *cobra.Command, client ckafka.Handle, context *config.Context) error {
 	protocol, err := cmd.Flags().GetString("protocol")
 	if err != nil {
 		return err
@@ -73,23 +80,57 @@ func (c *command) refreshOAuthBearerToken(cmd *cobra.Command, client ckafka.Hand
 		return err
 	}
 	if protocol == "SASL_SSL" && saslMechanism == "OAUTHBEARER" {
-		oart := ckafka.OAuthBearerTokenRefresh{Config: oauthConfig}
-		if c.Context.GetState() == nil { // require log-in to use oauthbearer token
-			return errors.NewErrorWithSuggestions(errors.NotLoggedInErrorMsg, errors.AuthTokenSuggestions)
+		return setOAuthBearerToken(client, context)
+	}
+	return nil
+}
+
+// setOAuthBearerToken sets the token with which a client authenticates with SASL/OAUTHBEARER, when the client is
+// created, and again whenever librdkafka requests a new token because the current one is about to expire.
+func setOAuthBearerToken(client ckafka.Handle, context *config.Context) error {
+	if context.GetState() == nil { // require log-in to use oauthbearer token
+		return errors.NewErrorWithSuggestions(errors.NotLoggedInErrorMsg, errors.AuthTokenSuggestions)
+	}
+
+	oauthBearerToken, err := retrieveOAuthBearerToken(context)
+	if err != nil {
+		_ = client.SetOAuthBearerTokenFailure(err.Error())
+		return fmt.Errorf("token retrieval error: %w", err)
+	}
+
+	if err := client.SetOAuthBearerToken(oauthBearerToken); err != nil {
+		_ = client.SetOAuthBearerTokenFailure(err.Error())
+		return fmt.Errorf("error setting token and extensions: %w", err)
+	}
+
+	return nil
+}
+
+// retrieveOAuthBearerToken requests a new token from the token endpoint if the context logged in as an OAuth client,
+// and otherwise uses the context's auth token.
+func retrieveOAuthBearerToken(context *config.Context) (ckafka.OAuthBearerToken, error) {
+	if credential := context.Credential; credential != nil && credential.OAuth != nil {
+		token, err := pauth.GetOAuthToken(credential.OAuth, context.Platform.CaCertPath)
+		if err != nil {
+			return ckafka.OAuthBearerToken{}, err
 		}
-		oauthBearerToken, retrieveErr := retrieveUnsecuredToken(oart, c.Context.GetAuthToken())
-		if retrieveErr != nil {
-			_ = client.SetOAuthBearerTokenFailure(retrieveErr.Error())
-			return fmt.Errorf("token retrieval error: %w", retrieveErr)
-		} else {
-			setTokenError := client.SetOAuthBearerToken(oauthBearerToken)
-			if setTokenError != nil {
-				_ = client.SetOAuthBearerTokenFailure(setTokenError.Error())
-				return fmt.Errorf("error setting token and extensions: %w", setTokenError)
-			}
+
+		subject, expiration := getTokenClaims(token.AccessToken)
+		if !token.Expiry.IsZero() {
+			expiration = token.Expiry
+		}
+		if subject == "" {
+			subject = credential.OAuth.ClientId
 		}
+
+		return ckafka.OAuthBearerToken{
+			TokenValue: token.AccessToken,
+			Expiration: expiration,
+			Principal:  subject,
+		}, nil
 	}
-	return nil
+
+	return retrieveUnsecuredToken(ckafka.OAuthBearerTokenRefresh{Config: oauthConfig}, context.GetAuthToken())
 }
 
 func retrieveUnsecuredToken(e ckafka.OAuthBearerTokenRefresh, tokenValue string) (ckafka.OAuthBearerToken, error) {
@@ -112,8 +153,7 @@ func retrieveUnsecuredToken(e ckafka.OAuthBearerTokenRefresh, tokenValue string)
 		return ckafka.OAuthBearerToken{}, fmt.Errorf("ignoring event %T: unrecognized key(s): %s", e, config)
 	}
 
-	now := time.Now()
-	expiration := now.Add(time.Second * time.Duration(3600)) // timeout after 60 mins. TODO: re-authenticate after timout
+	_, expiration := getTokenClaims(tokenValue)
 	oauthBearerToken := ckafka.OAuthBearerToken{
 		TokenValue: tokenValue,
 		Expiration: expiration,
@@ -122,6 +162,42 @@ func retrieveUnsecuredToken(e ckafka.OAuthBearerTokenRefresh, tokenValue string)
 	return oauthBearerToken, nil
 }
 
+// getTokenClaims returns the subject and expiration of a JWT, without verifying its signature. Tokens which are not
+// JWTs, or have no expiration, are assumed to expire after an hour.
+func getTokenClaims(token string) (string, time.Time) {
+	expiration := time.Now().Add(defaultTokenLifetime)
+
+	parsedToken, err := jwt.ParseSigned(token)
+	if err != nil {
+		return "", expiration
+	}
+
+	claims := jwt.Claims{}
+	if err := parsedToken.UnsafeClaimsWithoutVerification(&claims); err != nil {
+		return "", expiration
+	}
+
+	if claims.Expiry != nil {
+		expiration = claims.Expiry.Time()
+	}
+	return claims.Subject, expiration
+}
+
+// handleProducerEvents logs the errors of a producer, and refreshes its SASL/OAUTHBEARER token when librdkafka requests
+// it, until the producer is closed.
+func handleProducerEvents(producer *ckafka.Producer, context *config.Context) {
+	for event := range producer.Events() {
+		switch e := event.(type) {
+		case ckafka.OAuthBearerTokenRefresh:
+			if err := setOAuthBearerToken(producer, context); err != nil {
+				log.CliLogger.Warnf("Failed to refresh OAuth token: %v", err)
+			}
+		case ckafka.Error:
+			log.CliLogger.Warnf("Producer error: %v", e)
+		}
+	}
+}
+
 func newProducer(kafka *config.KafkaClusterConfig, clientID, configPath string, configStrings []string) (*ckafka.Producer, error) {
 	configMap, err := getProducerConfigMap(kafka, clientID)
 	if err != nil {
@@ -140,8 +216,8 @@ func newConsumer(group string, kafka *config.KafkaClusterConfig, clientID, confi
 	return newConsumerWithOverwrittenConfigs(configMap, configPath, configStrings)
 }
 
-func newOnPremProducer(cmd *cobra.Command, clientID, configPath string, configStrings []string) (*ckafka.Producer, error) {
-	configMap, err := getOnPremProducerConfigMap(cmd, clientID)
+func newOnPremProducer(cmd *cobra.Command, context *config.Context, clientID, configPath string, configStrings []string) (*ckafka.Producer, error) {
+	configMap, err := getOnPremProducerConfigMap(cmd, context, clientID)
 	if err != nil {
 		return nil, fmt.Errorf(errors.FailedToGetConfigurationErrorMsg, err)
 	}
@@ -149,8 +225,8 @@ func newOnPremProducer(cmd *cobra.Command, clientID, configPath string, configSt
 	return newProducerWithOverwrittenConfigs(configMap, configPath, configStrings)
 }
 
-func newOnPremConsumer(cmd *cobra.Command, clientID, configPath string, configStrings []string) (*ckafka.Consumer, error) {
-	configMap, err := getOnPremConsumerConfigMap(cmd, clientID)
+func newOnPremConsumer(cmd *cobra.Command, context *config.Context, group, clientID, configPath string, configStrings []string) (*ckafka.Consumer, error) {
+	configMap, err := getOnPremConsumerConfigMap(cmd, context, group, clientID)
 	if err != nil {
 		return nil, fmt.Errorf(errors.FailedToGetConfigurationErrorMsg, err)
 	}
@@ -314,6 +390,10 @@ func RunConsumer(consumer *ckafka.Consumer, groupHandler *GroupHandler) error {
 
 					return err
 				}
+			case ckafka.OAuthBearerTokenRefresh:
+				if err := setOAuthBearerToken(consumer, groupHandler.Context); err != nil {
+					log.CliLogger.Warnf("Failed to refresh OAuth token: %v", err)
+				}
 			case ckafka.Error:
 				fmt.Fprintf(groupHandler.Out, "%% Error: %v: %v\n", e.Code(), e)
 				if e.Code() == ckafka.ErrAllBrokersDown {
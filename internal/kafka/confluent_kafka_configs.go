@@ -94,7 +94,7 @@ func getOnPremCommonConfig(clientID, bootstrap string) *ckafka.ConfigMap {
 	}
 }
 
-func getOnPremProducerConfigMap(cmd *cobra.Command, clientID string) (*ckafka.ConfigMap, error) {
+func getOnPremProducerConfigMap(cmd *cobra.Command, context *config.Context, clientID string) (*ckafka.ConfigMap, error) {
 	bootstrap, err := cmd.Flags().GetString("bootstrap")
 	if err != nil {
 		return nil, err
@@ -131,10 +131,10 @@ func getOnPremProducerConfigMap(cmd *cobra.Command, clientID string) (*ckafka.Co
 		return nil, err
 	}
 
-	return setProtocolConfig(cmd, configMap)
+	return setProtocolConfig(cmd, context, configMap)
 }
 
-func getOnPremConsumerConfigMap(cmd *cobra.Command, clientID string) (*ckafka.ConfigMap, error) {
+func getOnPremConsumerConfigMap(cmd *cobra.Command, context *config.Context, group, clientID string) (*ckafka.ConfigMap, error) {
 	bootstrap, err := cmd.Flags().GetString("bootstrap")
 	if err != nil {
 		return nil, err
@@ -160,10 +160,6 @@ func getOnPremConsumerConfigMap(cmd *cobra.Command, clientID string) (*ckafka.Co
 		}
 	}
 
-	group, err := cmd.Flags().GetString("group")
-	if err != nil {
-		return nil, err
-	}
 	if group == "" {
 		group = fmt.Sprintf("confluent_cli_consumer_%s", uuid.New())
 	}
@@ -181,10 +177,10 @@ func getOnPremConsumerConfigMap(cmd *cobra.Command, clientID string) (*ckafka.Co
 		return nil, err
 	}
 
-	return setProtocolConfig(cmd, configMap)
+	return setProtocolConfig(cmd, context, configMap)
 }
 
-func setProtocolConfig(cmd *cobra.Command, configMap *ckafka.ConfigMap) (*ckafka.ConfigMap, error) {
+func setProtocolConfig(cmd *cobra.Command, context *config.Context, configMap *ckafka.ConfigMap) (*ckafka.ConfigMap, error) {
 	protocol, err := cmd.Flags().GetString("protocol")
 	if err != nil {
 		return nil, err
@@ -196,7 +192,7 @@ func setProtocolConfig(cmd *cobra.Command, configMap *ckafka.ConfigMap) (*ckafka
 			return nil, err
 		}
 	case "SSL":
-		configMap, err = setSSLConfig(cmd, configMap)
+		configMap, err = setSSLConfig(cmd, context, configMap)
 		if err != nil {
 			return nil, err
 		}
@@ -221,7 +217,7 @@ func setPlaintextConfig(configMap *ckafka.ConfigMap) (*ckafka.ConfigMap, error)
 	return configMap, nil
 }
 
-func setSSLConfig(cmd *cobra.Command, configMap *ckafka.ConfigMap) (*ckafka.ConfigMap, error) {
+func setSSLConfig(cmd *cobra.Command, context *config.Context, configMap *ckafka.ConfigMap) (*ckafka.ConfigMap, error) {
 	certLocation, err := cmd.Flags().GetString("cert-location")
 	if err != nil {
 		return nil, err
@@ -234,6 +230,11 @@ func setSSLConfig(cmd *cobra.Command, configMap *ckafka.ConfigMap) (*ckafka.Conf
 	if err != nil {
 		return nil, err
 	}
+	// Without a client certificate from flags, use the one with which the user logged in to Confluent Platform
+	if certLocation == "" && keyLocation == "" && context != nil && context.Credential != nil {
+		certLocation = context.Credential.ClientCertPath
+		keyLocation = context.Credential.ClientKeyPath
+	}
 	sslMap := map[string]string{
 		"security.protocol":        "SSL",
 		"ssl.certificate.location": certLocation,
@@ -0,0 +1,278 @@
+package kafka
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"sync"
+	"time"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+)
+
+const (
+	// benchmarkTimestampHeader holds the time at which a benchmark record was produced, in nanoseconds since the Unix
+	// epoch, so that the end-to-end latency can be measured regardless of the topic's timestamp type
+	benchmarkTimestampHeader = "confluent-cli-benchmark-timestamp"
+	// benchmarkConsumeTimeout is how long to wait for produced records to be consumed once all have been acknowledged
+	benchmarkConsumeTimeout = 30 * time.Second
+	benchmarkPollTimeout    = time.Second
+)
+
+var keyDistributions = []string{"none", "sequential", "uniform", "zipf"}
+
+type benchmarkOut struct {
+	Phase              string  `human:"Phase" serialized:"phase"`
+	Records            int     `human:"Records" serialized:"records"`
+	RecordsPerSecond   float64 `human:"Records/sec" serialized:"records_per_second"`
+	MegabytesPerSecond float64 `human:"MB/sec" serialized:"megabytes_per_second"`
+	P50LatencyMs       float64 `human:"p50 Latency (ms)" serialized:"p50_latency_ms"`
+	P95LatencyMs       float64 `human:"p95 Latency (ms)" serialized:"p95_latency_ms"`
+	P99LatencyMs       float64 `human:"p99 Latency (ms)" serialized:"p99_latency_ms"`
+	MaxLatencyMs       float64 `human:"Max Latency (ms)" serialized:"max_latency_ms"`
+}
+
+// benchmarkPhase collects the latencies and sizes of the records of one phase of a benchmark
+type benchmarkPhase struct {
+	latencies []time.Duration
+	bytes     int64
+	last      time.Time
+}
+
+func (p *benchmarkPhase) add(latency time.Duration, bytes int, now time.Time) {
+	p.latencies = append(p.latencies, latency)
+	p.bytes += int64(bytes)
+	p.last = now
+}
+
+func (p *benchmarkPhase) summarize(name string, start time.Time) *benchmarkOut {
+	slices.Sort(p.latencies)
+
+	out := &benchmarkOut{
+		Phase:        name,
+		Records:      len(p.latencies),
+		P50LatencyMs: toMilliseconds(getPercentile(p.latencies, 50)),
+		P95LatencyMs: toMilliseconds(getPercentile(p.latencies, 95)),
+		P99LatencyMs: toMilliseconds(getPercentile(p.latencies, 99)),
+		MaxLatencyMs: toMilliseconds(getPercentile(p.latencies, 100)),
+	}
+	if elapsed := p.last.Sub(start).Seconds(); len(p.latencies) > 0 && elapsed > 0 {
+		out.RecordsPerSecond = round(float64(len(p.latencies)) / elapsed)
+		out.MegabytesPerSecond = round(float64(p.bytes) / elapsed / (1024 * 1024))
+	}
+	return out
+}
+
+// getPercentile returns the nearest-rank percentile of sorted durations
+func getPercentile(sorted []time.Duration, percentile float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := int(math.Ceil(percentile/100*float64(len(sorted)))) - 1
+	return sorted[min(max(i, 0), len(sorted)-1)]
+}
+
+func toMilliseconds(d time.Duration) float64 {
+	return round(float64(d) / float64(time.Millisecond))
+}
+
+func round(f float64) float64 {
+	return math.Round(f*100) / 100
+}
+
+// newKeyGenerator returns a function which returns the key of each record, or nil if records have no key
+func newKeyGenerator(distribution string, numKeys int, r *rand.Rand) (func() []byte, error) {
+	if numKeys < 1 {
+		return nil, fmt.Errorf("number of keys must be at least 1")
+	}
+
+	switch distribution {
+	case "none":
+		return func() []byte { return nil }, nil
+	case "sequential":
+		i := 0
+		return func() []byte {
+			key := fmt.Sprintf("key-%d", i%numKeys)
+			i++
+			return []byte(key)
+		}, nil
+	case "uniform":
+		return func() []byte { return []byte(fmt.Sprintf("key-%d", r.Intn(numKeys))) }, nil
+	case "zipf":
+		// with a single key, every record must use it
+		if numKeys == 1 {
+			return func() []byte { return []byte("key-0") }, nil
+		}
+		zipf := rand.NewZipf(r, 1.1, 1, uint64(numKeys-1))
+		return func() []byte { return []byte(fmt.Sprintf("key-%d", zipf.Uint64())) }, nil
+	default:
+		return nil, fmt.Errorf(`invalid key distribution "%s"`, distribution)
+	}
+}
+
+// getPacingDelay returns how long to wait before sending the next record, so that records are sent at the target rate
+// in records per second. A rate of 0 sends records as fast as possible.
+func getPacingDelay(start, now time.Time, sent, rate int) time.Duration {
+	if rate <= 0 {
+		return 0
+	}
+	due := start.Add(time.Duration(float64(sent) / float64(rate) * float64(time.Second)))
+	return max(due.Sub(now), 0)
+}
+
+type benchmark struct {
+	producer *ckafka.Producer
+	// consumer measures the end-to-end latency, and is nil if only producing is measured
+	consumer   *ckafka.Consumer
+	topic      string
+	numRecords int
+	rate       int
+	nextKey    func() []byte
+	nextValue  func() ([]byte, error)
+}
+
+func (b *benchmark) run() ([]*benchmarkOut, error) {
+	if b.consumer != nil {
+		if err := b.assignEndOffsets(); err != nil {
+			return nil, err
+		}
+	}
+
+	produced := new(benchmarkPhase)
+	consumed := new(benchmarkPhase)
+	start := time.Now()
+
+	deliveries := make(chan ckafka.Event, 10000)
+	acked := make(chan int, 1)
+	var deliveryErr error
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		count := 0
+		for e := range deliveries {
+			message, ok := e.(*ckafka.Message)
+			if !ok {
+				continue
+			}
+			if message.TopicPartition.Error != nil {
+				if deliveryErr == nil {
+					deliveryErr = message.TopicPartition.Error
+				}
+				continue
+			}
+			now := time.Now()
+			produced.add(now.Sub(message.Opaque.(time.Time)), len(message.Key)+len(message.Value), now)
+			count++
+		}
+		acked <- count
+	}()
+
+	if b.consumer != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			b.consume(consumed, acked)
+		}()
+	}
+
+	produceErr := produceMessages(b.producer, deliveries, b.numRecords, func(i int) (*ckafka.Message, error) {
+		return b.nextMessage(start, i)
+	})
+	close(deliveries)
+	wg.Wait()
+
+	if produceErr != nil {
+		return nil, produceErr
+	}
+	if deliveryErr != nil {
+		return nil, fmt.Errorf("failed to deliver records: %w", deliveryErr)
+	}
+
+	outs := []*benchmarkOut{produced.summarize("Produce", start)}
+	if b.consumer != nil {
+		outs = append(outs, consumed.summarize("End-to-End", start))
+	}
+	return outs, nil
+}
+
+// nextMessage returns the i-th record once it is due, with its send time in a header for the end-to-end latency
+func (b *benchmark) nextMessage(start time.Time, i int) (*ckafka.Message, error) {
+	time.Sleep(getPacingDelay(start, time.Now(), i, b.rate))
+
+	value, err := b.nextValue()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	timestamp := make([]byte, 8)
+	binary.BigEndian.PutUint64(timestamp, uint64(now.UnixNano()))
+
+	return &ckafka.Message{
+		TopicPartition: ckafka.TopicPartition{Topic: &b.topic, Partition: ckafka.PartitionAny},
+		Key:            b.nextKey(),
+		Value:          value,
+		Headers:        []ckafka.Header{{Key: benchmarkTimestampHeader, Value: timestamp}},
+		Opaque:         now,
+	}, nil
+}
+
+// assignEndOffsets assigns every partition of the topic to the consumer at its current end, so that only the records
+// produced by the benchmark are consumed
+func (b *benchmark) assignEndOffsets() error {
+	timeout := int(benchmarkConsumeTimeout.Milliseconds())
+
+	metadata, err := b.consumer.GetMetadata(&b.topic, false, timeout)
+	if err != nil {
+		return err
+	}
+	topic, ok := metadata.Topics[b.topic]
+	if !ok || topic.Error.Code() != ckafka.ErrNoError {
+		return fmt.Errorf(`failed to get the partitions of topic "%s"`, b.topic)
+	}
+
+	partitions := make([]ckafka.TopicPartition, len(topic.Partitions))
+	for i, partition := range topic.Partitions {
+		_, high, err := b.consumer.QueryWatermarkOffsets(b.topic, partition.ID, timeout)
+		if err != nil {
+			return err
+		}
+		partitions[i] = ckafka.TopicPartition{Topic: &b.topic, Partition: partition.ID, Offset: ckafka.Offset(high)}
+	}
+	return b.consumer.Assign(partitions)
+}
+
+// consume reads the benchmark's records until as many as were acknowledged have been read, or no more arrive before
+// the timeout
+func (b *benchmark) consume(phase *benchmarkPhase, acked <-chan int) {
+	expected := -1
+	var deadline time.Time
+
+	for expected < 0 || len(phase.latencies) < expected {
+		select {
+		case count := <-acked:
+			expected = count
+			deadline = time.Now().Add(benchmarkConsumeTimeout)
+		default:
+		}
+		if expected >= 0 && time.Now().After(deadline) {
+			return
+		}
+
+		message, err := b.consumer.ReadMessage(benchmarkPollTimeout)
+		if err != nil {
+			continue
+		}
+		now := time.Now()
+		for _, header := range message.Headers {
+			if header.Key == benchmarkTimestampHeader && len(header.Value) == 8 {
+				sent := time.Unix(0, int64(binary.BigEndian.Uint64(header.Value)))
+				phase.add(now.Sub(sent), len(message.Key)+len(message.Value), now)
+			}
+		}
+	}
+}
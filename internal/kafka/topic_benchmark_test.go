@@ -0,0 +1,76 @@
+package kafka
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPercentile(t *testing.T) {
+	latencies := make([]time.Duration, 100)
+	for i := range latencies {
+		latencies[i] = time.Duration(i+1) * time.Millisecond
+	}
+
+	require.Equal(t, 50*time.Millisecond, getPercentile(latencies, 50))
+	require.Equal(t, 99*time.Millisecond, getPercentile(latencies, 99))
+	require.Equal(t, 100*time.Millisecond, getPercentile(latencies, 100))
+	require.Equal(t, time.Millisecond, getPercentile(latencies, 0))
+	require.Equal(t, time.Duration(0), getPercentile(nil, 50))
+}
+
+func TestBenchmarkPhaseSummarize(t *testing.T) {
+	start := time.Unix(0, 0)
+	phase := new(benchmarkPhase)
+	phase.add(30*time.Millisecond, 1024*1024, start.Add(time.Second))
+	phase.add(10*time.Millisecond, 1024*1024, start.Add(2*time.Second))
+
+	require.Equal(t, &benchmarkOut{
+		Phase:              "Produce",
+		Records:            2,
+		RecordsPerSecond:   1,
+		MegabytesPerSecond: 1,
+		P50LatencyMs:       10,
+		P95LatencyMs:       30,
+		P99LatencyMs:       30,
+		MaxLatencyMs:       30,
+	}, phase.summarize("Produce", start))
+
+	require.Equal(t, &benchmarkOut{Phase: "End-to-End"}, new(benchmarkPhase).summarize("End-to-End", start))
+}
+
+func TestNewKeyGenerator(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	nextKey, err := newKeyGenerator("none", 10, r)
+	require.NoError(t, err)
+	require.Nil(t, nextKey())
+
+	nextKey, err = newKeyGenerator("sequential", 2, r)
+	require.NoError(t, err)
+	require.Equal(t, []string{"key-0", "key-1", "key-0"}, []string{string(nextKey()), string(nextKey()), string(nextKey())})
+
+	for _, distribution := range []string{"uniform", "zipf"} {
+		nextKey, err = newKeyGenerator(distribution, 3, r)
+		require.NoError(t, err)
+		for i := 0; i < 100; i++ {
+			require.Contains(t, []string{"key-0", "key-1", "key-2"}, string(nextKey()))
+		}
+	}
+
+	_, err = newKeyGenerator("random", 10, r)
+	require.EqualError(t, err, `invalid key distribution "random"`)
+
+	_, err = newKeyGenerator("uniform", 0, r)
+	require.EqualError(t, err, "number of keys must be at least 1")
+}
+
+func TestGetPacingDelay(t *testing.T) {
+	start := time.Unix(0, 0)
+
+	require.Equal(t, time.Duration(0), getPacingDelay(start, start.Add(time.Second), 100, 0))
+	require.Equal(t, 500*time.Millisecond, getPacingDelay(start, start.Add(time.Second), 150, 100))
+	require.Equal(t, time.Duration(0), getPacingDelay(start, start.Add(2*time.Second), 150, 100))
+}
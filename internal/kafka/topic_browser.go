@@ -0,0 +1,219 @@
+package kafka
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+
+	"github.com/confluentinc/cli/v3/pkg/serdes"
+)
+
+const browserTimeout = 10 * time.Second
+
+type browsedCluster struct {
+	id   string
+	name string
+}
+
+type browsedTopic struct {
+	name       string
+	partitions []int32
+}
+
+// browsedRecord is a deserialized record, as shown in the table and detail pane of the topic browser.
+type browsedRecord struct {
+	partition   int32
+	offset      int64
+	timestamp   time.Time
+	key         string
+	value       string
+	headers     []string
+	keySchema   string
+	valueSchema string
+}
+
+// recordSource reads the topics of a cluster and pages of records from their partitions.
+type recordSource interface {
+	listTopics() ([]browsedTopic, error)
+	getWatermarks(topic string, partition int32) (int64, int64, error)
+	getOffsetForTimestamp(topic string, partition int32, timestamp time.Time) (int64, error)
+	readRecords(topic string, partition int32, offset int64, limit int) ([]*ckafka.Message, error)
+	close()
+}
+
+type consumerRecordSource struct {
+	consumer *ckafka.Consumer
+}
+
+func (s *consumerRecordSource) listTopics() ([]browsedTopic, error) {
+	metadata, err := s.consumer.GetMetadata(nil, true, int(browserTimeout.Milliseconds()))
+	if err != nil {
+		return nil, err
+	}
+
+	topics := make([]browsedTopic, 0, len(metadata.Topics))
+	for name, topic := range metadata.Topics {
+		partitions := make([]int32, len(topic.Partitions))
+		for i, partition := range topic.Partitions {
+			partitions[i] = partition.ID
+		}
+		slices.Sort(partitions)
+		topics = append(topics, browsedTopic{name: name, partitions: partitions})
+	}
+	slices.SortFunc(topics, func(a, b browsedTopic) int { return strings.Compare(a.name, b.name) })
+
+	return topics, nil
+}
+
+func (s *consumerRecordSource) getWatermarks(topic string, partition int32) (int64, int64, error) {
+	return s.consumer.QueryWatermarkOffsets(topic, partition, int(browserTimeout.Milliseconds()))
+}
+
+func (s *consumerRecordSource) getOffsetForTimestamp(topic string, partition int32, timestamp time.Time) (int64, error) {
+	topicPartitions := []ckafka.TopicPartition{{Topic: &topic, Partition: partition, Offset: ckafka.Offset(timestamp.UnixMilli())}}
+	offsets, err := s.consumer.OffsetsForTimes(topicPartitions, int(browserTimeout.Milliseconds()))
+	if err != nil {
+		return 0, err
+	}
+	if len(offsets) != 1 {
+		return 0, fmt.Errorf(`failed to find the offset of partition %d of topic "%s"`, partition, topic)
+	}
+	if offsets[0].Error != nil {
+		return 0, offsets[0].Error
+	}
+
+	// there is no record at or after the timestamp, so start from the end of the partition
+	if offsets[0].Offset == ckafka.OffsetEnd {
+		_, high, err := s.getWatermarks(topic, partition)
+		return high, err
+	}
+
+	return int64(offsets[0].Offset), nil
+}
+
+// readRecords reads up to limit records starting at the given offset, stopping early at the end of the partition.
+func (s *consumerRecordSource) readRecords(topic string, partition int32, offset int64, limit int) ([]*ckafka.Message, error) {
+	_, high, err := s.getWatermarks(topic, partition)
+	if err != nil {
+		return nil, err
+	}
+	end := min(offset+int64(limit), high)
+	if offset >= end {
+		return nil, nil
+	}
+
+	if err := s.consumer.Assign([]ckafka.TopicPartition{{Topic: &topic, Partition: partition, Offset: ckafka.Offset(offset)}}); err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = s.consumer.Unassign()
+	}()
+
+	var messages []*ckafka.Message
+	deadline := time.Now().Add(browserTimeout)
+	for time.Now().Before(deadline) {
+		message, err := s.consumer.ReadMessage(time.Until(deadline))
+		if err != nil {
+			if kafkaErr, ok := err.(ckafka.Error); ok && kafkaErr.Code() == ckafka.ErrTimedOut {
+				break
+			}
+			return nil, err
+		}
+
+		messages = append(messages, message)
+		// offsets of compacted topics may have gaps, so stop at the last offset before the end instead of counting records
+		if int64(message.TopicPartition.Offset) >= end-1 || len(messages) == limit {
+			break
+		}
+	}
+
+	return messages, nil
+}
+
+func (s *consumerRecordSource) close() {
+	_ = s.consumer.Close()
+}
+
+// decodeRecord deserializes a record with the formats of the group handler. Failing to deserialize the key or value
+// does not fail the record; the error is shown in its place instead.
+func decodeRecord(message *ckafka.Message, h *GroupHandler) *browsedRecord {
+	record := &browsedRecord{
+		partition: message.TopicPartition.Partition,
+		offset:    int64(message.TopicPartition.Offset),
+		timestamp: message.Timestamp,
+		headers:   getFullHeaders(message.Headers),
+	}
+
+	record.key, record.keySchema = decodeField(message.Key, h.KeyFormat, h)
+	record.value, record.valueSchema = decodeField(message.Value, h.ValueFormat, h)
+
+	return record
+}
+
+func decodeField(data []byte, format string, h *GroupHandler) (string, string) {
+	if data == nil {
+		return "null", ""
+	}
+
+	deserializer, err := serdes.GetDeserializationProvider(format)
+	if err != nil {
+		return fmt.Sprintf("<error: %v>", err), ""
+	}
+
+	var schema string
+	if slices.Contains(serdes.SchemaBasedFormats, format) {
+		schemaPath, referencePathMap, err := h.RequestSchema(data)
+		if err != nil {
+			return fmt.Sprintf("<error: %v>", err), ""
+		}
+		if err := deserializer.LoadSchema(schemaPath, referencePathMap); err != nil {
+			return fmt.Sprintf("<error: %v>", err), ""
+		}
+		if schemaBytes, err := os.ReadFile(schemaPath); err == nil {
+			schema = string(schemaBytes)
+		}
+		data = data[messageOffset:]
+	}
+
+	value, err := deserializer.Deserialize(data)
+	if err != nil {
+		return fmt.Sprintf("<error: %v>", err), schema
+	}
+	return value, schema
+}
+
+// getPreviousPageStart returns the offset of the page before the one starting at offset, without going before the
+// start of the partition.
+func getPreviousPageStart(offset, low int64, limit int) int64 {
+	return max(offset-int64(limit), low)
+}
+
+// parseJumpTarget parses the target of a jump, which is either an offset or a timestamp in RFC 3339 format or in
+// milliseconds since the Unix epoch prefixed with "@".
+func parseJumpTarget(target string) (int64, *time.Time, error) {
+	target = strings.TrimSpace(target)
+
+	if milliseconds, ok := strings.CutPrefix(target, "@"); ok {
+		i, err := strconv.ParseInt(milliseconds, 10, 64)
+		if err != nil {
+			return 0, nil, fmt.Errorf(`invalid timestamp "%s": use milliseconds since the Unix epoch`, milliseconds)
+		}
+		timestamp := time.UnixMilli(i)
+		return 0, &timestamp, nil
+	}
+
+	if timestamp, err := time.Parse(time.RFC3339, target); err == nil {
+		return 0, &timestamp, nil
+	}
+
+	offset, err := strconv.ParseInt(target, 10, 64)
+	if err != nil || offset < 0 {
+		return 0, nil, fmt.Errorf(`invalid offset or timestamp "%s"`, target)
+	}
+	return offset, nil, nil
+}
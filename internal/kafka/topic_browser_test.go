@@ -0,0 +1,78 @@
+package kafka
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+)
+
+func TestParseJumpTarget(t *testing.T) {
+	offset, timestamp, err := parseJumpTarget(" 42 ")
+	require.NoError(t, err)
+	require.Equal(t, int64(42), offset)
+	require.Nil(t, timestamp)
+
+	_, timestamp, err = parseJumpTarget("@1700000000000")
+	require.NoError(t, err)
+	require.Equal(t, int64(1700000000000), timestamp.UnixMilli())
+
+	_, timestamp, err = parseJumpTarget("2023-11-14T22:13:20Z")
+	require.NoError(t, err)
+	require.Equal(t, int64(1700000000000), timestamp.UnixMilli())
+
+	_, _, err = parseJumpTarget("-1")
+	require.EqualError(t, err, `invalid offset or timestamp "-1"`)
+
+	_, _, err = parseJumpTarget("@yesterday")
+	require.EqualError(t, err, `invalid timestamp "yesterday": use milliseconds since the Unix epoch`)
+}
+
+func TestGetPreviousPageStart(t *testing.T) {
+	require.Equal(t, int64(50), getPreviousPageStart(100, 0, 50))
+	require.Equal(t, int64(10), getPreviousPageStart(30, 10, 50))
+}
+
+func TestDecodeRecord(t *testing.T) {
+	topic := "orders"
+	message := &ckafka.Message{
+		TopicPartition: ckafka.TopicPartition{Topic: &topic, Partition: 2, Offset: 7},
+		Value:          []byte(`{"id":1}`),
+		Timestamp:      time.UnixMilli(1700000000000),
+		Headers:        []ckafka.Header{{Key: "source", Value: []byte("web")}},
+	}
+
+	record := decodeRecord(message, &GroupHandler{KeyFormat: "string", ValueFormat: "string"})
+	require.Equal(t, &browsedRecord{
+		partition: 2,
+		offset:    7,
+		timestamp: time.UnixMilli(1700000000000),
+		key:       "null",
+		value:     `{"id":1}`,
+		headers:   []string{`source="web"`},
+	}, record)
+
+	record = decodeRecord(message, &GroupHandler{KeyFormat: "string", ValueFormat: "avro"})
+	require.Contains(t, record.value, "<error: unknown magic byte>")
+}
+
+func TestFormatRecordDetail(t *testing.T) {
+	record := &browsedRecord{
+		partition:   2,
+		offset:      7,
+		timestamp:   time.UnixMilli(1700000000000).UTC(),
+		key:         "order-1",
+		value:       `{"id":1}`,
+		valueSchema: `{"type":"string"}`,
+	}
+
+	require.Equal(t, "[yellow]Partition:\n[white]2\n\n"+
+		"[yellow]Offset:\n[white]7\n\n"+
+		"[yellow]Timestamp:\n[white]2023-11-14T22:13:20Z (1700000000000)\n\n"+
+		"[yellow]Key:\n[white]order-1\n\n"+
+		"[yellow]Value:\n[white]{\n  \"id\": 1\n}\n\n"+
+		"[yellow]Headers:\n[white]none\n\n"+
+		"[yellow]Value Schema:\n[white]{\n  \"type\": \"string\"\n}\n\n", formatRecordDetail(record))
+}
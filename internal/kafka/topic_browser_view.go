@@ -0,0 +1,453 @@
+package kafka
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/confluentinc/cli/v3/pkg/flink/components"
+	"github.com/confluentinc/cli/v3/pkg/flink/types"
+)
+
+const (
+	clustersPage = "clusters"
+	topicsPage   = "topics"
+	recordsPage  = "records"
+	recordPage   = "record"
+	jumpPage     = "jump"
+
+	quitShortcut         = "Q"
+	nextPageShortcut     = "N"
+	previousPageShortcut = "P"
+	jumpShortcut         = "J"
+)
+
+var (
+	listShortcuts = []types.Shortcut{
+		{KeyText: quitShortcut, Text: "Quit"},
+		{KeyText: "Enter", Text: "Open"},
+		{KeyText: "Esc", Text: "Back"},
+	}
+	recordsShortcuts = []types.Shortcut{
+		{KeyText: quitShortcut, Text: "Quit"},
+		{KeyText: fmt.Sprintf("%s/%s", nextPageShortcut, previousPageShortcut), Text: "Next/previous page"},
+		{KeyText: "Left/Right", Text: "Previous/next partition"},
+		{KeyText: jumpShortcut, Text: "Jump to offset or timestamp"},
+		{KeyText: "Enter", Text: "Record details"},
+		{KeyText: "Esc", Text: "Back"},
+	}
+	recordShortcuts = []types.Shortcut{
+		{KeyText: quitShortcut, Text: "Quit"},
+		{KeyText: "Esc", Text: "Back"},
+	}
+)
+
+// topicBrowser is an interactive terminal UI to pick a cluster and topic, and page through the records of each of its
+// partitions. All reads happen outside of the UI goroutine; input is ignored while a read is in progress.
+type topicBrowser struct {
+	app      *tview.Application
+	pages    *tview.Pages
+	clusters *tview.List
+	topics   *tview.List
+	table    *tview.Table
+	detail   *tview.TextView
+	jump     *tview.InputField
+	statuses map[string]*tview.TextView
+
+	listClusters func() ([]browsedCluster, error)
+	openCluster  func(string) (recordSource, error)
+	handler      *GroupHandler
+	pageSize     int
+
+	loading      bool
+	source       recordSource
+	topic        *browsedTopic
+	partitionIdx int
+	low          int64
+	high         int64
+	pageStart    int64
+	records      []*browsedRecord
+}
+
+func newTopicBrowser(listClusters func() ([]browsedCluster, error), openCluster func(string) (recordSource, error), handler *GroupHandler, pageSize int) *topicBrowser {
+	b := &topicBrowser{
+		app:          tview.NewApplication(),
+		pages:        tview.NewPages(),
+		clusters:     tview.NewList().ShowSecondaryText(false),
+		topics:       tview.NewList(),
+		table:        tview.NewTable().SetFixed(1, 0).SetSelectable(true, false),
+		detail:       tview.NewTextView().SetDynamicColors(true),
+		jump:         tview.NewInputField().SetLabel("Offset, RFC 3339 timestamp, or @milliseconds: "),
+		statuses:     make(map[string]*tview.TextView),
+		listClusters: listClusters,
+		openCluster:  openCluster,
+		handler:      handler,
+		pageSize:     pageSize,
+	}
+
+	b.clusters.SetBorder(true).SetTitle(" Kafka clusters ")
+	b.topics.SetBorder(true).SetTitle(" Topics ")
+	b.table.SetBorder(true)
+	b.detail.SetBorder(true).SetTitle(" Record details ")
+	b.jump.SetBorder(true).SetTitle(" Jump ")
+	b.jump.SetDoneFunc(b.jumpDone)
+
+	b.pages.AddPage(clustersPage, b.newLayout(clustersPage, b.clusters, listShortcuts), true, false)
+	b.pages.AddPage(topicsPage, b.newLayout(topicsPage, b.topics, listShortcuts), true, false)
+	b.pages.AddPage(recordsPage, b.newLayout(recordsPage, b.table, recordsShortcuts), true, false)
+	b.pages.AddPage(recordPage, b.newLayout(recordPage, b.detail, recordShortcuts), true, false)
+	b.pages.AddPage(jumpPage, newModal(b.jump, 70, 3), true, false)
+
+	b.app.SetInputCapture(b.inputCapture)
+	return b
+}
+
+func (b *topicBrowser) newLayout(page string, content tview.Primitive, shortcuts []types.Shortcut) *tview.Flex {
+	status := tview.NewTextView().SetDynamicColors(true)
+	b.statuses[page] = status
+
+	return tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(content, 0, 1, true).
+		AddItem(status, 1, 1, false).
+		AddItem(components.NewShortcuts(shortcuts), 1, 1, false)
+}
+
+func newModal(content tview.Primitive, width, height int) tview.Primitive {
+	return tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(content, height, 1, true).
+			AddItem(nil, 0, 1, false), width, 1, true).
+		AddItem(nil, 0, 1, false)
+}
+
+// run starts the browser on the given cluster, or on the list of clusters if clusterId is empty, and on the given topic
+// if it is not empty. It blocks until the user quits.
+func (b *topicBrowser) run(clusterId, topic string) error {
+	if clusterId == "" {
+		b.showClusters()
+	} else {
+		b.selectCluster(clusterId, topic)
+	}
+
+	// mouse needs to be disabled, otherwise selecting text with the cursor won't work
+	b.app.SetRoot(b.pages, true).EnableMouse(false)
+
+	err := b.app.Run()
+	if b.source != nil {
+		b.source.close()
+	}
+	return err
+}
+
+func (b *topicBrowser) setStatus(text string) {
+	page, _ := b.pages.GetFrontPage()
+	if page == jumpPage {
+		page = recordsPage
+	}
+	if status, ok := b.statuses[page]; ok {
+		status.SetText(text)
+	}
+}
+
+// load runs a read outside of the UI goroutine, then updates the UI with done if the read succeeded.
+func (b *topicBrowser) load(message string, read func() error, done func()) {
+	b.loading = true
+	b.setStatus(fmt.Sprintf("[darkcyan]%s[white]", message))
+
+	go func() {
+		err := read()
+		b.app.QueueUpdateDraw(func() {
+			b.loading = false
+			if err != nil {
+				b.setStatus(fmt.Sprintf("[red]Error: %s[white]", tview.Escape(err.Error())))
+				return
+			}
+			b.setStatus("")
+			done()
+		})
+	}()
+}
+
+func (b *topicBrowser) showClusters() {
+	var clusters []browsedCluster
+	b.pages.SwitchToPage(clustersPage)
+	b.load("Loading Kafka clusters...", func() error {
+		var err error
+		clusters, err = b.listClusters()
+		return err
+	}, func() {
+		b.clusters.Clear()
+		for _, cluster := range clusters {
+			id := cluster.id
+			b.clusters.AddItem(fmt.Sprintf("%s (%s)", cluster.name, cluster.id), "", 0, func() { b.selectCluster(id, "") })
+		}
+	})
+}
+
+func (b *topicBrowser) selectCluster(clusterId, topicName string) {
+	if b.source != nil {
+		b.source.close()
+		b.source = nil
+	}
+
+	b.topic = nil
+
+	var source recordSource
+	var topics []browsedTopic
+	b.pages.SwitchToPage(topicsPage)
+	b.topics.SetTitle(fmt.Sprintf(" Topics of %s ", clusterId))
+	b.load("Loading topics...", func() error {
+		var err error
+		source, err = b.openCluster(clusterId)
+		if err != nil {
+			return err
+		}
+
+		topics, err = source.listTopics()
+		if err != nil {
+			source.close()
+		}
+		return err
+	}, func() {
+		b.source = source
+		b.topics.Clear()
+		for i := range topics {
+			topic := topics[i]
+			b.topics.AddItem(topic.name, fmt.Sprintf("%d partitions", len(topic.partitions)), 0, func() { b.selectTopic(&topic) })
+			if topic.name == topicName {
+				b.selectTopic(&topic)
+			}
+		}
+		if topicName != "" && b.topic == nil {
+			b.setStatus(fmt.Sprintf(`[red]Error: unknown topic "%s"[white]`, tview.Escape(topicName)))
+		}
+	})
+}
+
+func (b *topicBrowser) selectTopic(topic *browsedTopic) {
+	if len(topic.partitions) == 0 {
+		return
+	}
+	b.topic = topic
+	b.selectPartition(0)
+}
+
+func (b *topicBrowser) selectPartition(partitionIdx int) {
+	b.partitionIdx = partitionIdx
+	partition := b.topic.partitions[partitionIdx]
+
+	b.pages.SwitchToPage(recordsPage)
+	b.load("Loading offsets...", func() error {
+		var err error
+		b.low, b.high, err = b.source.getWatermarks(b.topic.name, partition)
+		return err
+	}, func() {
+		b.readPage(b.low)
+	})
+}
+
+func (b *topicBrowser) readPage(offset int64) {
+	partition := b.topic.partitions[b.partitionIdx]
+
+	handler := *b.handler
+	if handler.Subject == "" {
+		handler.Subject = b.topic.name
+	}
+
+	var records []*browsedRecord
+	b.load("Reading records...", func() error {
+		messages, err := b.source.readRecords(b.topic.name, partition, offset, b.pageSize)
+		if err != nil {
+			return err
+		}
+		records = make([]*browsedRecord, len(messages))
+		for i, message := range messages {
+			records[i] = decodeRecord(message, &handler)
+		}
+		return nil
+	}, func() {
+		b.pageStart = offset
+		b.records = records
+		b.renderRecords()
+	})
+}
+
+func (b *topicBrowser) renderRecords() {
+	partition := b.topic.partitions[b.partitionIdx]
+	b.table.SetTitle(fmt.Sprintf(" %s | Partition %d (%d of %d) | Offsets %d to %d ", tview.Escape(b.topic.name), partition, b.partitionIdx+1, len(b.topic.partitions), b.low, b.high))
+
+	b.table.Clear()
+	for i, header := range []string{"Offset", "Timestamp", "Key", "Value"} {
+		b.table.SetCell(0, i, tview.NewTableCell(header).SetTextColor(tcell.ColorYellow).SetSelectable(false))
+	}
+
+	for i, record := range b.records {
+		cells := []string{fmt.Sprint(record.offset), record.timestamp.Format(time.RFC3339), record.key, record.value}
+		for j, cell := range cells {
+			tableCell := tview.NewTableCell(tview.Escape(strings.Join(strings.Fields(cell), " "))).SetMaxWidth(40).SetReference(record)
+			if j == 3 {
+				tableCell.SetMaxWidth(0).SetExpansion(1)
+			}
+			b.table.SetCell(i+1, j, tableCell)
+		}
+	}
+
+	if len(b.records) == 0 {
+		b.setStatus("No records at or after this offset.")
+	} else {
+		b.setStatus(fmt.Sprintf("Showing offsets %d to %d.", b.records[0].offset, b.records[len(b.records)-1].offset))
+	}
+	b.table.Select(1, 0).ScrollToBeginning()
+}
+
+func (b *topicBrowser) showRecord() {
+	row, _ := b.table.GetSelection()
+	record, ok := b.table.GetCell(row, 0).GetReference().(*browsedRecord)
+	if !ok {
+		return
+	}
+
+	b.detail.SetText(formatRecordDetail(record)).ScrollToBeginning()
+	b.pages.SwitchToPage(recordPage)
+}
+
+func formatRecordDetail(record *browsedRecord) string {
+	headers := "none"
+	if len(record.headers) > 0 {
+		headers = strings.Join(record.headers, "\n")
+	}
+
+	sections := [][2]string{
+		{"Partition", fmt.Sprint(record.partition)},
+		{"Offset", fmt.Sprint(record.offset)},
+		{"Timestamp", fmt.Sprintf("%s (%d)", record.timestamp.Format(time.RFC3339Nano), record.timestamp.UnixMilli())},
+		{"Key", indentJson(record.key)},
+		{"Value", indentJson(record.value)},
+		{"Headers", headers},
+	}
+	if record.keySchema != "" {
+		sections = append(sections, [2]string{"Key Schema", indentJson(record.keySchema)})
+	}
+	if record.valueSchema != "" {
+		sections = append(sections, [2]string{"Value Schema", indentJson(record.valueSchema)})
+	}
+
+	sb := strings.Builder{}
+	for _, section := range sections {
+		sb.WriteString(fmt.Sprintf("[yellow]%s:\n[white]%s\n\n", section[0], tview.Escape(section[1])))
+	}
+	return sb.String()
+}
+
+func indentJson(s string) string {
+	if !json.Valid([]byte(s)) {
+		return s
+	}
+	out := new(bytes.Buffer)
+	if err := json.Indent(out, []byte(s), "", "  "); err != nil {
+		return s
+	}
+	return out.String()
+}
+
+func (b *topicBrowser) jumpDone(key tcell.Key) {
+	defer b.pages.HidePage(jumpPage)
+	if key != tcell.KeyEnter {
+		return
+	}
+
+	offset, timestamp, err := parseJumpTarget(b.jump.GetText())
+	if err != nil {
+		b.setStatus(fmt.Sprintf("[red]Error: %s[white]", tview.Escape(err.Error())))
+		return
+	}
+
+	if timestamp == nil {
+		b.readPage(min(max(offset, b.low), b.high))
+		return
+	}
+
+	partition := b.topic.partitions[b.partitionIdx]
+	b.load("Looking up offset...", func() error {
+		var err error
+		offset, err = b.source.getOffsetForTimestamp(b.topic.name, partition, *timestamp)
+		return err
+	}, func() {
+		b.readPage(offset)
+	})
+}
+
+// inputCapture handles the shortcuts of the page in front.
+func (b *topicBrowser) inputCapture(event *tcell.EventKey) *tcell.EventKey {
+	page, _ := b.pages.GetFrontPage()
+	if page == jumpPage {
+		return event
+	}
+
+	if event.Key() == tcell.KeyCtrlC || event.Key() == tcell.KeyCtrlQ {
+		b.app.Stop()
+		return nil
+	}
+	if b.loading {
+		return nil
+	}
+
+	if event.Key() == tcell.KeyRune && string(unicode.ToUpper(event.Rune())) == quitShortcut {
+		b.app.Stop()
+		return nil
+	}
+
+	switch page {
+	case topicsPage:
+		if event.Key() == tcell.KeyEscape {
+			b.showClusters()
+			return nil
+		}
+	case recordsPage:
+		return b.inputHandlerRecords(event)
+	case recordPage:
+		if event.Key() == tcell.KeyEscape {
+			b.pages.SwitchToPage(recordsPage)
+			return nil
+		}
+	}
+	return event
+}
+
+func (b *topicBrowser) inputHandlerRecords(event *tcell.EventKey) *tcell.EventKey {
+	switch event.Key() {
+	case tcell.KeyEscape:
+		b.topic = nil
+		b.pages.SwitchToPage(topicsPage)
+	case tcell.KeyEnter:
+		b.showRecord()
+	case tcell.KeyLeft:
+		b.selectPartition((b.partitionIdx - 1 + len(b.topic.partitions)) % len(b.topic.partitions))
+	case tcell.KeyRight:
+		b.selectPartition((b.partitionIdx + 1) % len(b.topic.partitions))
+	case tcell.KeyRune:
+		switch string(unicode.ToUpper(event.Rune())) {
+		case nextPageShortcut:
+			if len(b.records) > 0 {
+				b.readPage(b.records[len(b.records)-1].offset + 1)
+			}
+		case previousPageShortcut:
+			b.readPage(getPreviousPageStart(b.pageStart, b.low, b.pageSize))
+		case jumpShortcut:
+			b.jump.SetText("")
+			b.pages.ShowPage(jumpPage)
+		}
+	default:
+		return event
+	}
+	return nil
+}
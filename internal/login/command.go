@@ -2,7 +2,10 @@ package login
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"os"
 	"path/filepath"
 	"regexp"
 	"runtime"
@@ -17,8 +20,10 @@ import (
 	"github.com/confluentinc/cli/v3/pkg/ccloudv2"
 	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
 	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/credentialhelper"
 	"github.com/confluentinc/cli/v3/pkg/errors"
 	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/form"
 	"github.com/confluentinc/cli/v3/pkg/keychain"
 	"github.com/confluentinc/cli/v3/pkg/log"
 	"github.com/confluentinc/cli/v3/pkg/netrc"
@@ -41,7 +46,9 @@ func New(cfg *config.Config, prerunner pcmd.PreRunner, ccloudClientFactory pauth
 		Use:   "login",
 		Short: "Log in to Confluent Cloud or Confluent Platform.",
 		Long: fmt.Sprintf("Confluent Cloud:\n\nLog in to Confluent Cloud using your email and password, or using single sign-on (SSO) credentials.\n\nEmail and password login can be accomplished non-interactively using the `%s` and `%s` environment variables.\n\nEmail and password can also be stored locally for non-interactive re-authentication with the `--save` flag.\n\nSSO login can be accomplished headlessly using the `--no-browser` flag, but non-interactive login is not natively supported. Authentication tokens last 8 hours and are automatically refreshed with CLI client usage. If the client is not used for more than 8 hours, you have to log in again.\n\nLog in to a specific Confluent Cloud organization using the `--organization-id` flag, or by setting the environment variable `%s`.\n\n", pauth.ConfluentCloudEmail, pauth.ConfluentCloudPassword, pauth.ConfluentCloudOrganizationId) +
-			fmt.Sprintf("Confluent Platform:\n\nLog in to Confluent Platform with your username and password, the `--url` flag to identify the location of your Metadata Service (MDS), and the `--ca-cert-path` flag to identify your self-signed certificate chain.\n\nLogin can be accomplished non-interactively using the `%s`, `%s`, `%s`, and `%s` environment variables.\n\nIn a non-interactive login, `%s` replaces the `--url` flag, and `%s` replaces the `--ca-cert-path` flag.\n\nEven with the environment variables set, you can force an interactive login using the `--prompt` flag.", pauth.ConfluentPlatformUsername, pauth.ConfluentPlatformPassword, pauth.ConfluentPlatformMDSURL, pauth.ConfluentPlatformCACertPath, pauth.ConfluentPlatformMDSURL, pauth.ConfluentPlatformCACertPath),
+			fmt.Sprintf("Confluent Platform:\n\nLog in to Confluent Platform with your username and password, the `--url` flag to identify the location of your Metadata Service (MDS), and the `--ca-cert-path` flag to identify your self-signed certificate chain.\n\nLogin can be accomplished non-interactively using the `%s`, `%s`, `%s`, and `%s` environment variables.\n\nIn a non-interactive login, `%s` replaces the `--url` flag, and `%s` replaces the `--ca-cert-path` flag.\n\nEven with the environment variables set, you can force an interactive login using the `--prompt` flag.", pauth.ConfluentPlatformUsername, pauth.ConfluentPlatformPassword, pauth.ConfluentPlatformMDSURL, pauth.ConfluentPlatformCACertPath, pauth.ConfluentPlatformMDSURL, pauth.ConfluentPlatformCACertPath) +
+			fmt.Sprintf("\n\nLog in to Confluent Platform as an OAuth 2.0 client with the `--oauth-token-url` and `--client-id` flags. The client authenticates to the token endpoint with a client secret, which can also be set with the `%s` environment variable, or with a JWT signed by the private key in `--client-assertion-key-path`. Tokens are requested again when they expire, which requires the client secret to be saved with the `--save` flag, or to be set in the environment.", pauth.ConfluentPlatformClientSecret) +
+			"\n\nLog in to Confluent Platform with a client certificate instead of a username and password, using mutual TLS (mTLS), with the `--client-cert-path` and `--client-key-path` flags. The certificate is also used by `confluent kafka topic produce` and `confluent kafka topic consume` for SSL client authentication, unless the `--cert-location` and `--key-location` flags are passed.",
 		Args: cobra.NoArgs,
 		Example: examples.BuildExampleString(
 			examples.Example{
@@ -60,6 +67,14 @@ func New(cfg *config.Config, prerunner pcmd.PreRunner, ccloudClientFactory pauth
 				Text: "Log in to Confluent Platform with a MDS URL and CA certificate.",
 				Code: "confluent login --url https://localhost:8090 --ca-cert-path certs/my-cert.crt",
 			},
+			examples.Example{
+				Text: "Log in to Confluent Platform as an OAuth client, which authenticates with a JWT signed by its private key.",
+				Code: "confluent login --url https://localhost:8090 --oauth-token-url https://idp.example.com/oauth2/token --client-id my-client --client-assertion-key-path client-key.pem --scope kafka",
+			},
+			examples.Example{
+				Text: "Log in to Confluent Platform with a client certificate.",
+				Code: "confluent login --url https://localhost:8090 --ca-cert-path certs/my-cert.crt --client-cert-path certs/client.crt --client-key-path certs/client.key",
+			},
 		),
 	}
 
@@ -81,11 +96,22 @@ func New(cfg *config.Config, prerunner pcmd.PreRunner, ccloudClientFactory pauth
 	cmd.Flags().Bool("no-browser", false, "Do not open a browser window when authenticating using Single Sign-On (SSO).")
 	cmd.Flags().String("organization-id", "", "The Confluent Cloud organization to log in to. If empty, log in to the default organization.")
 	cmd.Flags().Bool("prompt", false, "Bypass non-interactive login and prompt for login credentials.")
-	cmd.Flags().Bool("save", false, "Save username and encrypted password (non-SSO credentials) to the configuration file in your $HOME directory, and to macOS keychain if applicable. You will be logged back in when your token expires, after one hour for Confluent Cloud, or after six hours for Confluent Platform.")
+	cmd.Flags().String("client-cert-path", "", "Client certificate in PEM format, to log in to Confluent Platform with mutual TLS (mTLS) instead of a username and password.")
+	cmd.Flags().String("client-key-path", "", "Private key of the client certificate in PEM format.")
+	cmd.Flags().String("oauth-token-url", "", "OAuth 2.0 token endpoint URL, to log in to Confluent Platform as an OAuth client with the client credentials grant.")
+	cmd.Flags().String("client-id", "", "OAuth client ID.")
+	cmd.Flags().String("client-secret", "", "OAuth client secret.")
+	cmd.Flags().String("client-assertion-key-path", "", "Private key in PEM format, with which the OAuth client signs a JWT to authenticate instead of using a client secret.")
+	cmd.Flags().StringSlice("scope", nil, "A comma-separated list of OAuth scopes to request.")
+	cmd.Flags().Bool("save", false, "Save username and encrypted password (non-SSO credentials) to the configuration file in your $HOME directory, and to macOS keychain if applicable, or to the configured credential helper instead. You will be logged back in when your token expires, after one hour for Confluent Cloud, or after six hours for Confluent Platform. OAuth client secrets are always saved to the configuration file.")
 
 	cobra.CheckErr(cmd.Flags().MarkHidden("us-gov"))
 
 	cmd.MarkFlagsMutuallyExclusive("url", "us-gov")
+	cmd.MarkFlagsRequiredTogether("client-cert-path", "client-key-path")
+	cmd.MarkFlagsMutuallyExclusive("client-cert-path", "oauth-token-url")
+	cmd.MarkFlagsRequiredTogether("oauth-token-url", "client-id")
+	cmd.MarkFlagsMutuallyExclusive("client-secret", "client-assertion-key-path")
 
 	return cmd
 }
@@ -106,6 +132,27 @@ func (c *command) login(cmd *cobra.Command, _ []string) error {
 		output.ErrPrintf(c.Config.EnableColor, "Assuming %s.\n", warningMsg)
 	}
 
+	isOAuth := cmd.Flags().Changed("oauth-token-url")
+	if isCCloud && isOAuth {
+		return errors.NewErrorWithSuggestions(
+			"OAuth client login is only supported for Confluent Platform",
+			"Log in to Confluent Platform with `confluent login --url <mds-url> --oauth-token-url <token-url> --client-id <client-id>`.",
+		)
+	}
+	if isCCloud && cmd.Flags().Changed("client-cert-path") {
+		return errors.NewErrorWithSuggestions(
+			"client certificate login is only supported for Confluent Platform",
+			"Log in to Confluent Platform with `confluent login --url <mds-url> --client-cert-path <cert-path> --client-key-path <key-path>`.",
+		)
+	}
+	if !isOAuth {
+		for _, flag := range []string{"client-secret", "client-assertion-key-path", "scope"} {
+			if cmd.Flags().Changed(flag) {
+				return fmt.Errorf("the `--%s` flag can only be used with the `--oauth-token-url` flag", flag)
+			}
+		}
+	}
+
 	if isCCloud {
 		return c.loginCCloud(cmd, url)
 	} else {
@@ -149,7 +196,10 @@ func (c *command) loginCCloud(cmd *cobra.Command, url string) error {
 		return err
 	}
 
-	currentEnvironment, currentOrg, err := pauth.PersistCCloudCredentialsToConfig(c.Config, client, url, credentials, save)
+	// Credentials saved by a credential helper are not also saved to the configuration file
+	saveToCredentialHelper := save && c.cfg.CredentialHelper != ""
+
+	currentEnvironment, currentOrg, err := pauth.PersistCCloudCredentialsToConfig(c.Config, client, url, credentials, save && !saveToCredentialHelper)
 	if err != nil {
 		return err
 	}
@@ -169,6 +219,9 @@ func (c *command) loginCCloud(cmd *cobra.Command, url string) error {
 		c.printRemainingFreeCredit(client, currentOrg)
 	}
 
+	if saveToCredentialHelper {
+		return c.saveLoginToCredentialHelper(true, url, credentials)
+	}
 	if save && runtime.GOOS == "darwin" && !c.cfg.IsTest {
 		return c.saveLoginToKeychain(true, url, credentials)
 	}
@@ -201,7 +254,7 @@ func (c *command) printRemainingFreeCredit(client *ccloudv1.Client, currentOrg *
 	}
 }
 
-// Order of precedence: env vars > config file > netrc file > prompt
+// Order of precedence: env vars > credential helper > keychain > config file > netrc file > prompt
 // i.e. if login credentials found in env vars then acquire token using env vars and skip checking for credentials else where
 func (c *command) getCCloudCredentials(cmd *cobra.Command, url, organizationId string) (*pauth.Credentials, error) {
 	client := c.ccloudClientFactory.AnonHTTPClientFactory(url)
@@ -227,6 +280,7 @@ func (c *command) getCCloudCredentials(cmd *cobra.Command, url, organizationId s
 	return pauth.GetLoginCredentials(
 		c.loginCredentialsManager.GetCloudCredentialsFromEnvVar(organizationId),
 		c.loginCredentialsManager.GetSsoCredentialsFromConfig(c.cfg, url),
+		c.loginCredentialsManager.GetCredentialsFromCredentialHelper(c.cfg, true, filterParams.Name, url),
 		c.loginCredentialsManager.GetCredentialsFromKeychain(true, filterParams.Name, url),
 		c.loginCredentialsManager.GetCredentialsFromConfig(c.cfg, filterParams),
 		c.loginCredentialsManager.GetCredentialsFromNetrc(filterParams),
@@ -235,7 +289,15 @@ func (c *command) getCCloudCredentials(cmd *cobra.Command, url, organizationId s
 }
 
 func (c *command) loginMDS(cmd *cobra.Command, url string) error {
-	credentials, err := c.getConfluentCredentials(cmd, url)
+	var credentials *pauth.Credentials
+	var err error
+	if cmd.Flags().Changed("oauth-token-url") {
+		credentials, err = getOAuthCredentials(cmd)
+	} else if cmd.Flags().Changed("client-cert-path") {
+		credentials, err = getCertificateCredentials(cmd)
+	} else {
+		credentials, err = c.getConfluentCredentials(cmd, url)
+	}
 	if err != nil {
 		return err
 	}
@@ -271,14 +333,23 @@ func (c *command) loginMDS(cmd *cobra.Command, url string) error {
 		return err
 	}
 
-	client, err := c.mdsClientManager.GetMDSClient(url, caCertPath, unsafeTrace)
-	if err != nil {
-		return err
-	}
+	var token string
+	if credentials.OAuth != nil {
+		oauthToken, err := pauth.GetOAuthToken(credentials.OAuth, caCertPath)
+		if err != nil {
+			return err
+		}
+		token = oauthToken.AccessToken
+	} else {
+		client, err := c.mdsClientManager.GetMDSClient(url, caCertPath, credentials.ClientCertPath, credentials.ClientKeyPath, unsafeTrace)
+		if err != nil {
+			return err
+		}
 
-	token, err := c.authTokenHandler.GetConfluentToken(client, credentials)
-	if err != nil {
-		return err
+		token, err = c.authTokenHandler.GetConfluentToken(client, credentials)
+		if err != nil {
+			return err
+		}
 	}
 
 	save, err := cmd.Flags().GetBool("save")
@@ -286,11 +357,20 @@ func (c *command) loginMDS(cmd *cobra.Command, url string) error {
 		return err
 	}
 
-	if err := pauth.PersistConfluentLoginToConfig(c.Config, credentials, url, token, caCertPath, isLegacyContext, save); err != nil {
+	// Credentials saved by a credential helper are not also saved to the configuration file; OAuth client secrets are
+	// always saved to the configuration file, and client certificates have no password to save
+	isPasswordLogin := credentials.OAuth == nil && credentials.ClientCertPath == ""
+	saveToCredentialHelper := save && c.cfg.CredentialHelper != "" && isPasswordLogin
+
+	if err := pauth.PersistConfluentLoginToConfig(c.Config, credentials, url, token, caCertPath, isLegacyContext, save && !saveToCredentialHelper); err != nil {
 		return err
 	}
 
-	if save && runtime.GOOS == "darwin" && !c.cfg.IsTest {
+	if saveToCredentialHelper {
+		if err := c.saveLoginToCredentialHelper(false, url, credentials); err != nil {
+			return err
+		}
+	} else if save && runtime.GOOS == "darwin" && !c.cfg.IsTest && isPasswordLogin {
 		if err := c.saveLoginToKeychain(false, url, credentials); err != nil {
 			return err
 		}
@@ -308,7 +388,101 @@ func getCACertPath(cmd *cobra.Command) (string, error) {
 	return pauth.GetEnvWithFallback(pauth.ConfluentPlatformCACertPath, pauth.DeprecatedConfluentPlatformCACertPath), nil
 }
 
-// Order of precedence: env vars > netrc > prompt
+// getOAuthCredentials reads the settings of an OAuth client from flags. If neither a client secret nor a private key
+// is passed, the client secret is read from the environment, or else prompted for.
+func getOAuthCredentials(cmd *cobra.Command) (*pauth.Credentials, error) {
+	tokenUrl, err := cmd.Flags().GetString("oauth-token-url")
+	if err != nil {
+		return nil, err
+	}
+	clientId, err := cmd.Flags().GetString("client-id")
+	if err != nil {
+		return nil, err
+	}
+	clientSecret, err := cmd.Flags().GetString("client-secret")
+	if err != nil {
+		return nil, err
+	}
+	clientAssertionKeyPath, err := cmd.Flags().GetString("client-assertion-key-path")
+	if err != nil {
+		return nil, err
+	}
+	scopes, err := cmd.Flags().GetStringSlice("scope")
+	if err != nil {
+		return nil, err
+	}
+
+	if clientAssertionKeyPath != "" {
+		clientAssertionKeyPath, err = filepath.Abs(clientAssertionKeyPath)
+		if err != nil {
+			return nil, err
+		}
+	} else if clientSecret == "" {
+		clientSecret = os.Getenv(pauth.ConfluentPlatformClientSecret)
+		if clientSecret == "" {
+			f := form.New(form.Field{ID: "client secret", Prompt: "Client secret", IsHidden: true})
+			if err := f.Prompt(form.NewPrompt()); err != nil {
+				return nil, err
+			}
+			clientSecret = f.Responses["client secret"].(string)
+		}
+	}
+
+	return &pauth.Credentials{
+		Username: clientId,
+		OAuth: &config.OAuthConfig{
+			TokenUrl:               tokenUrl,
+			ClientId:               clientId,
+			ClientSecret:           clientSecret,
+			ClientAssertionKeyPath: clientAssertionKeyPath,
+			Scopes:                 scopes,
+		},
+	}, nil
+}
+
+// getCertificateCredentials reads the paths of a client certificate and its private key from flags. The user is
+// identified by the common name of the certificate's subject.
+func getCertificateCredentials(cmd *cobra.Command) (*pauth.Credentials, error) {
+	clientCertPath, err := cmd.Flags().GetString("client-cert-path")
+	if err != nil {
+		return nil, err
+	}
+	clientKeyPath, err := cmd.Flags().GetString("client-key-path")
+	if err != nil {
+		return nil, err
+	}
+
+	clientCertPath, err = filepath.Abs(clientCertPath)
+	if err != nil {
+		return nil, err
+	}
+	clientKeyPath, err = filepath.Abs(clientKeyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	keyPair, err := tls.LoadX509KeyPair(clientCertPath, clientKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load client certificate: %w", err)
+	}
+	cert, err := x509.ParseCertificate(keyPair.Certificate[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse client certificate: %w", err)
+	}
+
+	username := cert.Subject.CommonName
+	if username == "" {
+		username = cert.Subject.String()
+	}
+
+	return &pauth.Credentials{
+		Username:       username,
+		ClientCertPath: clientCertPath,
+		ClientKeyPath:  clientKeyPath,
+	}, nil
+}
+
+// Order of precedence: env vars > credential helper > keychain > config file > netrc > prompt
 // i.e. if login credentials found in env vars then acquire token using env vars and skip checking for credentials else where
 func (c *command) getConfluentCredentials(cmd *cobra.Command, url string) (*pauth.Credentials, error) {
 	prompt, err := cmd.Flags().GetBool("prompt")
@@ -330,6 +504,7 @@ func (c *command) getConfluentCredentials(cmd *cobra.Command, url string) (*paut
 
 	return pauth.GetLoginCredentials(
 		c.loginCredentialsManager.GetOnPremCredentialsFromEnvVar(),
+		c.loginCredentialsManager.GetCredentialsFromCredentialHelper(c.cfg, false, netrcFilterParams.Name, url),
 		c.loginCredentialsManager.GetCredentialsFromKeychain(false, netrcFilterParams.Name, url),
 		c.loginCredentialsManager.GetCredentialsFromConfig(c.cfg, netrcFilterParams),
 		c.loginCredentialsManager.GetCredentialsFromNetrc(netrcFilterParams),
@@ -390,6 +565,29 @@ func (c *command) saveLoginToKeychain(isCloud bool, url string, credentials *pau
 	return nil
 }
 
+func (c *command) saveLoginToCredentialHelper(isCloud bool, url string, credentials *pauth.Credentials) error {
+	if credentials.IsSSO {
+		output.ErrPrintln(c.cfg.EnableColor, "The `--save` flag was ignored since SSO credentials are not stored locally.")
+		return nil
+	}
+
+	request := &credentialhelper.Request{
+		Type:     credentialhelper.Login,
+		Url:      url,
+		Context:  c.Config.Context().GetNetrcMachineName(),
+		IsCloud:  isCloud,
+		Username: credentials.Username,
+		Secret:   credentials.Password,
+	}
+	if err := credentialhelper.Store(c.cfg.CredentialHelper, request); err != nil {
+		return err
+	}
+
+	output.ErrPrintf(c.cfg.EnableColor, "Wrote login credentials to credential helper \"%s\".\n", c.cfg.CredentialHelper)
+
+	return nil
+}
+
 func validateURL(url string, isCCloud bool) (string, string, error) {
 	if isCCloud {
 		if strings.Contains(url, ccloudv2.Hostnames[0]) {
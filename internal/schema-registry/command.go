@@ -16,10 +16,9 @@ type command struct {
 
 func New(cfg *config.Config, prerunner pcmd.PreRunner) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:         "schema-registry",
-		Aliases:     []string{"sr"},
-		Short:       "Manage Schema Registry.",
-		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireNonAPIKeyCloudLoginOrOnPremLogin},
+		Use:     "schema-registry",
+		Aliases: []string{"sr"},
+		Short:   "Manage Schema Registry.",
 	}
 
 	c := &command{}
@@ -30,7 +29,7 @@ func New(cfg *config.Config, prerunner pcmd.PreRunner) *cobra.Command {
 	}
 
 	cmd.AddCommand(c.newClusterCommand(cfg))
-	cmd.AddCommand(c.newCompatibilityCommand(cfg))
+	cmd.AddCommand(c.newCompatibilityCommand(cfg, prerunner))
 	cmd.AddCommand(c.newConfigCommand(cfg))
 	cmd.AddCommand(c.newExporterCommand(cfg))
 	cmd.AddCommand(c.newRegionCommand())
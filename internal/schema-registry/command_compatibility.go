@@ -7,13 +7,13 @@ import (
 	"github.com/confluentinc/cli/v3/pkg/config"
 )
 
-func (c *command) newCompatibilityCommand(cfg *config.Config) *cobra.Command {
+func (c *command) newCompatibilityCommand(cfg *config.Config, prerunner pcmd.PreRunner) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:         "compatibility",
-		Short:       "Validate schema compatibility.",
-		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireCloudLoginOrOnPremLogin},
+		Use:   "compatibility",
+		Short: "Validate schema compatibility.",
 	}
 
+	cmd.AddCommand(newCompatibilityCheckCommand(prerunner))
 	cmd.AddCommand(c.newCompatibilityValidateCommand(cfg))
 
 	return cmd
@@ -0,0 +1,277 @@
+package schemaregistry
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+	"github.com/confluentinc/cli/v3/pkg/schemaregistry/compatibility"
+)
+
+type compatibilityCheckCommand struct {
+	*pcmd.CLICommand
+}
+
+type compatibilityCheckOut struct {
+	Previous  string `human:"Previous" serialized:"previous"`
+	Direction string `human:"Direction" serialized:"direction"`
+	Path      string `human:"Path" serialized:"path"`
+	Message   string `human:"Message" serialized:"message"`
+}
+
+func newCompatibilityCheckCommand(prerunner pcmd.PreRunner) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check <schema>",
+		Short: "Check schema compatibility locally.",
+		Long:  "Check the compatibility of a schema with previous schemas, without connecting to Schema Registry. The previous schemas are either local schema files or the versions of a subject exported with `confluent schema-registry subject export`. Every incompatible change is reported with its path, and the command fails if the schema is incompatible.",
+		Args:  cobra.ExactArgs(1),
+	}
+
+	c := &compatibilityCheckCommand{pcmd.NewAnonymousCLICommand(cmd, prerunner)}
+	cmd.RunE = c.check
+
+	cmd.Example = examples.BuildExampleString(
+		examples.Example{
+			Text: `Check that schema "payment-v3.avsc" is backward compatible with schemas "payment-v1.avsc" and "payment-v2.avsc".`,
+			Code: "confluent schema-registry compatibility check payment-v3.avsc --type avro --previous payment-v1.avsc,payment-v2.avsc --compatibility backward_transitive",
+		},
+		examples.Example{
+			Text: `Check schema "payment.proto" against the versions of subject "payments-value" exported to directory "backup", using the compatibility of the subject.`,
+			Code: "confluent schema-registry compatibility check payment.proto --type protobuf --directory backup --subject payments-value",
+		},
+	)
+
+	pcmd.AddSchemaTypeFlag(cmd)
+	cmd.Flags().StringSlice("previous", nil, "A comma-separated list of paths to previous schema files, from oldest to newest.")
+	cmd.Flags().String("directory", "", "The path to a directory of exported subjects.")
+	cmd.Flags().String("subject", "", "Subject of the exported directory to check against.")
+	addCompatibilityFlag(cmd)
+	pcmd.AddOutputFlag(cmd)
+
+	cmd.MarkFlagsOneRequired("previous", "directory")
+	cmd.MarkFlagsMutuallyExclusive("previous", "directory")
+	cmd.MarkFlagsRequiredTogether("directory", "subject")
+	cobra.CheckErr(cmd.MarkFlagDirname("directory"))
+
+	return cmd
+}
+
+func (c *compatibilityCheckCommand) check(cmd *cobra.Command, args []string) error {
+	schemaType, err := cmd.Flags().GetString("type")
+	if err != nil {
+		return err
+	}
+	schemaType = strings.ToUpper(schemaType)
+
+	definition, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+	schema := compatibility.Schema{
+		Type:       schemaType,
+		Definition: string(definition),
+		References: localReferences(args[0], schemaType),
+	}
+
+	previousPaths, err := cmd.Flags().GetStringSlice("previous")
+	if err != nil {
+		return err
+	}
+
+	level := compatibility.Backward
+	var previous []compatibility.Schema
+	var labels []string
+	if len(previousPaths) > 0 {
+		for _, path := range previousPaths {
+			definition, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			previous = append(previous, compatibility.Schema{
+				Type:       schemaType,
+				Definition: string(definition),
+				References: localReferences(path, schemaType),
+			})
+			labels = append(labels, filepath.Base(path))
+		}
+	} else {
+		directory, err := cmd.Flags().GetString("directory")
+		if err != nil {
+			return err
+		}
+		subject, err := cmd.Flags().GetString("subject")
+		if err != nil {
+			return err
+		}
+
+		export, exports, err := findSubjectExport(directory, subject)
+		if err != nil {
+			return err
+		}
+		if compatibilityLevel := export.Config.GetCompatibilityLevel(); compatibilityLevel != "" {
+			if level, err = compatibility.ParseLevel(compatibilityLevel); err != nil {
+				return err
+			}
+		}
+
+		for _, version := range export.Schemas {
+			references, err := exportedReferences(exports, version.GetReferences())
+			if err != nil {
+				return err
+			}
+			previous = append(previous, compatibility.Schema{
+				Type:       version.GetSchemaType(),
+				Definition: version.GetSchema(),
+				References: references,
+			})
+			labels = append(labels, fmt.Sprintf("version %d", version.GetVersion()))
+		}
+
+		// The schema is expected to have the type and references of the latest version, unless specified otherwise
+		if len(previous) > 0 {
+			if schema.Type == "" {
+				schema.Type = previous[len(previous)-1].Type
+			}
+			for name, reference := range previous[len(previous)-1].References {
+				if _, ok := schema.References[name]; !ok {
+					schema.References[name] = reference
+				}
+			}
+		}
+	}
+
+	if cmd.Flags().Changed("compatibility") {
+		compatibilityLevel, err := cmd.Flags().GetString("compatibility")
+		if err != nil {
+			return err
+		}
+		if level, err = compatibility.ParseLevel(compatibilityLevel); err != nil {
+			return err
+		}
+	}
+
+	incompatibilities, err := compatibility.Check(level, schema, previous)
+	if err != nil {
+		return err
+	}
+
+	if len(incompatibilities) == 0 && !output.GetFormat(cmd).IsSerialized() {
+		output.Printf(false, "Schema is %s compatible.\n", level)
+		return nil
+	}
+
+	list := output.NewList(cmd)
+	for _, incompatibility := range incompatibilities {
+		list.Add(&compatibilityCheckOut{
+			Previous:  labels[incompatibility.Previous],
+			Direction: string(incompatibility.Direction),
+			Path:      incompatibility.Path,
+			Message:   incompatibility.Message,
+		})
+	}
+	list.Sort(false)
+	if err := list.Print(); err != nil {
+		return err
+	}
+
+	if len(incompatibilities) > 0 {
+		return fmt.Errorf("schema is not %s compatible", level)
+	}
+	return nil
+}
+
+// localReferences returns the other Protobuf files in the directory of a schema, which the schema may import
+func localReferences(path, schemaType string) map[string]string {
+	references := make(map[string]string)
+	if schemaType != compatibility.Protobuf {
+		return references
+	}
+
+	files, err := filepath.Glob(filepath.Join(filepath.Dir(path), "*.proto"))
+	if err != nil {
+		return references
+	}
+	for _, file := range files {
+		if filepath.Base(file) == filepath.Base(path) {
+			continue
+		}
+		if definition, err := os.ReadFile(file); err == nil {
+			references[filepath.Base(file)] = string(definition)
+		}
+	}
+	return references
+}
+
+func findSubjectExport(directory, subject string) (*subjectExport, map[string]*subjectExport, error) {
+	exports, err := readSubjectExports(directory)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bySubject := make(map[string]*subjectExport, len(exports))
+	for _, export := range exports {
+		sort.Slice(export.Schemas, func(i, j int) bool {
+			return export.Schemas[i].GetVersion() < export.Schemas[j].GetVersion()
+		})
+		bySubject[export.Subject] = export
+	}
+
+	export, ok := bySubject[subject]
+	if !ok {
+		return nil, nil, errors.NewErrorWithSuggestions(
+			fmt.Sprintf(`subject "%s" not found in the exported directory`, subject),
+			"Export the subject with `confluent schema-registry subject export`.",
+		)
+	}
+	return export, bySubject, nil
+}
+
+// exportedReferences returns the definitions of the referenced schemas, including the schemas they reference
+func exportedReferences(exports map[string]*subjectExport, references []srsdk.SchemaReference) (map[string]string, error) {
+	definitions := make(map[string]string)
+
+	var add func([]srsdk.SchemaReference) error
+	add = func(references []srsdk.SchemaReference) error {
+		for _, reference := range references {
+			if _, ok := definitions[reference.GetName()]; ok {
+				continue
+			}
+
+			schema, ok := findExportedVersion(exports, reference.GetSubject(), reference.GetVersion())
+			if !ok {
+				return fmt.Errorf(`referenced version %d of subject "%s" not found in the exported directory`, reference.GetVersion(), reference.GetSubject())
+			}
+			definitions[reference.GetName()] = schema.GetSchema()
+
+			if err := add(schema.GetReferences()); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return definitions, add(references)
+}
+
+func findExportedVersion(exports map[string]*subjectExport, subject string, version int32) (srsdk.Schema, bool) {
+	export, ok := exports[subject]
+	if !ok {
+		return srsdk.Schema{}, false
+	}
+	for _, schema := range export.Schemas {
+		if schema.GetVersion() == version {
+			return schema, true
+		}
+	}
+	return srsdk.Schema{}, false
+}
@@ -21,11 +21,12 @@ type validateOut struct {
 
 func (c *command) newCompatibilityValidateCommand(cfg *config.Config) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "validate",
-		Short: "Validate a schema with a subject version.",
-		Long:  "Validate that a schema is compatible against a given subject version.",
-		Args:  cobra.NoArgs,
-		RunE:  c.compatibilityValidate,
+		Use:         "validate",
+		Short:       "Validate a schema with a subject version.",
+		Long:        "Validate that a schema is compatible against a given subject version.",
+		Args:        cobra.NoArgs,
+		RunE:        c.compatibilityValidate,
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireNonAPIKeyCloudLoginOrOnPremLogin},
 	}
 
 	example := examples.Example{
@@ -24,7 +24,7 @@ func (c *command) newConfigCommand(cfg *config.Config) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:         "config",
 		Short:       "Manage Schema Registry configuration.",
-		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireCloudLoginOrOnPremLogin},
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireNonAPIKeyCloudLoginOrOnPremLogin},
 	}
 
 	cmd.AddCommand(c.newConfigDeleteCommand(cfg))
@@ -15,7 +15,7 @@ func (c *command) newSchemaCommand(cfg *config.Config) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:         "schema",
 		Short:       "Manage Schema Registry schemas.",
-		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireCloudLoginOrOnPremLogin},
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireNonAPIKeyCloudLoginOrOnPremLogin},
 	}
 
 	cmd.AddCommand(c.newSchemaCreateCommand(cfg))
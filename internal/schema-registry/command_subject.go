@@ -11,10 +11,12 @@ func (c *command) newSubjectCommand(cfg *config.Config) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:         "subject",
 		Short:       "Manage Schema Registry subjects.",
-		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireCloudLoginOrOnPremLogin},
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireNonAPIKeyCloudLoginOrOnPremLogin},
 	}
 
 	cmd.AddCommand(c.newSubjectDescribeCommand(cfg))
+	cmd.AddCommand(c.newSubjectExportCommand(cfg))
+	cmd.AddCommand(c.newSubjectImportCommand(cfg))
 	cmd.AddCommand(c.newSubjectListCommand(cfg))
 	cmd.AddCommand(c.newSubjectUpdateCommand(cfg))
 
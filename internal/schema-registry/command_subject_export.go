@@ -0,0 +1,189 @@
+package schemaregistry
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+	"github.com/confluentinc/cli/v3/pkg/schemaregistry"
+)
+
+// subjectExport is the content of a file written by `confluent schema-registry subject export`, one per subject
+type subjectExport struct {
+	Subject string         `json:"subject"`
+	Config  *srsdk.Config  `json:"config,omitempty"`
+	Mode    string         `json:"mode,omitempty"`
+	Schemas []srsdk.Schema `json:"schemas"`
+}
+
+type subjectExportOut struct {
+	Subject       string `human:"Subject" serialized:"subject"`
+	Versions      int    `human:"Versions" serialized:"versions"`
+	Compatibility string `human:"Compatibility" serialized:"compatibility"`
+	Mode          string `human:"Mode" serialized:"mode"`
+}
+
+func (c *command) newSubjectExportCommand(cfg *config.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export <directory>",
+		Short: "Export subjects to a directory.",
+		Long:  "Export all versions of subjects, including their references, metadata, rulesets, compatibility, and mode, to a directory. The directory can be imported into another Schema Registry with `confluent schema-registry subject import`.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  c.subjectExport,
+	}
+
+	example1 := examples.Example{
+		Text: `Export all subjects to directory "backup".`,
+		Code: "confluent schema-registry subject export backup",
+	}
+	example2 := examples.Example{
+		Text: `Export subjects starting with "payments" to directory "backup".`,
+		Code: "confluent schema-registry subject export backup --prefix payments",
+	}
+	if cfg.IsOnPremLogin() {
+		example1.Code += " " + onPremAuthenticationMsg
+		example2.Code += " " + onPremAuthenticationMsg
+	}
+	cmd.Example = examples.BuildExampleString(example1, example2)
+
+	cmd.Flags().String("prefix", ":*:", "Subject prefix.")
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	if cfg.IsCloudLogin() {
+		pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	} else {
+		addCaLocationFlag(cmd)
+		addSchemaRegistryEndpointFlag(cmd)
+	}
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *command) subjectExport(cmd *cobra.Command, args []string) error {
+	prefix, err := cmd.Flags().GetString("prefix")
+	if err != nil {
+		return err
+	}
+
+	client, err := c.GetSchemaRegistryClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	subjects, err := client.List(prefix, false)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(args[0], 0755); err != nil {
+		return err
+	}
+
+	list := output.NewList(cmd)
+	for _, subject := range subjects {
+		export, err := exportSubject(client, subject)
+		if err != nil {
+			return err
+		}
+
+		if err := writeSubjectExport(args[0], export); err != nil {
+			return err
+		}
+
+		list.Add(&subjectExportOut{
+			Subject:       subject,
+			Versions:      len(export.Schemas),
+			Compatibility: export.Config.GetCompatibilityLevel(),
+			Mode:          export.Mode,
+		})
+	}
+	return list.Print()
+}
+
+func exportSubject(client *schemaregistry.Client, subject string) (*subjectExport, error) {
+	versions, err := client.ListVersions(subject, false)
+	if err != nil {
+		return nil, catchSchemaNotFoundError(err, subject, "")
+	}
+
+	export := &subjectExport{
+		Subject: subject,
+		Schemas: make([]srsdk.Schema, len(versions)),
+	}
+
+	for i, version := range versions {
+		schema, err := client.GetSchemaByVersion(subject, strconv.Itoa(int(version)), false)
+		if err != nil {
+			return nil, catchSchemaNotFoundError(err, subject, strconv.Itoa(int(version)))
+		}
+		export.Schemas[i] = schema
+	}
+
+	// Subjects without their own compatibility or mode use the global settings, which aren't exported
+	config, err := client.GetSubjectLevelConfig(subject)
+	if err != nil && !isNotFoundError(err) {
+		return nil, err
+	}
+	if err == nil {
+		export.Config = &config
+	}
+
+	mode, err := client.GetMode(subject)
+	if err != nil && !isNotFoundError(err) {
+		return nil, err
+	}
+	export.Mode = mode.GetMode()
+
+	return export, nil
+}
+
+func isNotFoundError(err error) bool {
+	return strings.Contains(err.Error(), "Not Found")
+}
+
+// subjectExportFilename escapes the subject, since subjects may contain characters such as "/" and ":"
+func subjectExportFilename(subject string) string {
+	return url.QueryEscape(subject) + ".json"
+}
+
+func writeSubjectExport(dir string, export *subjectExport) error {
+	b, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, subjectExportFilename(export.Subject)), append(b, '\n'), 0644)
+}
+
+func readSubjectExports(dir string) ([]*subjectExport, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf(`no subjects found in directory "%s"`, dir)
+	}
+
+	exports := make([]*subjectExport, len(files))
+	for i, file := range files {
+		exports[i] = new(subjectExport)
+		if err := read(file, exports[i]); err != nil {
+			return nil, fmt.Errorf(`failed to read "%s": %w`, file, err)
+		}
+		if exports[i].Subject == "" {
+			return nil, fmt.Errorf(`"%s" is missing a subject`, file)
+		}
+	}
+	return exports, nil
+}
@@ -0,0 +1,240 @@
+package schemaregistry
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+	"github.com/confluentinc/cli/v3/pkg/schemaregistry"
+)
+
+const (
+	importMode    = "IMPORT"
+	readWriteMode = "READWRITE"
+)
+
+type subjectImportOut struct {
+	Subject string `human:"Subject" serialized:"subject"`
+	Version int32  `human:"Version" serialized:"version"`
+	Id      int32  `human:"ID" serialized:"id"`
+}
+
+func (c *command) newSubjectImportCommand(cfg *config.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <directory>",
+		Short: "Import subjects from a directory.",
+		Long:  "Import subjects exported with `confluent schema-registry subject export`. Schemas are registered in dependency order, so that referenced schemas are registered before the schemas referencing them. Compatibility and mode are set after all schemas of a subject are registered.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  c.subjectImport,
+	}
+
+	example1 := examples.Example{
+		Text: `Import the subjects of directory "backup".`,
+		Code: "confluent schema-registry subject import backup",
+	}
+	example2 := examples.Example{
+		Text: `Import the subjects of directory "backup", preserving their schema IDs and versions.`,
+		Code: "confluent schema-registry subject import backup --preserve-ids",
+	}
+	if cfg.IsOnPremLogin() {
+		example1.Code += " " + onPremAuthenticationMsg
+		example2.Code += " " + onPremAuthenticationMsg
+	}
+	cmd.Example = examples.BuildExampleString(example1, example2)
+
+	cmd.Flags().Bool("preserve-ids", false, "Preserve schema IDs and versions by switching subjects to IMPORT mode while registering schemas.")
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	if cfg.IsCloudLogin() {
+		pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	} else {
+		addCaLocationFlag(cmd)
+		addSchemaRegistryEndpointFlag(cmd)
+	}
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *command) subjectImport(cmd *cobra.Command, args []string) error {
+	preserveIds, err := cmd.Flags().GetBool("preserve-ids")
+	if err != nil {
+		return err
+	}
+
+	exports, err := readSubjectExports(args[0])
+	if err != nil {
+		return err
+	}
+
+	schemas, err := sortSchemasByDependencies(exports)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.GetSchemaRegistryClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	if preserveIds {
+		for _, export := range exports {
+			if _, err := client.UpdateMode(export.Subject, srsdk.ModeUpdateRequest{Mode: srsdk.PtrString(importMode)}); err != nil {
+				return fmt.Errorf(`failed to set mode of subject "%s" to %s: %w`, export.Subject, importMode, err)
+			}
+		}
+	}
+
+	list := output.NewList(cmd)
+	for _, schema := range schemas {
+		req := srsdk.RegisterSchemaRequest{
+			Schema:     schema.Schema,
+			SchemaType: schema.SchemaType,
+			References: schema.References,
+			Metadata:   schema.Metadata,
+			RuleSet:    schema.Ruleset,
+		}
+		if preserveIds {
+			req.Id = schema.Id
+			req.Version = schema.Version
+		}
+
+		res, err := client.Register(schema.GetSubject(), req, false)
+		if err != nil {
+			return fmt.Errorf(`failed to register version %d of subject "%s": %w`, schema.GetVersion(), schema.GetSubject(), err)
+		}
+
+		list.Add(&subjectImportOut{
+			Subject: schema.GetSubject(),
+			Version: schema.GetVersion(),
+			Id:      res.GetId(),
+		})
+	}
+
+	for _, export := range exports {
+		if err := importSubjectSettings(client, export, preserveIds); err != nil {
+			return err
+		}
+	}
+
+	// Keep the order in which the schemas were registered
+	list.Sort(false)
+	return list.Print()
+}
+
+// importSubjectSettings sets the compatibility and mode of a subject. The mode is set last, since a mode like
+// READONLY rejects changes to the subject.
+func importSubjectSettings(client *schemaregistry.Client, export *subjectExport, preserveIds bool) error {
+	if export.Config != nil {
+		req := srsdk.ConfigUpdateRequest{
+			Compatibility:      export.Config.CompatibilityLevel,
+			CompatibilityGroup: export.Config.CompatibilityGroup,
+			DefaultMetadata:    export.Config.DefaultMetadata,
+			OverrideMetadata:   export.Config.OverrideMetadata,
+			DefaultRuleSet:     export.Config.DefaultRuleSet,
+			OverrideRuleSet:    export.Config.OverrideRuleSet,
+		}
+		if _, err := client.UpdateSubjectLevelConfig(export.Subject, req); err != nil {
+			return fmt.Errorf(`failed to update compatibility of subject "%s": %w`, export.Subject, err)
+		}
+	}
+
+	mode := export.Mode
+	if mode == "" && preserveIds {
+		mode = readWriteMode
+	}
+	if mode != "" {
+		if _, err := client.UpdateMode(export.Subject, srsdk.ModeUpdateRequest{Mode: srsdk.PtrString(mode)}); err != nil {
+			return fmt.Errorf(`failed to set mode of subject "%s" to %s: %w`, export.Subject, mode, err)
+		}
+	}
+
+	return nil
+}
+
+type schemaKey struct {
+	subject string
+	version int32
+}
+
+// sortSchemasByDependencies orders the schemas of all subjects so that every schema comes after the schemas it
+// references and after the previous versions of its subject. References to schemas which aren't part of the export
+// are expected to exist in the target Schema Registry already.
+func sortSchemasByDependencies(exports []*subjectExport) ([]srsdk.Schema, error) {
+	schemas := make(map[schemaKey]srsdk.Schema)
+	var keys []schemaKey
+	for _, export := range exports {
+		for _, schema := range export.Schemas {
+			schema.Subject = srsdk.PtrString(export.Subject)
+			key := schemaKey{subject: export.Subject, version: schema.GetVersion()}
+			schemas[key] = schema
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].subject != keys[j].subject {
+			return keys[i].subject < keys[j].subject
+		}
+		return keys[i].version < keys[j].version
+	})
+
+	// The previous version of each version of a subject, which has to be registered first
+	previous := make(map[schemaKey]schemaKey)
+	for i := 1; i < len(keys); i++ {
+		if keys[i].subject == keys[i-1].subject {
+			previous[keys[i]] = keys[i-1]
+		}
+	}
+
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := make(map[schemaKey]int)
+	sorted := make([]srsdk.Schema, 0, len(keys))
+
+	var visit func(key schemaKey) error
+	visit = func(key schemaKey) error {
+		switch state[key] {
+		case visiting:
+			return fmt.Errorf(`circular reference involving version %d of subject "%s"`, key.version, key.subject)
+		case visited:
+			return nil
+		}
+		state[key] = visiting
+
+		schema := schemas[key]
+		for _, reference := range schema.GetReferences() {
+			dependency := schemaKey{subject: reference.GetSubject(), version: reference.GetVersion()}
+			if _, ok := schemas[dependency]; !ok {
+				continue
+			}
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+		if dependency, ok := previous[key]; ok {
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+
+		state[key] = visited
+		sorted = append(sorted, schema)
+		return nil
+	}
+
+	for _, key := range keys {
+		if err := visit(key); err != nil {
+			return nil, err
+		}
+	}
+	return sorted, nil
+}
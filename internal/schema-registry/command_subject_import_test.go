@@ -0,0 +1,45 @@
+package schemaregistry
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+)
+
+func newTestSchema(version int32, references ...srsdk.SchemaReference) srsdk.Schema {
+	return srsdk.Schema{Version: srsdk.PtrInt32(version), References: &references}
+}
+
+func newTestReference(subject string, version int32) srsdk.SchemaReference {
+	return srsdk.SchemaReference{Name: srsdk.PtrString(subject), Subject: srsdk.PtrString(subject), Version: srsdk.PtrInt32(version)}
+}
+
+func TestSortSchemasByDependencies(t *testing.T) {
+	exports := []*subjectExport{
+		{Subject: "a", Schemas: []srsdk.Schema{newTestSchema(2, newTestReference("c", 1)), newTestSchema(1)}},
+		{Subject: "b", Schemas: []srsdk.Schema{newTestSchema(1, newTestReference("a", 2), newTestReference("external", 1))}},
+		{Subject: "c", Schemas: []srsdk.Schema{newTestSchema(1)}},
+	}
+
+	schemas, err := sortSchemasByDependencies(exports)
+	require.NoError(t, err)
+
+	var order []string
+	for _, schema := range schemas {
+		order = append(order, fmt.Sprintf("%s-%d", schema.GetSubject(), schema.GetVersion()))
+	}
+	require.Equal(t, []string{"a-1", "c-1", "a-2", "b-1"}, order)
+}
+
+func TestSortSchemasByDependenciesCircularReference(t *testing.T) {
+	exports := []*subjectExport{
+		{Subject: "a", Schemas: []srsdk.Schema{newTestSchema(1, newTestReference("b", 1))}},
+		{Subject: "b", Schemas: []srsdk.Schema{newTestSchema(1, newTestReference("a", 1))}},
+	}
+
+	_, err := sortSchemasByDependencies(exports)
+	require.EqualError(t, err, `circular reference involving version 1 of subject "a"`)
+}
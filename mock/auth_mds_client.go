@@ -13,19 +13,21 @@ import (
 // MDSClientManager is a mock of MDSClientManager interface
 type MDSClientManager struct {
 	lockGetMDSClient sync.Mutex
-	GetMDSClientFunc func(url, caCertPath string, unsafeTrace bool) (*github_com_confluentinc_mds_sdk_go_mdsv1.APIClient, error)
+	GetMDSClientFunc func(url, caCertPath, clientCertPath, clientKeyPath string, unsafeTrace bool) (*github_com_confluentinc_mds_sdk_go_mdsv1.APIClient, error)
 
 	calls struct {
 		GetMDSClient []struct {
-			Url         string
-			CaCertPath  string
-			UnsafeTrace bool
+			Url            string
+			CaCertPath     string
+			ClientCertPath string
+			ClientKeyPath  string
+			UnsafeTrace    bool
 		}
 	}
 }
 
 // GetMDSClient mocks base method by wrapping the associated func.
-func (m *MDSClientManager) GetMDSClient(url, caCertPath string, unsafeTrace bool) (*github_com_confluentinc_mds_sdk_go_mdsv1.APIClient, error) {
+func (m *MDSClientManager) GetMDSClient(url, caCertPath, clientCertPath, clientKeyPath string, unsafeTrace bool) (*github_com_confluentinc_mds_sdk_go_mdsv1.APIClient, error) {
 	m.lockGetMDSClient.Lock()
 	defer m.lockGetMDSClient.Unlock()
 
@@ -34,18 +36,22 @@ func (m *MDSClientManager) GetMDSClient(url, caCertPath string, unsafeTrace bool
 	}
 
 	call := struct {
-		Url         string
-		CaCertPath  string
-		UnsafeTrace bool
+		Url            string
+		CaCertPath     string
+		ClientCertPath string
+		ClientKeyPath  string
+		UnsafeTrace    bool
 	}{
-		Url:         url,
-		CaCertPath:  caCertPath,
-		UnsafeTrace: unsafeTrace,
+		Url:            url,
+		CaCertPath:     caCertPath,
+		ClientCertPath: clientCertPath,
+		ClientKeyPath:  clientKeyPath,
+		UnsafeTrace:    unsafeTrace,
 	}
 
 	m.calls.GetMDSClient = append(m.calls.GetMDSClient, call)
 
-	return m.GetMDSClientFunc(url, caCertPath, unsafeTrace)
+	return m.GetMDSClientFunc(url, caCertPath, clientCertPath, clientKeyPath, unsafeTrace)
 }
 
 // GetMDSClientCalled returns true if GetMDSClient was called at least once.
@@ -58,9 +64,11 @@ func (m *MDSClientManager) GetMDSClientCalled() bool {
 
 // GetMDSClientCalls returns the calls made to GetMDSClient.
 func (m *MDSClientManager) GetMDSClientCalls() []struct {
-	Url         string
-	CaCertPath  string
-	UnsafeTrace bool
+	Url            string
+	CaCertPath     string
+	ClientCertPath string
+	ClientKeyPath  string
+	UnsafeTrace    bool
 } {
 	m.lockGetMDSClient.Lock()
 	defer m.lockGetMDSClient.Unlock()
@@ -30,6 +30,9 @@ type LoginCredentialsManager struct {
 	lockGetCredentialsFromKeychain sync.Mutex
 	GetCredentialsFromKeychainFunc func(arg0 bool, arg1, arg2 string) func() (*github_com_confluentinc_cli_v3_pkg_auth.Credentials, error)
 
+	lockGetCredentialsFromCredentialHelper sync.Mutex
+	GetCredentialsFromCredentialHelperFunc func(arg0 *github_com_confluentinc_cli_v3_pkg_config.Config, arg1 bool, arg2, arg3 string) func() (*github_com_confluentinc_cli_v3_pkg_auth.Credentials, error)
+
 	lockGetCredentialsFromNetrc sync.Mutex
 	GetCredentialsFromNetrcFunc func(arg0 github_com_confluentinc_cli_v3_pkg_netrc.NetrcMachineParams) func() (*github_com_confluentinc_cli_v3_pkg_auth.Credentials, error)
 
@@ -70,6 +73,12 @@ type LoginCredentialsManager struct {
 			Arg1 string
 			Arg2 string
 		}
+		GetCredentialsFromCredentialHelper []struct {
+			Arg0 *github_com_confluentinc_cli_v3_pkg_config.Config
+			Arg1 bool
+			Arg2 string
+			Arg3 string
+		}
 		GetCredentialsFromNetrc []struct {
 			Arg0 github_com_confluentinc_cli_v3_pkg_netrc.NetrcMachineParams
 		}
@@ -290,6 +299,53 @@ func (m *LoginCredentialsManager) GetCredentialsFromKeychainCalls() []struct {
 	return m.calls.GetCredentialsFromKeychain
 }
 
+// GetCredentialsFromCredentialHelper mocks base method by wrapping the associated func.
+func (m *LoginCredentialsManager) GetCredentialsFromCredentialHelper(arg0 *github_com_confluentinc_cli_v3_pkg_config.Config, arg1 bool, arg2, arg3 string) func() (*github_com_confluentinc_cli_v3_pkg_auth.Credentials, error) {
+	m.lockGetCredentialsFromCredentialHelper.Lock()
+	defer m.lockGetCredentialsFromCredentialHelper.Unlock()
+
+	if m.GetCredentialsFromCredentialHelperFunc == nil {
+		panic("mocker: LoginCredentialsManager.GetCredentialsFromCredentialHelperFunc is nil but LoginCredentialsManager.GetCredentialsFromCredentialHelper was called.")
+	}
+
+	call := struct {
+		Arg0 *github_com_confluentinc_cli_v3_pkg_config.Config
+		Arg1 bool
+		Arg2 string
+		Arg3 string
+	}{
+		Arg0: arg0,
+		Arg1: arg1,
+		Arg2: arg2,
+		Arg3: arg3,
+	}
+
+	m.calls.GetCredentialsFromCredentialHelper = append(m.calls.GetCredentialsFromCredentialHelper, call)
+
+	return m.GetCredentialsFromCredentialHelperFunc(arg0, arg1, arg2, arg3)
+}
+
+// GetCredentialsFromCredentialHelperCalled returns true if GetCredentialsFromCredentialHelper was called at least once.
+func (m *LoginCredentialsManager) GetCredentialsFromCredentialHelperCalled() bool {
+	m.lockGetCredentialsFromCredentialHelper.Lock()
+	defer m.lockGetCredentialsFromCredentialHelper.Unlock()
+
+	return len(m.calls.GetCredentialsFromCredentialHelper) > 0
+}
+
+// GetCredentialsFromCredentialHelperCalls returns the calls made to GetCredentialsFromCredentialHelper.
+func (m *LoginCredentialsManager) GetCredentialsFromCredentialHelperCalls() []struct {
+	Arg0 *github_com_confluentinc_cli_v3_pkg_config.Config
+	Arg1 bool
+	Arg2 string
+	Arg3 string
+} {
+	m.lockGetCredentialsFromCredentialHelper.Lock()
+	defer m.lockGetCredentialsFromCredentialHelper.Unlock()
+
+	return m.calls.GetCredentialsFromCredentialHelper
+}
+
 // GetCredentialsFromNetrc mocks base method by wrapping the associated func.
 func (m *LoginCredentialsManager) GetCredentialsFromNetrc(arg0 github_com_confluentinc_cli_v3_pkg_netrc.NetrcMachineParams) func() (*github_com_confluentinc_cli_v3_pkg_auth.Credentials, error) {
 	m.lockGetCredentialsFromNetrc.Lock()
@@ -565,6 +621,9 @@ func (m *LoginCredentialsManager) Reset() {
 	m.lockGetCredentialsFromKeychain.Lock()
 	m.calls.GetCredentialsFromKeychain = nil
 	m.lockGetCredentialsFromKeychain.Unlock()
+	m.lockGetCredentialsFromCredentialHelper.Lock()
+	m.calls.GetCredentialsFromCredentialHelper = nil
+	m.lockGetCredentialsFromCredentialHelper.Unlock()
 	m.lockGetCredentialsFromNetrc.Lock()
 	m.calls.GetCredentialsFromNetrc = nil
 	m.lockGetCredentialsFromNetrc.Unlock()
@@ -0,0 +1,304 @@
+package alias
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/shlex"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/log"
+	pversion "github.com/confluentinc/cli/v3/pkg/version"
+)
+
+// Annotation marks the commands which are added to the command tree for aliases, so that they can be told apart from
+// built-in commands.
+const Annotation = "alias"
+
+var (
+	nameRegex        = regexp.MustCompile(`^[a-z][0-9a-z-]*$`)
+	placeholderRegex = regexp.MustCompile(`\$(@|[1-9][0-9]*)`)
+
+	// Commands which cobra adds to the command tree when it is executed
+	reservedNames = []string{"help"}
+)
+
+type aliasInfo struct {
+	name     string
+	commands []string
+	args     []string
+}
+
+// ValidateName checks that an alias name is well-formed, and that it does not shadow a built-in command.
+func ValidateName(cmd *cobra.Command, name string) error {
+	if !nameRegex.MatchString(name) {
+		return fmt.Errorf(`invalid alias name "%s": alias names must start with a lowercase letter, and contain only lowercase letters, digits, and dashes`, name)
+	}
+	if slices.Contains(reservedNames, name) || isBuiltInCommand(cmd.Root(), name) {
+		return errors.NewErrorWithSuggestions(
+			fmt.Sprintf("alias \"%s\" would shadow the built-in command `%s %s`", name, pversion.CLIName, name),
+			"Choose a different alias name.",
+		)
+	}
+	return nil
+}
+
+// Validate checks that an alias has at least one command, and that each command can be split into arguments.
+func Validate(commands []string) error {
+	if len(commands) == 0 {
+		return fmt.Errorf("aliases must have at least one command")
+	}
+	for _, command := range commands {
+		args, err := shlex.Split(command)
+		if err != nil {
+			return fmt.Errorf(`invalid command "%s": %w`, command, err)
+		}
+		if len(args) == 0 {
+			return fmt.Errorf("alias commands must not be empty")
+		}
+		if args[0] == pversion.CLIName {
+			return errors.NewErrorWithSuggestions(
+				fmt.Sprintf(`invalid command "%s"`, command),
+				fmt.Sprintf("Omit the leading `%s` from alias commands.", pversion.CLIName),
+			)
+		}
+	}
+	return nil
+}
+
+func isBuiltInCommand(root *cobra.Command, name string) bool {
+	cmd, _, err := root.Find([]string{name})
+	return err == nil && cmd != root && !IsAlias(cmd)
+}
+
+// IsAlias determines if a command in the command tree was added for an alias.
+func IsAlias(cmd *cobra.Command) bool {
+	_, ok := cmd.Annotations[Annotation]
+	return ok
+}
+
+// AddCommands adds a command to the command tree for each alias, so that aliases show up in help and shell completion.
+// Aliases which shadow a built-in command are skipped.
+func AddCommands(cmd *cobra.Command, cfg *config.Config) {
+	for name, commands := range cfg.Aliases {
+		if ValidateName(cmd, name) != nil {
+			continue
+		}
+		cmd.AddCommand(newAliasCommand(name, commands))
+	}
+}
+
+func newAliasCommand(name string, commands []string) *cobra.Command {
+	return &cobra.Command{
+		Use:                name,
+		Short:              fmt.Sprintf("Alias for `%s`.", Describe(commands)),
+		Annotations:        map[string]string{Annotation: ""},
+		DisableFlagParsing: true,
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			steps, err := Expand(name, commands, args)
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return complete(cmd.Root(), steps[len(steps)-1], toComplete)
+		},
+		RunE: func(_ *cobra.Command, args []string) error {
+			// Aliases are normally expanded before the command tree is executed; this is only reached from the shell
+			steps, err := Expand(name, commands, args)
+			if err != nil {
+				return err
+			}
+			return ExecSteps(steps)
+		},
+	}
+}
+
+// complete suggests the next argument of an expanded alias command, as if it had been typed out in full. Flags are
+// completed because the alias command itself disables flag parsing.
+func complete(root *cobra.Command, step []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	cmd, args, err := root.Find(step)
+	if err != nil || cmd == root || IsAlias(cmd) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	cmd.Flags().AddFlagSet(cmd.InheritedFlags())
+
+	if strings.HasPrefix(toComplete, "-") {
+		var suggestions []string
+		cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+			if !flag.Hidden && strings.HasPrefix("--"+flag.Name, toComplete) {
+				suggestions = append(suggestions, fmt.Sprintf("--%s\t%s", flag.Name, flag.Usage))
+			}
+		})
+		return suggestions, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	// The previous argument may be a flag which is waiting for its value
+	var flagName string
+	if len(args) > 0 && strings.HasPrefix(args[len(args)-1], "--") && !strings.Contains(args[len(args)-1], "=") {
+		if flag := cmd.Flags().Lookup(strings.TrimPrefix(args[len(args)-1], "--")); flag != nil && flag.NoOptDefVal == "" {
+			flagName = flag.Name
+			args = args[:len(args)-1]
+		}
+	}
+
+	if err := cmd.ParseFlags(args); err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	if flagName != "" {
+		if completionFunc, ok := cmd.GetFlagCompletionFunc(flagName); ok {
+			return completionFunc(cmd, cmd.Flags().Args(), toComplete)
+		}
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	if cmd.HasAvailableSubCommands() && len(cmd.Flags().Args()) == 0 {
+		var suggestions []string
+		for _, subcommand := range cmd.Commands() {
+			if subcommand.IsAvailableCommand() && strings.HasPrefix(subcommand.Name(), toComplete) {
+				suggestions = append(suggestions, fmt.Sprintf("%s\t%s", subcommand.Name(), subcommand.Short))
+			}
+		}
+		return suggestions, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	if cmd.ValidArgsFunction != nil {
+		return cmd.ValidArgsFunction(cmd, cmd.Flags().Args(), toComplete)
+	}
+
+	return nil, cobra.ShellCompDirectiveNoFileComp
+}
+
+// Describe formats the commands of an alias as a single line.
+func Describe(commands []string) string {
+	described := make([]string, len(commands))
+	for i, command := range commands {
+		described[i] = fmt.Sprintf("%s %s", pversion.CLIName, command)
+	}
+	return strings.Join(described, "; ")
+}
+
+// FindAlias determines if the arguments passed in invoke an alias.
+func FindAlias(cmd *cobra.Command, args []string, cfg *config.Config) *aliasInfo {
+	if len(args) == 0 {
+		return nil
+	}
+
+	commands, ok := cfg.Aliases[args[0]]
+	if !ok {
+		return nil
+	}
+
+	if isBuiltInCommand(cmd, args[0]) {
+		log.CliLogger.Warnf("[WARN] Alias %s is ignored because it matches existing CLI command `%s %s`.", args[0], pversion.CLIName, args[0])
+		return nil
+	}
+
+	return &aliasInfo{
+		name:     args[0],
+		commands: commands,
+		args:     args[1:],
+	}
+}
+
+// Expand returns the arguments of each command of an alias found by the above FindAlias function.
+func (a *aliasInfo) Expand() ([][]string, error) {
+	return Expand(a.name, a.commands, a.args)
+}
+
+// Expand substitutes arguments into the commands of an alias. `$1`, `$2`, etc. are replaced by the corresponding
+// argument, and `$@` by all arguments. Arguments which are not referenced by a placeholder are appended to the last
+// command.
+func Expand(name string, commands, args []string) ([][]string, error) {
+	if len(commands) == 0 {
+		return nil, errors.NewErrorWithSuggestions(
+			fmt.Sprintf(`alias "%s" has no commands`, name),
+			fmt.Sprintf("Delete the alias with `%s alias delete %s`, and create it again.", pversion.CLIName, name),
+		)
+	}
+
+	steps := make([][]string, len(commands))
+	used := make([]bool, len(args))
+
+	for i, command := range commands {
+		words, err := shlex.Split(command)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid command "%s" in alias "%s": %w`, command, name, err)
+		}
+
+		for _, word := range words {
+			if word == "$@" {
+				steps[i] = append(steps[i], args...)
+				for j := range used {
+					used[j] = true
+				}
+				continue
+			}
+
+			var substitutionErr error
+			word = placeholderRegex.ReplaceAllStringFunc(word, func(placeholder string) string {
+				if placeholder == "$@" {
+					for j := range used {
+						used[j] = true
+					}
+					return strings.Join(args, " ")
+				}
+
+				n, _ := strconv.Atoi(strings.TrimPrefix(placeholder, "$"))
+				if n > len(args) {
+					substitutionErr = errors.NewErrorWithSuggestions(
+						fmt.Sprintf(`alias "%s" requires at least %d argument(s)`, name, n),
+						fmt.Sprintf("The alias expands to `%s`.", Describe(commands)),
+					)
+					return placeholder
+				}
+				used[n-1] = true
+				return args[n-1]
+			})
+			if substitutionErr != nil {
+				return nil, substitutionErr
+			}
+
+			steps[i] = append(steps[i], word)
+		}
+	}
+
+	for j, arg := range args {
+		if !used[j] {
+			steps[len(steps)-1] = append(steps[len(steps)-1], arg)
+		}
+	}
+
+	return steps, nil
+}
+
+// ExecSteps runs the commands of a multi-step alias in sequence, stopping at the first command which fails.
+func ExecSteps(steps [][]string) error {
+	executable, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	for _, step := range steps {
+		log.CliLogger.Debugf("Running `%s %s`", pversion.CLIName, strings.Join(step, " "))
+		cmd := &exec.Cmd{
+			Path:   executable,
+			Args:   append([]string{executable}, step...),
+			Stdout: os.Stdout,
+			Stdin:  os.Stdin,
+			Stderr: os.Stderr,
+		}
+		if err := cmd.Run(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
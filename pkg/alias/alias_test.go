@@ -0,0 +1,161 @@
+package alias
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/confluentinc/cli/v3/pkg/config"
+)
+
+func newRootCommand() *cobra.Command {
+	root := &cobra.Command{Use: "confluent"}
+	root.AddCommand(&cobra.Command{Use: "kafka", Aliases: []string{"kfk"}})
+	return root
+}
+
+func TestExpand(t *testing.T) {
+	tests := []struct {
+		commands []string
+		args     []string
+		expected [][]string
+	}{
+		{
+			commands: []string{"kafka consumer group lag summarize --cluster lkc-123456"},
+			args:     []string{"my-group"},
+			expected: [][]string{{"kafka", "consumer", "group", "lag", "summarize", "--cluster", "lkc-123456", "my-group"}},
+		},
+		{
+			commands: []string{"environment use $1", "kafka cluster use $2"},
+			args:     []string{"env-123456", "lkc-123456", "-v"},
+			expected: [][]string{{"environment", "use", "env-123456"}, {"kafka", "cluster", "use", "lkc-123456", "-v"}},
+		},
+		{
+			commands: []string{"kafka topic create $1 --config retention.ms=$2", "kafka topic describe $1"},
+			args:     []string{"orders", "1000"},
+			expected: [][]string{{"kafka", "topic", "create", "orders", "--config", "retention.ms=1000"}, {"kafka", "topic", "describe", "orders"}},
+		},
+		{
+			commands: []string{"kafka topic delete $@ --force"},
+			args:     []string{"a", "b"},
+			expected: [][]string{{"kafka", "topic", "delete", "a", "b", "--force"}},
+		},
+		{
+			commands: []string{`kafka topic produce "$1 topic"`},
+			args:     []string{"my"},
+			expected: [][]string{{"kafka", "topic", "produce", "my topic"}},
+		},
+	}
+
+	for _, test := range tests {
+		steps, err := Expand("test", test.commands, test.args)
+		require.NoError(t, err)
+		require.Equal(t, test.expected, steps)
+	}
+}
+
+func TestExpand_MissingArgument(t *testing.T) {
+	_, err := Expand("test", []string{"environment use $1", "kafka cluster use $2"}, []string{"env-123456"})
+	require.EqualError(t, err, `alias "test" requires at least 2 argument(s)`)
+}
+
+func TestExpand_NoCommands(t *testing.T) {
+	_, err := Expand("test", []string{}, []string{"env-123456"})
+	require.EqualError(t, err, `alias "test" has no commands`)
+}
+
+func TestComplete(t *testing.T) {
+	root := newRootCommand()
+	kafka, _, err := root.Find([]string{"kafka"})
+	require.NoError(t, err)
+	topic := &cobra.Command{Use: "topic", Short: "Manage Kafka topics."}
+	describe := &cobra.Command{
+		Use: "describe",
+		Run: func(_ *cobra.Command, _ []string) {},
+		ValidArgsFunction: func(_ *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return []string{"orders"}, cobra.ShellCompDirectiveNoFileComp
+		},
+	}
+	describe.Flags().String("cluster", "", "Kafka cluster ID.")
+	_ = describe.RegisterFlagCompletionFunc("cluster", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		return []string{"lkc-123456"}, cobra.ShellCompDirectiveNoFileComp
+	})
+	topic.AddCommand(describe)
+	kafka.AddCommand(topic)
+
+	cfg := &config.Config{Aliases: map[string][]string{
+		"k":        {"kafka"},
+		"describe": {"kafka topic describe"},
+		"empty":    {},
+	}}
+	AddCommands(root, cfg)
+
+	tests := []struct {
+		alias      string
+		args       []string
+		toComplete string
+		expected   []string
+	}{
+		{alias: "k", toComplete: "to", expected: []string{"topic\tManage Kafka topics."}},
+		{alias: "describe", expected: []string{"orders"}},
+		{alias: "describe", args: []string{"orders"}},
+		{alias: "describe", toComplete: "--cl", expected: []string{"--cluster\tKafka cluster ID."}},
+		{alias: "describe", args: []string{"--cluster"}, expected: []string{"lkc-123456"}},
+		{alias: "empty"},
+	}
+
+	for _, test := range tests {
+		cmd, _, err := root.Find([]string{test.alias})
+		require.NoError(t, err)
+		suggestions, directive := cmd.ValidArgsFunction(cmd, test.args, test.toComplete)
+		require.Equal(t, test.expected, suggestions)
+		require.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+	}
+}
+
+func TestValidateName(t *testing.T) {
+	root := newRootCommand()
+
+	require.NoError(t, ValidateName(root, "lag"))
+	require.Error(t, ValidateName(root, "kafka"))
+	require.Error(t, ValidateName(root, "kfk"))
+	require.Error(t, ValidateName(root, "help"))
+	require.Error(t, ValidateName(root, "Lag"))
+	require.Error(t, ValidateName(root, "-lag"))
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate([]string{"kafka topic list", "kafka cluster list"}))
+	require.Error(t, Validate([]string{}))
+	require.Error(t, Validate([]string{"confluent kafka topic list"}))
+	require.Error(t, Validate([]string{""}))
+	require.Error(t, Validate([]string{`kafka topic describe "unterminated`}))
+}
+
+func TestFindAlias(t *testing.T) {
+	cfg := &config.Config{Aliases: map[string][]string{
+		"lag":   {"kafka consumer group lag summarize"},
+		"kafka": {"kafka cluster list"},
+	}}
+	root := newRootCommand()
+	AddCommands(root, cfg)
+
+	alias := FindAlias(root, []string{"lag", "my-group"}, cfg)
+	require.NotNil(t, alias)
+	steps, err := alias.Expand()
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"kafka", "consumer", "group", "lag", "summarize", "my-group"}}, steps)
+
+	// Aliases never shadow built-in commands
+	require.Nil(t, FindAlias(root, []string{"kafka"}, cfg))
+	require.Nil(t, FindAlias(root, []string{"topic"}, cfg))
+	require.Nil(t, FindAlias(root, []string{}, cfg))
+
+	cmd, _, err := root.Find([]string{"lag"})
+	require.NoError(t, err)
+	require.True(t, IsAlias(cmd))
+}
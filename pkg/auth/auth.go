@@ -7,26 +7,31 @@ import (
 	"strings"
 
 	"github.com/dghubble/sling"
+	"golang.org/x/oauth2"
 
 	ccloudv1 "github.com/confluentinc/ccloud-sdk-go-v1-public"
 
+	"github.com/confluentinc/cli/v3/pkg/auth/oauth"
 	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/credentialhelper"
 	"github.com/confluentinc/cli/v3/pkg/errors"
 	"github.com/confluentinc/cli/v3/pkg/keychain"
 	"github.com/confluentinc/cli/v3/pkg/output"
 	"github.com/confluentinc/cli/v3/pkg/secret"
+	"github.com/confluentinc/cli/v3/pkg/utils"
 )
 
 const (
 	CCloudURL = "https://confluent.cloud"
 
-	ConfluentCloudEmail          = "CONFLUENT_CLOUD_EMAIL"
-	ConfluentCloudPassword       = "CONFLUENT_CLOUD_PASSWORD"
-	ConfluentCloudOrganizationId = "CONFLUENT_CLOUD_ORGANIZATION_ID"
-	ConfluentPlatformUsername    = "CONFLUENT_PLATFORM_USERNAME"
-	ConfluentPlatformPassword    = "CONFLUENT_PLATFORM_PASSWORD"
-	ConfluentPlatformMDSURL      = "CONFLUENT_PLATFORM_MDS_URL"
-	ConfluentPlatformCACertPath  = "CONFLUENT_PLATFORM_CA_CERT_PATH"
+	ConfluentCloudEmail           = "CONFLUENT_CLOUD_EMAIL"
+	ConfluentCloudPassword        = "CONFLUENT_CLOUD_PASSWORD"
+	ConfluentCloudOrganizationId  = "CONFLUENT_CLOUD_ORGANIZATION_ID"
+	ConfluentPlatformUsername     = "CONFLUENT_PLATFORM_USERNAME"
+	ConfluentPlatformPassword     = "CONFLUENT_PLATFORM_PASSWORD"
+	ConfluentPlatformMDSURL       = "CONFLUENT_PLATFORM_MDS_URL"
+	ConfluentPlatformCACertPath   = "CONFLUENT_PLATFORM_CA_CERT_PATH"
+	ConfluentPlatformClientSecret = "CONFLUENT_PLATFORM_CLIENT_SECRET"
 
 	DeprecatedConfluentCloudEmail         = "CCLOUD_EMAIL"
 	DeprecatedConfluentCloudPassword      = "CCLOUD_PASSWORD"
@@ -62,6 +67,18 @@ func PersistLogout(config *config.Config) error {
 		}
 	}
 
+	if config.CredentialHelper != "" {
+		request := &credentialhelper.Request{
+			Type:    credentialhelper.Login,
+			Url:     ctx.GetPlatformServer(),
+			Context: ctx.GetNetrcMachineName(),
+			IsCloud: config.IsCloudLogin(),
+		}
+		if err := credentialhelper.Erase(config.CredentialHelper, request); err != nil {
+			return err
+		}
+	}
+
 	delete(ctx.Config.SavedCredentials, ctx.Name)
 	if err := ctx.DeleteUserAuth(); err != nil {
 		return err
@@ -122,11 +139,24 @@ func addOrUpdateContext(cfg *config.Config, isCloud bool, credentials *Credentia
 		Username: credentials.Username,
 		// don't save password if they entered it interactively.
 	}
+	if credentials.OAuth != nil {
+		oauth := *credentials.OAuth
+		if !save {
+			oauth.ClientSecret = ""
+		}
+		credential.Name = generateOAuthCredentialName(oauth.ClientId)
+		credential.OAuth = &oauth
+	}
+	if credentials.ClientCertPath != "" {
+		credential.Name = generateCertificateCredentialName(credentials.Username)
+		credential.ClientCertPath = credentials.ClientCertPath
+		credential.ClientKeyPath = credentials.ClientKeyPath
+	}
 	if err := cfg.SaveCredential(credential); err != nil {
 		return err
 	}
 
-	if save && !credentials.IsSSO {
+	if save && !credentials.IsSSO && credentials.OAuth == nil && credentials.ClientCertPath == "" {
 		salt, nonce, err := secret.GenerateSaltAndNonce()
 		if err != nil {
 			return err
@@ -203,6 +233,42 @@ func generateCredentialName(username string) string {
 	return fmt.Sprintf("username-%s", username)
 }
 
+func generateOAuthCredentialName(clientId string) string {
+	return fmt.Sprintf("client-%s", clientId)
+}
+
+func generateCertificateCredentialName(username string) string {
+	return fmt.Sprintf("certificate-%s", username)
+}
+
+// GetOAuthToken requests an access token for an OAuth client which logs in to Confluent Platform. If neither a client
+// secret nor a private key was saved, the client secret is read from the environment.
+func GetOAuthToken(cfg *config.OAuthConfig, caCertPath string) (*oauth2.Token, error) {
+	if cfg.ClientSecret == "" && cfg.ClientAssertionKeyPath == "" {
+		clientSecret := os.Getenv(ConfluentPlatformClientSecret)
+		if clientSecret == "" {
+			return nil, errors.NewErrorWithSuggestions(
+				fmt.Sprintf(`no client secret for OAuth client "%s"`, cfg.ClientId),
+				fmt.Sprintf("Set the client secret with the `%s` environment variable, or save it with `confluent login --save`.", ConfluentPlatformClientSecret),
+			)
+		}
+		withSecret := *cfg
+		withSecret.ClientSecret = clientSecret
+		cfg = &withSecret
+	}
+
+	client := utils.DefaultClient()
+	if caCertPath != "" {
+		var err error
+		client, err = utils.SelfSignedCertClientFromPath(caCertPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return oauth.GetToken(cfg, client)
+}
+
 func GetDataplaneToken(ctx *config.Context) (string, error) {
 	endpoint := strings.Trim(ctx.GetPlatformServer(), "/") + "/api/access_tokens"
 
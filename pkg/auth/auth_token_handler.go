@@ -143,8 +143,11 @@ func (a *AuthTokenHandlerImpl) refreshCCloudSSOToken(client *ccloudv1.Client, re
 
 func (a *AuthTokenHandlerImpl) GetConfluentToken(mdsClient *mdsv1.APIClient, credentials *Credentials) (string, error) {
 	ctx := utils.GetContext()
-	basicContext := context.WithValue(ctx, mdsv1.ContextBasicAuth, mdsv1.BasicAuth{UserName: credentials.Username, Password: credentials.Password})
-	resp, _, err := mdsClient.TokensAndAuthenticationApi.GetToken(basicContext)
+	// Clients with a certificate are authenticated by the TLS handshake, instead of with a username and password
+	if credentials.ClientCertPath == "" {
+		ctx = context.WithValue(ctx, mdsv1.ContextBasicAuth, mdsv1.BasicAuth{UserName: credentials.Username, Password: credentials.Password})
+	}
+	resp, _, err := mdsClient.TokensAndAuthenticationApi.GetToken(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/confluentinc/cli/v3/pkg/auth/sso"
 	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/credentialhelper"
 	"github.com/confluentinc/cli/v3/pkg/errors"
 	"github.com/confluentinc/cli/v3/pkg/form"
 	"github.com/confluentinc/cli/v3/pkg/keychain"
@@ -35,6 +36,13 @@ type Credentials struct {
 	AuthToken        string
 	AuthRefreshToken string
 
+	// Only for Confluent Platform login as an OAuth client
+	OAuth *config.OAuthConfig
+
+	// Only for Confluent Platform login with a client certificate
+	ClientCertPath string
+	ClientKeyPath  string
+
 	// Only for Confluent Prerun login
 	PrerunLoginURL        string
 	PrerunLoginCaCertPath string
@@ -74,6 +82,7 @@ type LoginCredentialsManager interface {
 	GetSsoCredentialsFromConfig(*config.Config, string) func() (*Credentials, error)
 	GetCredentialsFromConfig(*config.Config, netrc.NetrcMachineParams) func() (*Credentials, error)
 	GetCredentialsFromKeychain(bool, string, string) func() (*Credentials, error)
+	GetCredentialsFromCredentialHelper(*config.Config, bool, string, string) func() (*Credentials, error)
 	GetCredentialsFromNetrc(netrc.NetrcMachineParams) func() (*Credentials, error)
 	GetCloudCredentialsFromPrompt(string) func() (*Credentials, error)
 	GetOnPremCredentialsFromPrompt() func() (*Credentials, error)
@@ -384,6 +393,32 @@ func (h *LoginCredentialsManagerImpl) GetCredentialsFromKeychain(isCloud bool, c
 	}
 }
 
+func (h *LoginCredentialsManagerImpl) GetCredentialsFromCredentialHelper(cfg *config.Config, isCloud bool, ctxName, url string) func() (*Credentials, error) {
+	return func() (*Credentials, error) {
+		if cfg.CredentialHelper == "" {
+			return nil, nil
+		}
+
+		request := &credentialhelper.Request{
+			Type:    credentialhelper.Login,
+			Url:     url,
+			Context: ctxName,
+			IsCloud: isCloud,
+		}
+		response, err := credentialhelper.Get(cfg.CredentialHelper, request)
+		if err != nil {
+			return nil, err
+		}
+		if response == nil {
+			log.CliLogger.Debugf(`Did not find credentials from credential helper "%s"`, cfg.CredentialHelper)
+			return nil, nil
+		}
+
+		log.CliLogger.Debugf(`Found credentials for user "%s" from credential helper "%s" (%s)`, response.Username, cfg.CredentialHelper, stopNonInteractiveMsg)
+		return &Credentials{Username: response.Username, Password: response.Secret}, nil
+	}
+}
+
 func (h *LoginCredentialsManagerImpl) SetCloudClient(client *ccloudv1.Client) {
 	h.client = client
 }
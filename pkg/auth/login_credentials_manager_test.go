@@ -3,6 +3,8 @@ package auth
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"runtime"
 	"testing"
 
 	"github.com/spf13/cobra"
@@ -12,6 +14,8 @@ import (
 	ccloudv1 "github.com/confluentinc/ccloud-sdk-go-v1-public"
 	ccloudv1mock "github.com/confluentinc/ccloud-sdk-go-v1-public/mock"
 
+	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/credentialhelper"
 	"github.com/confluentinc/cli/v3/pkg/errors"
 	"github.com/confluentinc/cli/v3/pkg/mock"
 	"github.com/confluentinc/cli/v3/pkg/netrc"
@@ -254,6 +258,32 @@ func (suite *LoginCredentialsManagerTestSuite) TestConfluentGetCredentialsFromNe
 	suite.compareCredentials(netrcCredentials, creds)
 }
 
+func (suite *LoginCredentialsManagerTestSuite) TestGetCredentialsFromCredentialHelper() {
+	if runtime.GOOS == "windows" {
+		suite.T().Skip("credential helper tests use a shell script")
+	}
+
+	// The helper only has credentials for Confluent Cloud
+	dir := suite.T().TempDir()
+	helper := "#!/bin/sh\nif grep -q is_cloud; then echo '{\"username\": \"helper-username\", \"secret\": \"helper-password\"}'; else echo '{}'; fi\n"
+	suite.require.NoError(os.WriteFile(filepath.Join(dir, credentialhelper.ExecutablePrefix+"test"), []byte(helper), 0700))
+	suite.T().Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	cfg := config.New()
+	creds, err := suite.loginCredentialsManager.GetCredentialsFromCredentialHelper(cfg, true, "", CCloudURL)()
+	suite.require.NoError(err)
+	suite.require.Nil(creds)
+
+	cfg.CredentialHelper = "test"
+	creds, err = suite.loginCredentialsManager.GetCredentialsFromCredentialHelper(cfg, true, "", CCloudURL)()
+	suite.require.NoError(err)
+	suite.compareCredentials(&Credentials{Username: "helper-username", Password: "helper-password"}, creds)
+
+	creds, err = suite.loginCredentialsManager.GetCredentialsFromCredentialHelper(cfg, false, "", "http://hi")()
+	suite.require.NoError(err)
+	suite.require.Nil(creds)
+}
+
 func (suite *LoginCredentialsManagerTestSuite) TestGetCCloudCredentialsFromPrompt() {
 	creds, err := suite.loginCredentialsManager.GetCloudCredentialsFromPrompt("")()
 	suite.require.NoError(err)
@@ -10,20 +10,20 @@ import (
 
 // Made it an interface so that we can inject MDS client for testing through GetMDSClient
 type MDSClientManager interface {
-	GetMDSClient(url, caCertPath string, unsafeTrace bool) (*mdsv1.APIClient, error)
+	GetMDSClient(url, caCertPath, clientCertPath, clientKeyPath string, unsafeTrace bool) (*mdsv1.APIClient, error)
 }
 
 type MDSClientManagerImpl struct{}
 
-func (m *MDSClientManagerImpl) GetMDSClient(url, caCertPath string, unsafeTrace bool) (*mdsv1.APIClient, error) {
+func (m *MDSClientManagerImpl) GetMDSClient(url, caCertPath, clientCertPath, clientKeyPath string, unsafeTrace bool) (*mdsv1.APIClient, error) {
 	mdsConfig := mdsv1.NewConfiguration()
 	mdsConfig.Debug = unsafeTrace
 
-	if caCertPath != "" {
-		log.CliLogger.Debugf("CA certificate path was specified.  Note, the set of supported ciphers for the CLI can be found at https://golang.org/pkg/crypto/tls/#pkg-constants")
+	if caCertPath != "" || clientCertPath != "" {
+		log.CliLogger.Debugf("CA certificate or client certificate path was specified.  Note, the set of supported ciphers for the CLI can be found at https://golang.org/pkg/crypto/tls/#pkg-constants")
 		var err error
 
-		mdsConfig.HTTPClient, err = utils.SelfSignedCertClientFromPath(caCertPath)
+		mdsConfig.HTTPClient, err = utils.CustomCAAndClientCertClient(caCertPath, clientCertPath, clientKeyPath)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,146 @@
+package oauth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/google/uuid"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/log"
+)
+
+const (
+	clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+	// Client assertions are only used once, so they are short-lived
+	clientAssertionLifetime = 5 * time.Minute
+)
+
+// GetToken requests an access token from the token endpoint with the OAuth 2.0 client credentials grant. The client
+// authenticates with its secret, or else with a JWT signed by its private key (RFC 7523).
+func GetToken(cfg *config.OAuthConfig, client *http.Client) (*oauth2.Token, error) {
+	clientCredentials := &clientcredentials.Config{
+		ClientID:     cfg.ClientId,
+		ClientSecret: cfg.ClientSecret,
+		TokenURL:     cfg.TokenUrl,
+		Scopes:       cfg.Scopes,
+	}
+
+	if cfg.ClientAssertionKeyPath != "" {
+		assertion, err := newClientAssertion(cfg.ClientId, cfg.TokenUrl, cfg.ClientAssertionKeyPath)
+		if err != nil {
+			return nil, err
+		}
+		clientCredentials.ClientSecret = ""
+		clientCredentials.AuthStyle = oauth2.AuthStyleInParams
+		clientCredentials.EndpointParams = url.Values{
+			"client_assertion_type": {clientAssertionType},
+			"client_assertion":      {assertion},
+		}
+	}
+
+	log.CliLogger.Debugf("Requesting OAuth token for client %s from %s", cfg.ClientId, cfg.TokenUrl)
+
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, client)
+	token, err := clientCredentials.Token(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OAuth token: %w", err)
+	}
+	return token, nil
+}
+
+// newClientAssertion returns a JWT which identifies the client to the token endpoint, signed by the client's private key.
+func newClientAssertion(clientId, tokenUrl, keyPath string) (string, error) {
+	key, err := readPrivateKey(keyPath)
+	if err != nil {
+		return "", err
+	}
+
+	algorithm, err := getSignatureAlgorithm(key)
+	if err != nil {
+		return "", err
+	}
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: algorithm, Key: key}, (&jose.SignerOptions{}).WithType("JWT"))
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	claims := jwt.Claims{
+		Issuer:   clientId,
+		Subject:  clientId,
+		Audience: jwt.Audience{tokenUrl},
+		ID:       uuid.New().String(),
+		IssuedAt: jwt.NewNumericDate(now),
+		Expiry:   jwt.NewNumericDate(now.Add(clientAssertionLifetime)),
+	}
+
+	return jwt.Signed(signer).Claims(claims).CompactSerialize()
+}
+
+func readPrivateKey(path string) (crypto.Signer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read private key: %w", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf(`private key "%s" is not in PEM format`, path)
+	}
+
+	var key any
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	return signer, nil
+}
+
+func getSignatureAlgorithm(key crypto.Signer) (jose.SignatureAlgorithm, error) {
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		return jose.RS256, nil
+	case *ecdsa.PrivateKey:
+		switch key.Curve {
+		case elliptic.P256():
+			return jose.ES256, nil
+		case elliptic.P384():
+			return jose.ES384, nil
+		case elliptic.P521():
+			return jose.ES512, nil
+		}
+	case ed25519.PrivateKey:
+		return jose.EdDSA, nil
+	}
+	return "", fmt.Errorf("unsupported private key type %T", key)
+}
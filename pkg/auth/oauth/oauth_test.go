@@ -0,0 +1,109 @@
+package oauth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/stretchr/testify/require"
+
+	"github.com/confluentinc/cli/v3/pkg/config"
+)
+
+func newTokenServer(t *testing.T, check func(*http.Request)) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		require.Equal(t, "kafka mds", r.PostForm.Get("scope"))
+		check(r)
+
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "my-token",
+			"token_type":   "Bearer",
+			"expires_in":   300,
+		}))
+	}))
+}
+
+func TestGetToken_ClientSecret(t *testing.T) {
+	server := newTokenServer(t, func(r *http.Request) {
+		clientId, clientSecret, ok := r.BasicAuth()
+		if !ok {
+			clientId, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+		}
+		require.Equal(t, "my-client", clientId)
+		require.Equal(t, "my-secret", clientSecret)
+	})
+	defer server.Close()
+
+	cfg := &config.OAuthConfig{
+		TokenUrl:     server.URL,
+		ClientId:     "my-client",
+		ClientSecret: "my-secret",
+		Scopes:       []string{"kafka", "mds"},
+	}
+	token, err := GetToken(cfg, server.Client())
+	require.NoError(t, err)
+	require.Equal(t, "my-token", token.AccessToken)
+	require.WithinDuration(t, time.Now().Add(5*time.Minute), token.Expiry, time.Minute)
+}
+
+func TestGetToken_ClientAssertion(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	keyPath := filepath.Join(t.TempDir(), "key.pem")
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600))
+
+	var server *httptest.Server
+	server = newTokenServer(t, func(r *http.Request) {
+		_, _, ok := r.BasicAuth()
+		require.False(t, ok)
+		require.Empty(t, r.PostForm.Get("client_secret"))
+		require.Equal(t, "my-client", r.PostForm.Get("client_id"))
+		require.Equal(t, clientAssertionType, r.PostForm.Get("client_assertion_type"))
+
+		assertion, err := jwt.ParseSigned(r.PostForm.Get("client_assertion"))
+		require.NoError(t, err)
+		claims := jwt.Claims{}
+		require.NoError(t, assertion.Claims(&key.PublicKey, &claims))
+		require.NoError(t, claims.Validate(jwt.Expected{Issuer: "my-client", Subject: "my-client", Audience: jwt.Audience{server.URL}}))
+		require.NotEmpty(t, claims.ID)
+	})
+	defer server.Close()
+
+	cfg := &config.OAuthConfig{
+		TokenUrl:               server.URL,
+		ClientId:               "my-client",
+		ClientAssertionKeyPath: keyPath,
+		Scopes:                 []string{"kafka", "mds"},
+	}
+	token, err := GetToken(cfg, server.Client())
+	require.NoError(t, err)
+	require.Equal(t, "my-token", token.AccessToken)
+}
+
+func TestGetToken_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error":"invalid_client"}`))
+	}))
+	defer server.Close()
+
+	cfg := &config.OAuthConfig{TokenUrl: server.URL, ClientId: "my-client", ClientSecret: "wrong-secret"}
+	_, err := GetToken(cfg, server.Client())
+	require.ErrorContains(t, err, "invalid_client")
+}
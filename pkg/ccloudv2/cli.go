@@ -8,6 +8,7 @@ import (
 	cliv1 "github.com/confluentinc/ccloud-sdk-go-v2/cli/v1"
 
 	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/log"
 )
 
 func newCliClient(url, userAgent string, unsafeTrace bool) *cliv1.APIClient {
@@ -17,8 +18,10 @@ func newCliClient(url, userAgent string, unsafeTrace bool) *cliv1.APIClient {
 	cfg.UserAgent = userAgent
 
 	// We do not use a retryable HTTP client so the CLI does not hang if there is a problem with the usage service.
-	cfg.HTTPClient = http.DefaultClient
-	cfg.HTTPClient.Timeout = 5 * time.Second
+	cfg.HTTPClient = &http.Client{
+		Transport: log.NewTransport(http.DefaultTransport),
+		Timeout:   5 * time.Second,
+	}
 
 	return cliv1.NewAPIClient(cfg)
 }
@@ -49,6 +49,7 @@ func IsCCloudURL(url string, isTest bool) bool {
 
 func NewRetryableHttpClient(cfg *config.Config, unsafeTrace bool) *http.Client {
 	client := retryablehttp.NewClient()
+	client.HTTPClient.Transport = plog.NewTransport(client.HTTPClient.Transport)
 	client.Logger = plog.NewLeveledLogger(unsafeTrace)
 	client.CheckRetry = func(_ context.Context, resp *http.Response, err error) (bool, error) {
 		if resp == nil {
@@ -78,6 +79,7 @@ func NewRetryableHttpClient(cfg *config.Config, unsafeTrace bool) *http.Client {
 
 func NewRetryableHttpClientWithRedirect(unsafeTrace bool, checkRedirect func(*http.Request, []*http.Request) error) *http.Client {
 	client := retryablehttp.NewClient()
+	client.HTTPClient.Transport = plog.NewTransport(client.HTTPClient.Transport)
 	client.Logger = plog.NewLeveledLogger(unsafeTrace)
 	client.CheckRetry = func(_ context.Context, resp *http.Response, err error) (bool, error) {
 		if resp == nil {
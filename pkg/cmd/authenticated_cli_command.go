@@ -16,6 +16,7 @@ import (
 	"github.com/confluentinc/cli/v3/pkg/auth"
 	"github.com/confluentinc/cli/v3/pkg/ccloudv2"
 	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/credentialhelper"
 	"github.com/confluentinc/cli/v3/pkg/errors"
 	"github.com/confluentinc/cli/v3/pkg/hub"
 	"github.com/confluentinc/cli/v3/pkg/schemaregistry"
@@ -188,6 +189,9 @@ func (c *AuthenticatedCLICommand) GetSchemaRegistryClient(cmd *cobra.Command) (*
 		configuration.Debug = unsafeTrace
 		configuration.HTTPClient = ccloudv2.NewRetryableHttpClient(c.Config, unsafeTrace)
 
+		// the ID of the Schema Registry cluster identifies its API keys to the credential helper
+		var clusterId string
+
 		schemaRegistryEndpoint, _ := cmd.Flags().GetString("schema-registry-endpoint")
 		if schemaRegistryEndpoint != "" {
 			u, err := url.Parse(schemaRegistryEndpoint)
@@ -223,6 +227,7 @@ func (c *AuthenticatedCLICommand) GetSchemaRegistryClient(cmd *cobra.Command) (*
 			}
 			configuration.Servers = srsdk.ServerConfigurations{{URL: clusters[0].Spec.GetHttpEndpoint()}}
 			configuration.DefaultHeader = map[string]string{"target-sr-cluster": clusters[0].GetId()}
+			clusterId = clusters[0].GetId()
 		} else {
 			return nil, errors.NewErrorWithSuggestions(
 				"Schema Registry endpoint not found",
@@ -233,6 +238,21 @@ func (c *AuthenticatedCLICommand) GetSchemaRegistryClient(cmd *cobra.Command) (*
 		schemaRegistryApiKey, _ := cmd.Flags().GetString("schema-registry-api-key")
 		schemaRegistryApiSecret, _ := cmd.Flags().GetString("schema-registry-api-secret")
 
+		if schemaRegistryApiKey != "" && schemaRegistryApiSecret == "" && c.Config.CredentialHelper != "" {
+			request := &credentialhelper.Request{
+				Type:     credentialhelper.SchemaRegistryAPIKey,
+				Key:      schemaRegistryApiKey,
+				Resource: clusterId,
+			}
+			response, err := credentialhelper.Get(c.Config.CredentialHelper, request)
+			if err != nil {
+				return nil, err
+			}
+			if response != nil {
+				schemaRegistryApiSecret = response.Secret
+			}
+		}
+
 		if schemaRegistryApiKey != "" && schemaRegistryApiSecret != "" {
 			apiKey := srsdk.BasicAuth{
 				UserName: schemaRegistryApiKey,
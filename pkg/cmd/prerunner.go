@@ -78,6 +78,9 @@ func (r *PreRun) Anonymous(command *CLICommand, willAuthenticate bool) func(*cob
 		if err := command.Config.ParseFlagsIntoConfig(cmd); err != nil {
 			return err
 		}
+		if err := command.Config.Project.SetFlagDefaults(cmd); err != nil {
+			return err
+		}
 
 		// check Feature Flag "cli.disable" for commands run from cloud context (except for on-prem login)
 		// check for commands that require cloud auth (since cloud context might not be active until auto-login)
@@ -103,6 +106,17 @@ func (r *PreRun) Anonymous(command *CLICommand, willAuthenticate bool) func(*cob
 			verbosity = int(log.TRACE)
 		}
 		log.CliLogger.SetVerbosity(verbosity)
+
+		// The log file is opened before flushing, so that it receives the messages which were buffered until now
+		logFile, err := cmd.Flags().GetString("log-file")
+		if err != nil {
+			return err
+		}
+		if logFile != "" {
+			if err := log.CliLogger.SetLogFile(logFile, "command", cmd.CommandPath(), "version", r.Version.Version); err != nil {
+				return fmt.Errorf("failed to open log file: %w", err)
+			}
+		}
 		log.CliLogger.Flush()
 
 		command.Version = r.Version
@@ -292,6 +306,7 @@ func (r *PreRun) getCCloudCredentials(netrcMachineName, url, organizationId stri
 	}
 	credentials, err := pauth.GetLoginCredentials(
 		r.LoginCredentialsManager.GetCloudCredentialsFromEnvVar(organizationId),
+		r.LoginCredentialsManager.GetCredentialsFromCredentialHelper(r.Config, true, filterParams.Name, url),
 		r.LoginCredentialsManager.GetCredentialsFromKeychain(true, filterParams.Name, url),
 		r.LoginCredentialsManager.GetPrerunCredentialsFromConfig(r.Config),
 		r.LoginCredentialsManager.GetCredentialsFromNetrc(filterParams),
@@ -493,7 +508,7 @@ func (r *PreRun) getConfluentTokenAndCredentials(cmd *cobra.Command, netrcMachin
 		return "", nil, err
 	}
 
-	client, err := r.MDSClientManager.GetMDSClient(credentials.PrerunLoginURL, credentials.PrerunLoginCaCertPath, unsafeTrace)
+	client, err := r.MDSClientManager.GetMDSClient(credentials.PrerunLoginURL, credentials.PrerunLoginCaCertPath, "", "", unsafeTrace)
 	if err != nil {
 		return "", nil, err
 	}
@@ -519,15 +534,18 @@ func (r *PreRun) createMDSClient(ctx *config.Context, ver *version.Version, unsa
 	}
 	mdsConfig.BasePath = ctx.GetPlatformServer()
 	mdsConfig.UserAgent = ver.UserAgent
-	if ctx.Platform.CaCertPath == "" {
+	caCertPath := ctx.Platform.CaCertPath
+	clientCertPath := ctx.Credential.ClientCertPath
+	clientKeyPath := ctx.Credential.ClientKeyPath
+	if caCertPath == "" && clientCertPath == "" {
 		return mdsv1.NewAPIClient(mdsConfig)
 	}
-	caCertPath := ctx.Platform.CaCertPath
 	// Try to load certs. On failure, warn, but don't error out because this may be an auth command, so there may
-	// be a --ca-cert-path flag on the cmd line that'll fix whatever issue there is with the cert file in the config
-	client, err := utils.SelfSignedCertClientFromPath(caCertPath)
+	// be a --ca-cert-path or --client-cert-path flag on the cmd line that'll fix whatever issue there is with the cert
+	// files in the config
+	client, err := utils.CustomCAAndClientCertClient(caCertPath, clientCertPath, clientKeyPath)
 	if err != nil {
-		log.CliLogger.Warnf("Unable to load certificate from %s. %s. Resulting SSL errors will be fixed by logging in with the --ca-cert-path flag.", caCertPath, err.Error())
+		log.CliLogger.Warnf("Unable to load certificates. %s. Resulting SSL errors will be fixed by logging in with the --ca-cert-path or --client-cert-path flags.", err.Error())
 	} else {
 		mdsConfig.HTTPClient = client
 	}
@@ -700,6 +718,7 @@ func (r *PreRun) getUpdatedAuthToken(ctx *config.Context, unsafeTrace bool) (str
 
 		credentials, err := pauth.GetLoginCredentials(
 			r.LoginCredentialsManager.GetCloudCredentialsFromEnvVar(organizationId),
+			r.LoginCredentialsManager.GetCredentialsFromCredentialHelper(r.Config, true, ctx.Name, ctx.GetPlatformServer()),
 			r.LoginCredentialsManager.GetCredentialsFromKeychain(true, ctx.Name, ctx.GetPlatformServer()),
 			r.LoginCredentialsManager.GetPrerunCredentialsFromConfig(r.Config),
 			r.LoginCredentialsManager.GetCredentialsFromNetrc(filterParams),
@@ -711,19 +730,40 @@ func (r *PreRun) getUpdatedAuthToken(ctx *config.Context, unsafeTrace bool) (str
 
 		return r.AuthTokenHandler.GetCCloudTokens(r.CCloudClientFactory, ctx.GetPlatformServer(), credentials, false, organizationId)
 	} else {
-		credentials, err := pauth.GetLoginCredentials(
-			r.LoginCredentialsManager.GetOnPremCredentialsFromEnvVar(),
-			r.LoginCredentialsManager.GetCredentialsFromKeychain(false, ctx.Name, ctx.GetPlatformServer()),
-			r.LoginCredentialsManager.GetPrerunCredentialsFromConfig(r.Config),
-			r.LoginCredentialsManager.GetCredentialsFromNetrc(filterParams),
-			r.LoginCredentialsManager.GetCredentialsFromConfig(r.Config, filterParams),
-		)
-		if err != nil {
-			return "", "", err
+		// OAuth clients request a new token from the token endpoint instead of MDS
+		if ctx.Credential != nil && ctx.Credential.OAuth != nil {
+			token, err := pauth.GetOAuthToken(ctx.Credential.OAuth, ctx.Platform.CaCertPath)
+			if err != nil {
+				return "", "", err
+			}
+			return token.AccessToken, "", nil
+		}
+
+		var credentials *pauth.Credentials
+		if ctx.Credential.ClientCertPath != "" {
+			// Clients with a certificate are authenticated by MDS without a password
+			credentials = &pauth.Credentials{
+				Username:       ctx.Credential.Username,
+				ClientCertPath: ctx.Credential.ClientCertPath,
+				ClientKeyPath:  ctx.Credential.ClientKeyPath,
+			}
+		} else {
+			var err error
+			credentials, err = pauth.GetLoginCredentials(
+				r.LoginCredentialsManager.GetOnPremCredentialsFromEnvVar(),
+				r.LoginCredentialsManager.GetCredentialsFromCredentialHelper(r.Config, false, ctx.Name, ctx.GetPlatformServer()),
+				r.LoginCredentialsManager.GetCredentialsFromKeychain(false, ctx.Name, ctx.GetPlatformServer()),
+				r.LoginCredentialsManager.GetPrerunCredentialsFromConfig(r.Config),
+				r.LoginCredentialsManager.GetCredentialsFromNetrc(filterParams),
+				r.LoginCredentialsManager.GetCredentialsFromConfig(r.Config, filterParams),
+			)
+			if err != nil {
+				return "", "", err
+			}
 		}
 
 		mdsClientManager := pauth.MDSClientManagerImpl{}
-		client, err := mdsClientManager.GetMDSClient(ctx.GetPlatformServer(), ctx.Platform.CaCertPath, unsafeTrace)
+		client, err := mdsClientManager.GetMDSClient(ctx.GetPlatformServer(), ctx.Platform.CaCertPath, credentials.ClientCertPath, credentials.ClientKeyPath, unsafeTrace)
 		if err != nil {
 			return "", "", err
 		}
@@ -798,15 +838,18 @@ func (r *PreRun) createMDSv2Client(ctx *config.Context, ver *version.Version, un
 	}
 	mdsv2Config.BasePath = ctx.GetPlatformServer() + "/api/metadata/security/v2alpha1"
 	mdsv2Config.UserAgent = ver.UserAgent
-	if ctx.Platform.CaCertPath == "" {
+	caCertPath := ctx.Platform.CaCertPath
+	clientCertPath := ctx.Credential.ClientCertPath
+	clientKeyPath := ctx.Credential.ClientKeyPath
+	if caCertPath == "" && clientCertPath == "" {
 		return mdsv2alpha1.NewAPIClient(mdsv2Config)
 	}
-	caCertPath := ctx.Platform.CaCertPath
 	// Try to load certs. On failure, warn, but don't error out because this may be an auth command, so there may
-	// be a --ca-cert-path flag on the cmd line that'll fix whatever issue there is with the cert file in the config
-	client, err := utils.SelfSignedCertClientFromPath(caCertPath)
+	// be a --ca-cert-path or --client-cert-path flag on the cmd line that'll fix whatever issue there is with the cert
+	// files in the config
+	client, err := utils.CustomCAAndClientCertClient(caCertPath, clientCertPath, clientKeyPath)
 	if err != nil {
-		log.CliLogger.Warnf("Unable to load certificate from %s. %s. Resulting SSL errors will be fixed by logging in with the --ca-cert-path flag.", caCertPath, err.Error())
+		log.CliLogger.Warnf("Unable to load certificates. %s. Resulting SSL errors will be fixed by logging in with the --ca-cert-path or --client-cert-path flags.", err.Error())
 	} else {
 		mdsv2Config.HTTPClient = client
 	}
@@ -74,6 +74,11 @@ var (
 				return nil, nil
 			}
 		},
+		GetCredentialsFromCredentialHelperFunc: func(_ *config.Config, _ bool, _, _ string) func() (*pauth.Credentials, error) {
+			return func() (*pauth.Credentials, error) {
+				return nil, nil
+			}
+		},
 		GetCredentialsFromConfigFunc: func(_ *config.Config, _ netrc.NetrcMachineParams) func() (*pauth.Credentials, error) {
 			return func() (*pauth.Credentials, error) {
 				return nil, nil
@@ -112,7 +117,7 @@ func getPreRunBase() *pcmd.PreRun {
 			},
 		},
 		MDSClientManager: &climock.MDSClientManager{
-			GetMDSClientFunc: func(_, _ string, _ bool) (*mdsv1.APIClient, error) {
+			GetMDSClientFunc: func(_, _, _, _ string, _ bool) (*mdsv1.APIClient, error) {
 				return &mdsv1.APIClient{}, nil
 			},
 		},
@@ -140,6 +145,7 @@ func TestPreRun_Anonymous_SetLoggingLevel(t *testing.T) {
 		cmd := &cobra.Command{Run: func(cmd *cobra.Command, args []string) {}}
 		cmd.Flags().CountP("verbose", "v", "Increase verbosity")
 		cmd.Flags().Bool("unsafe-trace", false, "")
+		cmd.Flags().String("log-file", "", "")
 		c := pcmd.NewAnonymousCLICommand(cmd, r)
 
 		_, err := pcmd.ExecuteCommand(c.Command, "help", flags)
@@ -160,6 +166,7 @@ func TestPreRun_TokenExpires(t *testing.T) {
 	rootCmd := pcmd.NewAuthenticatedCLICommand(root, r)
 	root.Flags().CountP("verbose", "v", "Increase verbosity")
 	root.Flags().Bool("unsafe-trace", false, "")
+	root.Flags().String("log-file", "", "")
 
 	_, err := pcmd.ExecuteCommand(rootCmd.Command)
 	require.Error(t, err)
@@ -241,6 +248,11 @@ func TestUpdateToken(t *testing.T) {
 						return nil, nil
 					}
 				},
+				GetCredentialsFromCredentialHelperFunc: func(_ *config.Config, _ bool, _, _ string) func() (*pauth.Credentials, error) {
+					return func() (*pauth.Credentials, error) {
+						return nil, nil
+					}
+				},
 				GetCredentialsFromConfigFunc: func(_ *config.Config, _ netrc.NetrcMachineParams) func() (*pauth.Credentials, error) {
 					return func() (*pauth.Credentials, error) {
 						return &pauth.Credentials{Username: "username", Password: "password"}, nil
@@ -277,6 +289,7 @@ func TestUpdateToken(t *testing.T) {
 			}
 			root.Flags().CountP("verbose", "v", "Increase verbosity")
 			root.Flags().Bool("unsafe-trace", false, "")
+			root.Flags().String("log-file", "", "")
 
 			_, err := pcmd.ExecuteCommand(rootCmd.Command, "--unsafe-trace")
 			require.Error(t, err)
@@ -440,6 +453,7 @@ func TestPrerun_AutoLogin(t *testing.T) {
 			var ccloudNetrcCalled bool
 			var ccloudConfigCalled bool
 			var ccloudKeychainCalled bool
+			var credentialHelperCalled bool
 			var confluentEnvVarCalled bool
 			var confluentNetrcCalled bool
 			r.LoginCredentialsManager = &climock.LoginCredentialsManager{
@@ -484,6 +498,12 @@ func TestPrerun_AutoLogin(t *testing.T) {
 						return test.keychainReturn.creds, test.keychainReturn.err
 					}
 				},
+				GetCredentialsFromCredentialHelperFunc: func(_ *config.Config, _ bool, _, _ string) func() (*pauth.Credentials, error) {
+					return func() (*pauth.Credentials, error) {
+						credentialHelperCalled = true
+						return nil, nil
+					}
+				},
 			}
 
 			root := &cobra.Command{
@@ -497,6 +517,7 @@ func TestPrerun_AutoLogin(t *testing.T) {
 			}
 			root.Flags().CountP("verbose", "v", "Increase verbosity")
 			root.Flags().Bool("unsafe-trace", false, "")
+			root.Flags().String("log-file", "", "")
 
 			out, err := pcmd.ExecuteCommand(rootCmd.Command)
 
@@ -505,11 +526,14 @@ func TestPrerun_AutoLogin(t *testing.T) {
 				require.Equal(t, test.netrcChecked, ccloudNetrcCalled)
 				require.Equal(t, test.configChecked, ccloudConfigCalled)
 				require.Equal(t, test.keychainChecked, ccloudKeychainCalled)
+				// the credential helper has no credentials, and is checked right before the keychain
+				require.Equal(t, test.keychainChecked, credentialHelperCalled)
 				require.False(t, confluentEnvVarCalled)
 			} else {
 				require.Equal(t, test.envVarChecked, confluentEnvVarCalled)
 				require.Equal(t, test.netrcChecked, confluentNetrcCalled)
 				require.Equal(t, test.keychainChecked, ccloudKeychainCalled)
+				require.False(t, credentialHelperCalled)
 				require.False(t, ccloudEnvVarCalled)
 			}
 
@@ -575,6 +599,11 @@ func TestPrerun_ReLoginToLastOrgUsed(t *testing.T) {
 				return nil, nil
 			}
 		},
+		GetCredentialsFromCredentialHelperFunc: func(_ *config.Config, _ bool, _, _ string) func() (*pauth.Credentials, error) {
+			return func() (*pauth.Credentials, error) {
+				return nil, nil
+			}
+		},
 	}
 
 	cfg := config.AuthenticatedToOrgCloudConfigMock(555, "o-555")
@@ -587,6 +616,7 @@ func TestPrerun_ReLoginToLastOrgUsed(t *testing.T) {
 	rootCmd := pcmd.NewAuthenticatedCLICommand(root, r)
 	root.Flags().CountP("verbose", "v", "Increase verbosity")
 	root.Flags().Bool("unsafe-trace", false, "")
+	root.Flags().String("log-file", "", "")
 
 	_, err = pcmd.ExecuteCommand(rootCmd.Command)
 	require.NoError(t, err)
@@ -636,6 +666,11 @@ func TestPrerun_AutoLoginNotTriggeredIfLoggedIn(t *testing.T) {
 						return nil, nil
 					}
 				},
+				GetCredentialsFromCredentialHelperFunc: func(_ *config.Config, _ bool, _, _ string) func() (*pauth.Credentials, error) {
+					return func() (*pauth.Credentials, error) {
+						return nil, nil
+					}
+				},
 			}
 
 			r := getPreRunBase()
@@ -654,6 +689,7 @@ func TestPrerun_AutoLoginNotTriggeredIfLoggedIn(t *testing.T) {
 
 			root.Flags().CountP("verbose", "v", "Increase verbosity")
 			root.Flags().Bool("unsafe-trace", false, "")
+			root.Flags().String("log-file", "", "")
 
 			_, err := pcmd.ExecuteCommand(rootCmd.Command)
 			require.NoError(t, err)
@@ -671,6 +707,7 @@ func TestInitializeOnPremKafkaRest(t *testing.T) {
 	cobraCmd := &cobra.Command{Use: "test"}
 	cobraCmd.Flags().CountP("verbose", "v", "Increase verbosity")
 	cobraCmd.Flags().Bool("unsafe-trace", false, "")
+	cobraCmd.Flags().String("log-file", "", "")
 	c := pcmd.NewAuthenticatedCLICommand(cobraCmd, r)
 	t.Run("InitializeOnPremKafkaRest_ValidMdsToken", func(t *testing.T) {
 		err := r.InitializeOnPremKafkaRest(c)(c.Command, []string{})
@@ -14,6 +14,7 @@ const (
 	RequireCloudLoginOrOnPremLogin          = "cloud-login-or-on-prem-login"
 	RequireNonAPIKeyCloudLogin              = "non-api-key-cloud-login"
 	RequireNonAPIKeyCloudLoginOrOnPremLogin = "non-api-key-cloud-login-or-on-prem-login"
+	RequireNoLogin                          = "no-login"
 	RequireNonCloudLogin                    = "non-cloud-login"
 	RequireOnPremLogin                      = "on-prem-login"
 )
@@ -26,6 +27,11 @@ func ErrIfMissingRunRequirement(cmd *cobra.Command, cfg *config.Config) error {
 	}
 
 	if requirement, ok := cmd.Annotations[RunRequirement]; ok {
+		// Local commands under a group that requires a login, such as a SQL formatter, don't inherit its requirements.
+		if requirement == RequireNoLogin {
+			return nil
+		}
+
 		var f func() error
 
 		switch requirement {
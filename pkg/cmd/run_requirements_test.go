@@ -120,3 +120,14 @@ func TestErrIfMissingRunRequirement_Subcommand(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, err, config.RequireCloudLoginErr)
 }
+
+func TestErrIfMissingRunRequirement_NoLoginSubcommand(t *testing.T) {
+	a := &cobra.Command{Annotations: map[string]string{RunRequirement: RequireCloudLogin}}
+	b := &cobra.Command{Annotations: map[string]string{RunRequirement: RequireNoLogin}}
+	c := &cobra.Command{}
+	a.AddCommand(b)
+	b.AddCommand(c)
+
+	err := ErrIfMissingRunRequirement(c, noContextCfg)
+	require.NoError(t, err)
+}
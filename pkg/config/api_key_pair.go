@@ -1,9 +1,11 @@
 package config
 
 import (
+	"fmt"
 	"runtime"
 	"strings"
 
+	"github.com/confluentinc/cli/v3/pkg/credentialhelper"
 	"github.com/confluentinc/cli/v3/pkg/secret"
 )
 
@@ -13,9 +15,32 @@ type APIKeyPair struct {
 	Secret string `json:"api_secret,omitempty"`
 	Salt   []byte `json:"salt,omitempty"`
 	Nonce  []byte `json:"nonce,omitempty"`
+	// CredentialHelper is the name of the credential helper which stores the secret, if it is not stored in the config
+	CredentialHelper string `json:"credential_helper,omitempty"`
 }
 
-func (c *APIKeyPair) DecryptSecret() error {
+// DecryptSecret decrypts the secret, or gets it from the credential helper which stores it. The API key is identified to
+// the credential helper by the ID of its resource, like when it was stored.
+func (c *APIKeyPair) DecryptSecret(resource string) error {
+	if c.CredentialHelper != "" {
+		if c.Secret == "" {
+			request := &credentialhelper.Request{
+				Type:     credentialhelper.APIKey,
+				Key:      c.Key,
+				Resource: resource,
+			}
+			response, err := credentialhelper.Get(c.CredentialHelper, request)
+			if err != nil {
+				return err
+			}
+			if response == nil {
+				return fmt.Errorf(`credential helper "%s" has no secret for API key "%s"`, c.CredentialHelper, c.Key)
+			}
+			c.Secret = response.Secret
+		}
+		return nil
+	}
+
 	if (strings.HasPrefix(c.Secret, secret.AesGcm) && c.Salt != nil) || runtime.GOOS == "windows" {
 		decryptedSecret, err := secret.Decrypt(c.Key, c.Secret, c.Salt, c.Nonce)
 		if err != nil {
@@ -27,6 +52,12 @@ func (c *APIKeyPair) DecryptSecret() error {
 }
 
 func (c *APIKeyPair) EncryptSecret() error {
+	// The secret is kept by the credential helper, and must not be written to the config
+	if c.CredentialHelper != "" {
+		c.Secret = ""
+		return nil
+	}
+
 	if c.Salt == nil || c.Nonce == nil {
 		salt, nonce, err := secret.GenerateSaltAndNonce()
 		if err != nil {
@@ -68,6 +68,7 @@ var (
 
 // Whitelist is the configuration fields that are visible by the `config` subcommands.
 var Whitelist = []string{
+	"credential_helper",
 	"disable_feature_flags",
 	"disable_plugins",
 	"disable_update_check",
@@ -84,6 +85,16 @@ type Config struct {
 	DisableUpdates      bool `json:"disable_updates,omitempty"`
 	EnableColor         bool `json:"enable_color"`
 
+	// CredentialHelper is the name of an external credential helper, "confluent-credential-<name>", which stores login
+	// credentials and API secrets instead of the configuration file
+	CredentialHelper string `json:"credential_helper,omitempty"`
+
+	// Encryption protects the secrets in the configuration file with a passphrase or a key file, if set
+	Encryption *EncryptionConfig `json:"encryption,omitempty"`
+
+	// Aliases are user-defined commands, each of which expands to one or more CLI commands
+	Aliases map[string][]string `json:"aliases,omitempty"`
+
 	Platforms        map[string]*Platform        `json:"platforms,omitempty"`
 	Credentials      map[string]*Credential      `json:"credentials,omitempty"`
 	CurrentContext   string                      `json:"current_context"`
@@ -102,10 +113,12 @@ type Config struct {
 	IsTest   bool              `json:"-"`
 	Version  *pversion.Version `json:"-"`
 	Filename string            `json:"-"`
+	Project  *ProjectConfig    `json:"-"`
 
-	overwrittenCurrentContext      string
-	overwrittenCurrentEnvironment  string
-	overwrittenCurrentKafkaCluster string
+	overwrittenCurrentContext          string
+	overwrittenCurrentEnvironment      string
+	overwrittenCurrentKafkaCluster     string
+	overwrittenCurrentFlinkComputePool string
 }
 
 func (c *Config) SetOverwrittenCurrentContext(context string) {
@@ -132,6 +145,15 @@ func (c *Config) SetOverwrittenCurrentKafkaCluster(clusterId string) {
 	}
 }
 
+func (c *Config) SetOverwrittenCurrentFlinkComputePool(computePoolId string) {
+	if computePoolId == "" {
+		computePoolId = emptyFieldIndicator
+	}
+	if c.overwrittenCurrentFlinkComputePool == "" {
+		c.overwrittenCurrentFlinkComputePool = computePoolId
+	}
+}
+
 func New() *Config {
 	return &Config{
 		Platforms:        make(map[string]*Platform),
@@ -163,7 +185,12 @@ func (c *Config) DecryptCredentials() error {
 	if credentials := c.Credentials; c.Credentials != nil {
 		for _, credential := range credentials {
 			if credential.APIKeyPair != nil {
-				if err := credential.APIKeyPair.DecryptSecret(); err != nil {
+				if err := credential.APIKeyPair.DecryptSecret(""); err != nil {
+					return err
+				}
+			}
+			if credential.OAuth != nil {
+				if err := credential.OAuth.DecryptSecret(); err != nil {
 					return err
 				}
 			}
@@ -193,6 +220,10 @@ func (c *Config) Load() error {
 		return fmt.Errorf(errors.UnableToReadConfigurationFileErrorMsg, filename, err)
 	}
 
+	if c.Encryption != nil {
+		secret.SetProtectionKeyFunc(c.UnlockEncryptionKey)
+	}
+
 	for _, context := range c.Contexts {
 		// Some "pre-validation"
 		if context.Name == "" {
@@ -225,12 +256,14 @@ func (c *Config) Load() error {
 
 // Save writes the CLI config to disk.
 func (c *Config) Save() error {
+	tempFlinkComputePool := c.resolveOverwrittenFlinkComputePool()
 	tempKafkaCluster := c.resolveOverwrittenKafkaCluster()
 	tempEnvironment := c.resolveOverwrittenCurrentEnvironment()
 	tempContext := c.resolveOverwrittenContext()
 	var tempAuthToken string
 	var tempAuthRefreshToken string
 	tempCredentials := map[string]string{}
+	tempClientSecrets := map[string]string{}
 
 	if c.Context() != nil {
 		tempAuthToken = c.Context().GetState().AuthToken
@@ -245,12 +278,32 @@ func (c *Config) Save() error {
 			if credential.APIKeyPair != nil {
 				tempCredentials[name] = credential.APIKeyPair.Secret
 			}
+			if credential.OAuth != nil {
+				tempClientSecrets[name] = credential.OAuth.ClientSecret
+			}
 		}
 		if err := c.encryptCredentialsAPISecret(); err != nil {
 			return err
 		}
 	}
 
+	if err := c.write(); err != nil {
+		return err
+	}
+
+	c.restoreOverwrittenContext(tempContext)
+	c.restoreOverwrittenEnvironment(tempEnvironment)
+	c.restoreOverwrittenKafkaCluster(tempKafkaCluster)
+	c.restoreOverwrittenFlinkComputePool(tempFlinkComputePool)
+	c.restoreOverwrittenAuthToken(tempAuthToken)
+	c.restoreOverwrittenAuthRefreshToken(tempAuthRefreshToken)
+	c.restoreOverwrittenCredentials(tempCredentials)
+	c.restoreOverwrittenClientSecrets(tempClientSecrets)
+
+	return nil
+}
+
+func (c *Config) write() error {
 	if err := c.Validate(); err != nil {
 		return err
 	}
@@ -270,13 +323,6 @@ func (c *Config) Save() error {
 		return fmt.Errorf("unable to write config to file %s: %w", filename, err)
 	}
 
-	c.restoreOverwrittenContext(tempContext)
-	c.restoreOverwrittenEnvironment(tempEnvironment)
-	c.restoreOverwrittenKafkaCluster(tempKafkaCluster)
-	c.restoreOverwrittenAuthToken(tempAuthToken)
-	c.restoreOverwrittenAuthRefreshToken(tempAuthRefreshToken)
-	c.restoreOverwrittenCredentials(tempCredentials)
-
 	return nil
 }
 
@@ -288,6 +334,11 @@ func (c *Config) encryptCredentialsAPISecret() error {
 				return err
 			}
 		}
+		if credential.OAuth != nil {
+			if err := credential.OAuth.EncryptSecret(); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -353,6 +404,13 @@ func (c *Config) restoreOverwrittenKafkaCluster(tempKafkaCluster string) {
 	}
 }
 
+// Switch the initial active kafka back into the struct and forget the overwriting value, so that a cluster which is used
+// explicitly is saved
+func (c *Config) dropOverwrittenKafkaCluster() {
+	c.resolveOverwrittenKafkaCluster()
+	c.overwrittenCurrentKafkaCluster = ""
+}
+
 func (c *Config) restoreOverwrittenAuthToken(tempAuthToken string) {
 	if tempAuthToken != "" {
 		c.Context().GetState().AuthToken = tempAuthToken
@@ -373,6 +431,44 @@ func (c *Config) restoreOverwrittenCredentials(tempApiSecrets map[string]string)
 	}
 }
 
+func (c *Config) restoreOverwrittenClientSecrets(tempClientSecrets map[string]string) {
+	for name, secret := range tempClientSecrets {
+		if secret != "" {
+			c.Credentials[name].OAuth.ClientSecret = secret
+		}
+	}
+}
+
+// If the active Flink compute pool has been overwritten by the project configuration, replace it with the previous one
+// Return the overwriting value so that it can be restored after writing the file
+func (c *Config) resolveOverwrittenFlinkComputePool() string {
+	ctx := c.Context()
+	var tempComputePool string
+	if c.overwrittenCurrentFlinkComputePool != "" && ctx != nil && ctx.GetCurrentEnvironmentContext() != nil {
+		computePool := c.overwrittenCurrentFlinkComputePool
+		if computePool == emptyFieldIndicator {
+			computePool = ""
+		}
+		tempComputePool = ctx.GetCurrentFlinkComputePool()
+		_ = ctx.SetCurrentFlinkComputePool(computePool)
+	}
+	return tempComputePool
+}
+
+// Restore the overwriting compute pool back into the struct so that it is used for any execution after Save()
+func (c *Config) restoreOverwrittenFlinkComputePool(tempComputePool string) {
+	if tempComputePool != "" {
+		_ = c.Context().SetCurrentFlinkComputePool(tempComputePool)
+	}
+}
+
+// Switch the initial compute pool back into the struct and forget the overwriting value, so that a compute pool which is
+// used explicitly is saved
+func (c *Config) dropOverwrittenFlinkComputePool() {
+	c.resolveOverwrittenFlinkComputePool()
+	c.overwrittenCurrentFlinkComputePool = ""
+}
+
 // Switch the initial config context back into the struct so that it is saved and not the flag value
 // Return the overwriting flag context value so that it can be restored after writing the file
 func (c *Config) resolveOverwrittenContext() string {
@@ -394,6 +490,13 @@ func (c *Config) restoreOverwrittenContext(tempContext string) {
 	}
 }
 
+// Switch the initial config context back into the struct and forget the overwriting value, so that a context which is
+// used explicitly is saved
+func (c *Config) dropOverwrittenContext() {
+	c.resolveOverwrittenContext()
+	c.overwrittenCurrentContext = ""
+}
+
 // Switch the initial config account back into the struct so that it is saved and not the flag value
 // Return the overwriting flag account value so that it can be restored after writing the file
 func (c *Config) resolveOverwrittenCurrentEnvironment() string {
@@ -412,6 +515,13 @@ func (c *Config) restoreOverwrittenEnvironment(id string) {
 	}
 }
 
+// Switch the initial config account back into the struct and forget the overwriting value, so that an environment which
+// is used explicitly is saved
+func (c *Config) dropOverwrittenEnvironment() {
+	c.resolveOverwrittenCurrentEnvironment()
+	c.overwrittenCurrentEnvironment = ""
+}
+
 func (c *Config) Validate() error {
 	// Validate that current context exists.
 	if c.CurrentContext != "" {
@@ -551,15 +661,48 @@ func (c *Config) CreateContext(name, bootstrapURL, apiKey, apiSecret string) err
 	return c.AddContext(name, platform.Name, credential.Name, kafkaClusters, kafkaClusterCfg.ID, nil, "", "")
 }
 
-// UseContext sets the current context, if it exists.
+// UseContext sets the current context, if it exists. Values overwritten by flags or the project configuration are
+// dropped first, so that the context is saved even if the project configuration pins another one.
 func (c *Config) UseContext(name string) error {
 	if _, err := c.FindContext(name); err != nil {
 		return err
 	}
+	c.dropOverwrittenFlinkComputePool()
+	c.dropOverwrittenKafkaCluster()
+	c.dropOverwrittenEnvironment()
+	c.dropOverwrittenContext()
 	c.CurrentContext = name
 	return c.Save()
 }
 
+// UseEnvironment sets and saves the current environment of the current context, even if the project configuration pins
+// another one.
+func (c *Config) UseEnvironment(id string) error {
+	c.dropOverwrittenFlinkComputePool()
+	c.dropOverwrittenKafkaCluster()
+	c.dropOverwrittenEnvironment()
+	c.Context().SetCurrentEnvironment(id)
+	return c.Save()
+}
+
+// UseKafkaCluster sets and saves the active Kafka cluster of the current environment, even if the project configuration
+// pins another one.
+func (c *Config) UseKafkaCluster(id string) error {
+	c.dropOverwrittenKafkaCluster()
+	c.Context().KafkaClusterContext.SetActiveKafkaCluster(id)
+	return c.Save()
+}
+
+// UseFlinkComputePool sets and saves the current Flink compute pool of the current environment, even if the project
+// configuration pins another one.
+func (c *Config) UseFlinkComputePool(id string) error {
+	c.dropOverwrittenFlinkComputePool()
+	if err := c.Context().SetCurrentFlinkComputePool(id); err != nil {
+		return err
+	}
+	return c.Save()
+}
+
 func (c *Config) SaveCredential(credential *Credential) error {
 	if credential.Name == "" {
 		return fmt.Errorf("credential must have a name")
@@ -435,7 +435,14 @@ func printApiKeysDictErrorMessage(missingKey, mismatchKey, missingSecret bool, c
 	output.ErrPrintf(false, "You can re-add the API key pair with `confluent api-key store --resource %s`\n", cluster.ID)
 }
 
+// ParseFlagsIntoContext overwrites the current environment, Kafka cluster, and Flink settings with flag values, or
+// otherwise with the values pinned by the project configuration, without persisting them.
 func (c *Context) ParseFlagsIntoContext(cmd *cobra.Command) error {
+	project := new(ProjectConfig)
+	if c != nil && c.Config != nil && c.Config.Project != nil {
+		project = c.Config.Project
+	}
+
 	if environment, _ := cmd.Flags().GetString("environment"); environment != "" {
 		if c.GetCredentialType() == APIKey {
 			output.ErrPrintln(c.Config.EnableColor, "[WARN] The `--environment` flag is ignored when using API key credentials.")
@@ -443,6 +450,9 @@ func (c *Context) ParseFlagsIntoContext(cmd *cobra.Command) error {
 			c.Config.SetOverwrittenCurrentEnvironment(c.CurrentEnvironment)
 			c.SetCurrentEnvironment(environment)
 		}
+	} else if project.Environment != "" && c.GetCredentialType() != APIKey {
+		c.Config.SetOverwrittenCurrentEnvironment(c.CurrentEnvironment)
+		c.SetCurrentEnvironment(project.Environment)
 	}
 
 	if cluster, _ := cmd.Flags().GetString("cluster"); cluster != "" {
@@ -452,12 +462,20 @@ func (c *Context) ParseFlagsIntoContext(cmd *cobra.Command) error {
 			c.Config.SetOverwrittenCurrentKafkaCluster(c.KafkaClusterContext.GetActiveKafkaClusterId())
 			c.KafkaClusterContext.SetActiveKafkaCluster(cluster)
 		}
+	} else if project.KafkaCluster != "" && c.GetCredentialType() != APIKey {
+		c.Config.SetOverwrittenCurrentKafkaCluster(c.KafkaClusterContext.GetActiveKafkaClusterId())
+		c.KafkaClusterContext.SetActiveKafkaCluster(project.KafkaCluster)
 	}
 
 	if computePool, _ := cmd.Flags().GetString("compute-pool"); computePool != "" {
 		if err := c.SetCurrentFlinkComputePool(computePool); err != nil {
 			return err
 		}
+	} else if project.FlinkComputePool != "" && c.GetCurrentEnvironmentContext() != nil {
+		c.Config.SetOverwrittenCurrentFlinkComputePool(c.GetCurrentFlinkComputePool())
+		if err := c.SetCurrentFlinkComputePool(project.FlinkComputePool); err != nil {
+			return err
+		}
 	}
 
 	if region, _ := cmd.Flags().GetString("region"); region != "" {
@@ -0,0 +1,354 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"filippo.io/age"
+
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/secret"
+)
+
+const contextExportVersion = 1
+
+// How to import a context whose name is already taken
+const (
+	ImportConflictMerge  = "merge"
+	ImportConflictRename = "rename"
+	ImportConflictSkip   = "skip"
+)
+
+var ImportConflictStrategies = []string{ImportConflictMerge, ImportConflictRename, ImportConflictSkip}
+
+// ContextExport is a portable file holding one or more contexts, along with their platforms and credentials. It never
+// holds login state. API secrets are stripped, unless they are encrypted to the age X25519 recipient of the importer, as
+// an ASCII-armored age file.
+type ContextExport struct {
+	Version  int                `json:"version"`
+	Contexts []*ExportedContext `json:"contexts"`
+	Secrets  string             `json:"secrets,omitempty"`
+}
+
+type ExportedContext struct {
+	*Context
+	Platform   *Platform   `json:"platform_info"`
+	Credential *Credential `json:"credential_info"`
+}
+
+// ImportedContext describes what happened to an exported context when it was imported.
+type ImportedContext struct {
+	Name          string
+	ImportedAs    string
+	Action        string
+	KafkaClusters int
+}
+
+// ExportContexts copies contexts into a portable export. If a recipient is given, API secrets are included, encrypted to
+// that recipient.
+func (c *Config) ExportContexts(names []string, recipient string) (*ContextExport, error) {
+	export := &ContextExport{Version: contextExportVersion}
+	secrets := map[string]string{}
+
+	for _, name := range names {
+		ctx, err := c.FindContext(name)
+		if err != nil {
+			return nil, err
+		}
+
+		exported := new(ExportedContext)
+		if err := copyJson(ctx, &exported.Context); err != nil {
+			return nil, err
+		}
+		if err := copyJson(ctx.Platform, &exported.Platform); err != nil {
+			return nil, err
+		}
+		if err := copyJson(ctx.Credential, &exported.Credential); err != nil {
+			return nil, err
+		}
+
+		// Feature flags are cached per machine, and login state is never exported
+		exported.FeatureFlags = nil
+		exported.NetrcMachineName = ""
+		exported.Credential.Password = ""
+		if oauth := exported.Credential.OAuth; oauth != nil {
+			oauth.ClientSecret = ""
+			oauth.Salt = nil
+			oauth.Nonce = nil
+		}
+
+		for pair, resource := range exported.getAPIKeyPairs() {
+			if recipient != "" {
+				if err := pair.DecryptSecret(resource); err != nil {
+					return nil, err
+				}
+				secrets[pair.Key] = pair.Secret
+			}
+			pair.Secret = ""
+			pair.Salt = nil
+			pair.Nonce = nil
+			pair.CredentialHelper = ""
+		}
+
+		export.Contexts = append(export.Contexts, exported)
+	}
+
+	if recipient != "" && len(secrets) > 0 {
+		data, err := json.Marshal(secrets)
+		if err != nil {
+			return nil, err
+		}
+		export.Secrets, err = secret.EncryptToRecipient(recipient, data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return export, nil
+}
+
+// ImportContexts adds the contexts of an export to the configuration. The API secrets of the export are decrypted with
+// an identity, if one is given; otherwise, API keys without secrets are dropped. If a context with the same name already
+// exists, it is merged with, renamed, or skipped according to the conflict strategy.
+func (c *Config) ImportContexts(export *ContextExport, identity *age.X25519Identity, conflict string) ([]*ImportedContext, error) {
+	if export.Version != contextExportVersion {
+		return nil, fmt.Errorf("unsupported context export version %d", export.Version)
+	}
+	if !slices.Contains(ImportConflictStrategies, conflict) {
+		return nil, fmt.Errorf(`invalid conflict strategy "%s"`, conflict)
+	}
+
+	secrets := map[string]string{}
+	if export.Secrets != "" && identity != nil {
+		data, err := secret.DecryptWithIdentity(identity, export.Secrets)
+		if err != nil {
+			return nil, errors.NewErrorWithSuggestions(err.Error(), "Pass the key file of the age recipient which the contexts were exported to.")
+		}
+		if err := json.Unmarshal(data, &secrets); err != nil {
+			return nil, err
+		}
+	}
+
+	var imported []*ImportedContext
+	for _, exported := range export.Contexts {
+		if exported.Context == nil || exported.Name == "" || exported.Platform == nil || exported.Credential == nil || exported.KafkaClusterContext == nil {
+			return nil, fmt.Errorf("malformed context export")
+		}
+		if err := exported.restoreSecrets(secrets); err != nil {
+			return nil, err
+		}
+
+		result := &ImportedContext{Name: exported.Name, ImportedAs: exported.Name}
+		existing, ok := c.Contexts[exported.Name]
+		switch {
+		case !ok:
+			result.Action = "created"
+		case conflict == ImportConflictSkip:
+			result.Action = "skipped"
+			imported = append(imported, result)
+			continue
+		case conflict == ImportConflictRename:
+			result.ImportedAs = c.availableContextName(exported.Name)
+			result.Action = "created"
+		default:
+			added, err := existing.merge(exported)
+			if err != nil {
+				return nil, err
+			}
+			result.Action = "merged"
+			result.KafkaClusters = added
+			imported = append(imported, result)
+			continue
+		}
+
+		result.KafkaClusters = c.addExportedContext(exported, result.ImportedAs)
+		imported = append(imported, result)
+	}
+
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	if err := c.Save(); err != nil {
+		return nil, err
+	}
+
+	return imported, nil
+}
+
+func (c *Config) addExportedContext(exported *ExportedContext, name string) int {
+	if _, ok := c.Platforms[exported.Platform.Name]; !ok {
+		c.Platforms[exported.Platform.Name] = exported.Platform
+	}
+	if _, ok := c.Credentials[exported.Credential.Name]; !ok {
+		c.Credentials[exported.Credential.Name] = exported.Credential
+	}
+
+	ctx := exported.Context
+	ctx.Name = name
+	ctx.NetrcMachineName = name
+	ctx.Platform = c.Platforms[ctx.PlatformName]
+	ctx.Credential = c.Credentials[ctx.CredentialName]
+	ctx.State = new(ContextState)
+	ctx.Config = c
+	ctx.KafkaClusterContext.Context = ctx
+	if ctx.Environments == nil {
+		ctx.Environments = map[string]*EnvironmentContext{}
+	}
+
+	c.Contexts[name] = ctx
+	c.ContextStates[name] = ctx.State
+
+	return len(ctx.KafkaClusterContext.getKafkaClusterConfigs())
+}
+
+func (c *Config) availableContextName(name string) string {
+	for i := 2; ; i++ {
+		candidate := fmt.Sprintf("%s-%d", name, i)
+		if _, ok := c.Contexts[candidate]; !ok {
+			return candidate
+		}
+	}
+}
+
+// merge adds the environments and Kafka clusters of an exported context which are missing from a context, and returns
+// the number of Kafka clusters added. Existing settings are never changed.
+func (c *Context) merge(exported *ExportedContext) (int, error) {
+	if c.PlatformName != exported.PlatformName || c.KafkaClusterContext.EnvContext != exported.KafkaClusterContext.EnvContext {
+		return 0, errors.NewErrorWithSuggestions(
+			fmt.Sprintf(`cannot merge into context "%s", which logs in to a different platform or with a different type of credential`, c.Name),
+			"Import it under a new name with `--conflict rename`.",
+		)
+	}
+
+	for id, environment := range exported.Environments {
+		if c.Environments == nil {
+			c.Environments = map[string]*EnvironmentContext{}
+		}
+		if _, ok := c.Environments[id]; !ok {
+			c.Environments[id] = environment
+		}
+	}
+
+	added := 0
+	mergeClusters := func(clusters, exportedClusters map[string]*KafkaClusterConfig) {
+		for id, cluster := range exportedClusters {
+			existing, ok := clusters[id]
+			if !ok {
+				clusters[id] = cluster
+				added++
+				continue
+			}
+			for key, pair := range cluster.APIKeys {
+				if existing.APIKeys == nil {
+					existing.APIKeys = map[string]*APIKeyPair{}
+				}
+				if _, ok := existing.APIKeys[key]; !ok {
+					existing.APIKeys[key] = pair
+				}
+			}
+		}
+	}
+
+	kafka := c.KafkaClusterContext
+	if kafka.EnvContext {
+		for id, exportedEnv := range exported.KafkaClusterContext.KafkaEnvContexts {
+			if kafka.KafkaEnvContexts == nil {
+				kafka.KafkaEnvContexts = map[string]*KafkaEnvContext{}
+			}
+			env, ok := kafka.KafkaEnvContexts[id]
+			if !ok {
+				env = &KafkaEnvContext{ActiveKafkaCluster: exportedEnv.ActiveKafkaCluster}
+				kafka.KafkaEnvContexts[id] = env
+			}
+			if env.KafkaClusterConfigs == nil {
+				env.KafkaClusterConfigs = map[string]*KafkaClusterConfig{}
+			}
+			mergeClusters(env.KafkaClusterConfigs, exportedEnv.KafkaClusterConfigs)
+		}
+	} else {
+		if kafka.KafkaClusterConfigs == nil {
+			kafka.KafkaClusterConfigs = map[string]*KafkaClusterConfig{}
+		}
+		if kafka.ActiveKafkaCluster == "" {
+			kafka.ActiveKafkaCluster = exported.KafkaClusterContext.ActiveKafkaCluster
+		}
+		mergeClusters(kafka.KafkaClusterConfigs, exported.KafkaClusterContext.KafkaClusterConfigs)
+	}
+
+	return added, nil
+}
+
+// getAPIKeyPairs returns the API key pairs of an exported context's credential and Kafka clusters, along with the ID of
+// the Kafka cluster of each.
+func (e *ExportedContext) getAPIKeyPairs() map[*APIKeyPair]string {
+	pairs := make(map[*APIKeyPair]string)
+	if e.Credential != nil && e.Credential.APIKeyPair != nil {
+		pairs[e.Credential.APIKeyPair] = ""
+	}
+	if e.KafkaClusterContext != nil {
+		for _, cluster := range e.KafkaClusterContext.getKafkaClusterConfigs() {
+			for _, pair := range cluster.APIKeys {
+				pairs[pair] = cluster.ID
+			}
+		}
+	}
+	return pairs
+}
+
+// restoreSecrets fills in the API secrets of an exported context, and drops the API keys of its Kafka clusters which
+// have no secret, since they cannot be used.
+func (e *ExportedContext) restoreSecrets(secrets map[string]string) error {
+	if pair := e.Credential.APIKeyPair; pair != nil {
+		pair.Secret = secrets[pair.Key]
+	}
+
+	for _, cluster := range e.KafkaClusterContext.getKafkaClusterConfigs() {
+		for key, pair := range cluster.APIKeys {
+			if pair == nil || secrets[key] == "" {
+				delete(cluster.APIKeys, key)
+				continue
+			}
+			pair.Secret = secrets[key]
+			if err := pair.EncryptSecret(); err != nil {
+				return err
+			}
+		}
+		if _, ok := cluster.APIKeys[cluster.APIKey]; !ok {
+			cluster.APIKey = ""
+		}
+		if cluster.APIKeys == nil {
+			cluster.APIKeys = map[string]*APIKeyPair{}
+		}
+	}
+
+	return nil
+}
+
+// getKafkaClusterConfigs returns the Kafka clusters of every environment of a Kafka cluster context.
+func (k *KafkaClusterContext) getKafkaClusterConfigs() []*KafkaClusterConfig {
+	var clusters []*KafkaClusterConfig
+	for _, cluster := range k.KafkaClusterConfigs {
+		if cluster != nil {
+			clusters = append(clusters, cluster)
+		}
+	}
+	for _, env := range k.KafkaEnvContexts {
+		if env != nil {
+			for _, cluster := range env.KafkaClusterConfigs {
+				if cluster != nil {
+					clusters = append(clusters, cluster)
+				}
+			}
+		}
+	}
+	return clusters
+}
+
+func copyJson(from, to any) error {
+	data, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, to)
+}
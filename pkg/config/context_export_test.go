@@ -0,0 +1,122 @@
+package config
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"filippo.io/age"
+	"github.com/stretchr/testify/require"
+
+	"github.com/confluentinc/cli/v3/pkg/secret"
+)
+
+func newContextExportTestIdentity(t *testing.T) (*age.X25519Identity, string) {
+	data, err := secret.GenerateIdentity()
+	require.NoError(t, err)
+	identity, err := secret.ParseIdentity(data)
+	require.NoError(t, err)
+	return identity, identity.Recipient().String()
+}
+
+func newContextImportTestConfig(t *testing.T) *Config {
+	cfg := New()
+	cfg.Filename = filepath.Join(t.TempDir(), "config.json")
+	cfg.IsTest = true
+	return cfg
+}
+
+func exportContexts(t *testing.T, recipient string) *ContextExport {
+	cfg := SetupTestInputs(true).statefulConfig
+	cfg.Filename = filepath.Join(t.TempDir(), "config.json")
+
+	export, err := cfg.ExportContexts([]string{contextName}, recipient)
+	require.NoError(t, err)
+
+	// Round trip the export through its file format
+	data, err := json.Marshal(export)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), apiSecretString)
+	require.NotContains(t, string(data), "eyJ.eyJ.abc")
+
+	export = new(ContextExport)
+	require.NoError(t, json.Unmarshal(data, export))
+	return export
+}
+
+func TestConfig_ExportContexts(t *testing.T) {
+	cfg := SetupTestInputs(true).statefulConfig
+
+	_, err := cfg.ExportContexts([]string{"missing"}, "")
+	require.Error(t, err)
+
+	export, err := cfg.ExportContexts([]string{contextName}, "")
+	require.NoError(t, err)
+	require.Empty(t, export.Secrets)
+	require.Len(t, export.Contexts, 1)
+	require.Equal(t, cfg.Contexts[contextName].PlatformName, export.Contexts[0].Platform.Name)
+	require.Equal(t, "test-user", export.Contexts[0].Credential.Username)
+
+	// The configuration is not changed
+	require.Equal(t, apiSecretString, cfg.Credentials["api-key-abc-key-123"].APIKeyPair.Secret)
+}
+
+func TestConfig_ImportContexts(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		return
+	}
+
+	identity, recipient := newContextExportTestIdentity(t)
+	cfg := newContextImportTestConfig(t)
+
+	imported, err := cfg.ImportContexts(exportContexts(t, recipient), identity, ImportConflictMerge)
+	require.NoError(t, err)
+	require.Equal(t, []*ImportedContext{{Name: contextName, ImportedAs: contextName, Action: "created", KafkaClusters: 1}}, imported)
+
+	ctx := cfg.Contexts[contextName]
+	require.NotNil(t, ctx)
+	require.Empty(t, ctx.GetState().AuthToken)
+
+	cluster := ctx.KafkaClusterContext.KafkaEnvContexts[environmentId].KafkaClusterConfigs[kafkaClusterID]
+	require.NoError(t, cluster.DecryptAPIKeys())
+	require.Equal(t, apiSecretString, cluster.GetApiSecret())
+
+	// Merge a cluster which is missing from the existing context
+	delete(ctx.KafkaClusterContext.KafkaEnvContexts[environmentId].KafkaClusterConfigs, kafkaClusterID)
+	imported, err = cfg.ImportContexts(exportContexts(t, recipient), identity, ImportConflictMerge)
+	require.NoError(t, err)
+	require.Equal(t, []*ImportedContext{{Name: contextName, ImportedAs: contextName, Action: "merged", KafkaClusters: 1}}, imported)
+
+	imported, err = cfg.ImportContexts(exportContexts(t, recipient), identity, ImportConflictMerge)
+	require.NoError(t, err)
+	require.Equal(t, []*ImportedContext{{Name: contextName, ImportedAs: contextName, Action: "merged"}}, imported)
+
+	imported, err = cfg.ImportContexts(exportContexts(t, recipient), identity, ImportConflictRename)
+	require.NoError(t, err)
+	require.Equal(t, []*ImportedContext{{Name: contextName, ImportedAs: contextName + "-2", Action: "created", KafkaClusters: 1}}, imported)
+
+	imported, err = cfg.ImportContexts(exportContexts(t, recipient), identity, ImportConflictSkip)
+	require.NoError(t, err)
+	require.Equal(t, []*ImportedContext{{Name: contextName, ImportedAs: contextName, Action: "skipped"}}, imported)
+
+	_, err = cfg.ImportContexts(exportContexts(t, recipient), identity, "overwrite")
+	require.EqualError(t, err, `invalid conflict strategy "overwrite"`)
+}
+
+func TestConfig_ImportContexts_WithoutSecrets(t *testing.T) {
+	_, recipient := newContextExportTestIdentity(t)
+	otherIdentity, _ := newContextExportTestIdentity(t)
+
+	cfg := newContextImportTestConfig(t)
+	_, err := cfg.ImportContexts(exportContexts(t, recipient), otherIdentity, ImportConflictMerge)
+	require.ErrorContains(t, err, "the identity does not match the recipient the data was encrypted to")
+
+	// API keys without secrets are dropped
+	_, err = cfg.ImportContexts(exportContexts(t, recipient), nil, ImportConflictMerge)
+	require.NoError(t, err)
+
+	cluster := cfg.Contexts[contextName].KafkaClusterContext.KafkaEnvContexts[environmentId].KafkaClusterConfigs[kafkaClusterID]
+	require.Empty(t, cluster.APIKeys)
+	require.Empty(t, cluster.APIKey)
+}
@@ -9,4 +9,9 @@ type Credential struct {
 	Username       string         `json:"username"`
 	APIKeyPair     *APIKeyPair    `json:"api_key_pair"`
 	CredentialType CredentialType `json:"credential_type"`
+	// OAuth is set if the credential logs in to Confluent Platform as an OAuth 2.0 client
+	OAuth *OAuthConfig `json:"oauth,omitempty"`
+	// ClientCertPath and ClientKeyPath are set if the credential logs in to Confluent Platform with a client certificate
+	ClientCertPath string `json:"client_cert_path,omitempty"`
+	ClientKeyPath  string `json:"client_key_path,omitempty"`
 }
@@ -0,0 +1,291 @@
+package config
+
+import (
+	"crypto/rand"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"filippo.io/age"
+	"golang.org/x/term"
+
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/log"
+	"github.com/confluentinc/cli/v3/pkg/output"
+	"github.com/confluentinc/cli/v3/pkg/secret"
+)
+
+const (
+	ConfigPassphraseEnvVar = "CONFLUENT_CONFIG_PASSPHRASE"
+
+	agentDirname        = "agent"
+	agentSocketFilename = "agent.sock"
+	encryptionSaltSize  = 32
+
+	// A known value which is encrypted with the protection key, so that a passphrase can be checked before it is used
+	encryptionVerifierUsername = "verifier"
+	encryptionVerifierValue    = "confluent"
+)
+
+var (
+	ConfigLockedErr = errors.NewErrorWithSuggestions(
+		"the configuration file is protected by a passphrase and cannot be unlocked without a terminal",
+		fmt.Sprintf("Unlock the configuration file for this session with `confluent configuration encryption unlock`, or set the passphrase in the `%s` environment variable.", ConfigPassphraseEnvVar),
+	)
+	IncorrectPassphraseErr = errors.NewErrorWithSuggestions(
+		"incorrect passphrase for the configuration file",
+		"If you forgot the passphrase, log in again after deleting the configuration file.",
+	)
+)
+
+// EncryptionConfig describes how the key which protects the secrets in the configuration file is unlocked: derived from
+// a passphrase, or unwrapped with the age X25519 identity in a key file.
+type EncryptionConfig struct {
+	KeyFile    string `json:"key_file,omitempty"`
+	WrappedKey string `json:"wrapped_key,omitempty"`
+	Salt       []byte `json:"salt,omitempty"`
+	Verifier   string `json:"verifier"`
+}
+
+// NewEncryptionConfig returns an encryption configuration with a new protection key, which is derived from the
+// passphrase with a new salt, or generated and wrapped for the identity in the key file.
+func NewEncryptionConfig(passphrase, keyFile string) (*EncryptionConfig, []byte, error) {
+	e := &EncryptionConfig{KeyFile: keyFile}
+
+	if keyFile == "" {
+		e.Salt = make([]byte, encryptionSaltSize)
+		if _, err := rand.Read(e.Salt); err != nil {
+			return nil, nil, err
+		}
+	} else {
+		identity, err := e.readIdentity()
+		if err != nil {
+			return nil, nil, err
+		}
+		key, err := secret.GenerateProtectionKey()
+		if err != nil {
+			return nil, nil, err
+		}
+		e.WrappedKey, err = secret.WrapProtectionKey(identity, key)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	key, err := e.DeriveKey(passphrase)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	e.Verifier, err = secret.EncryptWithKey(key, encryptionVerifierUsername, encryptionVerifierValue)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return e, key, nil
+}
+
+// DeriveKey returns the protection key, unwrapped with the identity in the key file if there is one, or else derived
+// from a passphrase.
+func (e *EncryptionConfig) DeriveKey(passphrase string) ([]byte, error) {
+	if e.KeyFile != "" {
+		identity, err := e.readIdentity()
+		if err != nil {
+			return nil, err
+		}
+		key, err := secret.UnwrapProtectionKey(identity, e.WrappedKey)
+		if err != nil {
+			return nil, fmt.Errorf(`key file "%s" does not unlock the configuration file: %w`, e.KeyFile, err)
+		}
+		return key, nil
+	}
+
+	return secret.DeriveProtectionKeyFromPassphrase(passphrase, e.Salt)
+}
+
+func (e *EncryptionConfig) readIdentity() (*age.X25519Identity, error) {
+	data, err := os.ReadFile(e.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+	identity, err := secret.ParseIdentity(data)
+	if err != nil {
+		return nil, fmt.Errorf(`invalid key file "%s": %w`, e.KeyFile, err)
+	}
+	return identity, nil
+}
+
+// Verify checks that a key is the protection key.
+func (e *EncryptionConfig) Verify(key []byte) error {
+	value, err := secret.DecryptWithKey(key, encryptionVerifierUsername, e.Verifier)
+	if err != nil || value != encryptionVerifierValue {
+		if e.KeyFile != "" {
+			return fmt.Errorf(`key file "%s" does not unlock the configuration file`, e.KeyFile)
+		}
+		return IncorrectPassphraseErr
+	}
+	return nil
+}
+
+// GetAgentSocket returns the path of the socket of the agent which caches the protection key for a session.
+func (c *Config) GetAgentSocket() string {
+	return filepath.Join(filepath.Dir(c.GetFilename()), agentDirname, agentSocketFilename)
+}
+
+// UnlockEncryptionKey returns the protection key, trying the session agent, the key file, the passphrase environment
+// variable, and finally a passphrase prompt on the terminal, in that order.
+func (c *Config) UnlockEncryptionKey() ([]byte, error) {
+	return c.UnlockEncryptionKeyWithPrompt(promptPassphrase)
+}
+
+// UnlockEncryptionKeyWithPrompt is UnlockEncryptionKey with a custom passphrase prompt.
+func (c *Config) UnlockEncryptionKeyWithPrompt(prompt func() (string, error)) ([]byte, error) {
+	if key, err := secret.GetKeyFromAgent(c.GetAgentSocket()); err == nil && c.Encryption.Verify(key) == nil {
+		log.CliLogger.Trace("Unlocked the configuration file with the key cached by the agent")
+		return key, nil
+	}
+
+	var passphrase string
+	if c.Encryption.KeyFile == "" {
+		passphrase = os.Getenv(ConfigPassphraseEnvVar)
+		if passphrase == "" {
+			var err error
+			passphrase, err = prompt()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	key, err := c.Encryption.DeriveKey(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Encryption.Verify(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func promptPassphrase() (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return "", ConfigLockedErr
+	}
+
+	output.ErrPrint(false, "Configuration passphrase: ")
+	passphrase, err := term.ReadPassword(fd)
+	output.ErrPrintln(false, "")
+	if err != nil {
+		return "", err
+	}
+	return string(passphrase), nil
+}
+
+// SetEncryption re-encrypts every secret in the configuration file. Secrets are protected by a key unlocked from a
+// passphrase or a key file if encryption is non-nil, or by a key derived from the machine otherwise. The configuration
+// file is written immediately, so that it never holds a mix of both.
+func (c *Config) SetEncryption(encryption *EncryptionConfig, key []byte) error {
+	secrets := c.getStoredSecrets()
+
+	for _, s := range secrets {
+		if !isEncrypted(*s.value) {
+			continue
+		}
+		plaintext, err := secret.Decrypt(s.username, *s.value, *s.salt, *s.nonce)
+		if err != nil {
+			return err
+		}
+		*s.value = plaintext
+	}
+
+	c.Encryption = encryption
+	secret.SetProtectionKey(key)
+
+	for _, s := range secrets {
+		if *s.value == "" {
+			continue
+		}
+		if *s.salt == nil || *s.nonce == nil {
+			salt, nonce, err := secret.GenerateSaltAndNonce()
+			if err != nil {
+				return err
+			}
+			*s.salt = salt
+			*s.nonce = nonce
+		}
+		encrypted, err := secret.Encrypt(s.username, *s.value, *s.salt, *s.nonce)
+		if err != nil {
+			return err
+		}
+		*s.value = encrypted
+	}
+
+	return c.write()
+}
+
+// storedSecret is a secret in the configuration file, with the values used to encrypt it.
+type storedSecret struct {
+	username string
+	value    *string
+	salt     *[]byte
+	nonce    *[]byte
+}
+
+func (c *Config) getStoredSecrets() []*storedSecret {
+	var secrets []*storedSecret
+
+	addAPIKeyPair := func(pair *APIKeyPair) {
+		// Secrets kept by a credential helper are not in the configuration file
+		if pair != nil && pair.CredentialHelper == "" {
+			secrets = append(secrets, &storedSecret{username: pair.Key, value: &pair.Secret, salt: &pair.Salt, nonce: &pair.Nonce})
+		}
+	}
+	addKafkaClusterConfigs := func(configs map[string]*KafkaClusterConfig) {
+		for _, config := range configs {
+			if config != nil {
+				for _, pair := range config.APIKeys {
+					addAPIKeyPair(pair)
+				}
+			}
+		}
+	}
+
+	for _, credential := range c.Credentials {
+		addAPIKeyPair(credential.APIKeyPair)
+		if oauth := credential.OAuth; oauth != nil {
+			secrets = append(secrets, &storedSecret{username: oauth.ClientId, value: &oauth.ClientSecret, salt: &oauth.Salt, nonce: &oauth.Nonce})
+		}
+	}
+	for name, state := range c.ContextStates {
+		if state != nil {
+			secrets = append(secrets,
+				&storedSecret{username: name, value: &state.AuthToken, salt: &state.Salt, nonce: &state.Nonce},
+				&storedSecret{username: name, value: &state.AuthRefreshToken, salt: &state.Salt, nonce: &state.Nonce},
+			)
+		}
+	}
+	for _, loginCredential := range c.SavedCredentials {
+		if loginCredential != nil {
+			secrets = append(secrets, &storedSecret{username: loginCredential.Username, value: &loginCredential.EncryptedPassword, salt: &loginCredential.Salt, nonce: &loginCredential.Nonce})
+		}
+	}
+	for _, context := range c.Contexts {
+		if context.KafkaClusterContext != nil {
+			addKafkaClusterConfigs(context.KafkaClusterContext.KafkaClusterConfigs)
+			for _, envContext := range context.KafkaClusterContext.KafkaEnvContexts {
+				if envContext != nil {
+					addKafkaClusterConfigs(envContext.KafkaClusterConfigs)
+				}
+			}
+		}
+	}
+
+	return secrets
+}
+
+func isEncrypted(value string) bool {
+	return strings.HasPrefix(value, secret.AesGcm) || (runtime.GOOS == "windows" && value != "")
+}
@@ -0,0 +1,144 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/confluentinc/cli/v3/pkg/secret"
+)
+
+func loadEncryptionTestConfig(t *testing.T, filename string) *Config {
+	cfg := New()
+	cfg.Filename = filename
+	require.NoError(t, cfg.Load())
+	require.NoError(t, cfg.DecryptCredentials())
+	require.NoError(t, cfg.DecryptContextStates())
+	return cfg
+}
+
+func TestConfig_SetEncryption(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		return
+	}
+	t.Cleanup(func() { secret.SetProtectionKey(nil) })
+
+	cfg := SetupTestInputs(true).statefulConfig
+	cfg.Filename = filepath.Join(t.TempDir(), "config.json")
+	require.NoError(t, cfg.Save())
+
+	// Enable encryption with a passphrase
+	cfg = loadEncryptionTestConfig(t, cfg.Filename)
+	encryption, key, err := NewEncryptionConfig("passphrase", "")
+	require.NoError(t, err)
+	require.NoError(t, cfg.SetEncryption(encryption, key))
+
+	data, err := os.ReadFile(cfg.Filename)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), apiSecretString)
+	require.NotContains(t, string(data), "encrypted-password")
+	require.Equal(t, 6, strings.Count(string(data), secret.AesGcmProtected))
+
+	// Unlock with the passphrase in the environment
+	t.Setenv(ConfigPassphraseEnvVar, "passphrase")
+	cfg = loadEncryptionTestConfig(t, cfg.Filename)
+	require.Equal(t, apiSecretString, cfg.Credentials["api-key-abc-key-123"].APIKeyPair.Secret)
+	require.Equal(t, "eyJ.eyJ.abc", cfg.Context().GetState().AuthToken)
+
+	password, err := secret.Decrypt("test-user", cfg.SavedCredentials[contextName].EncryptedPassword, cfg.SavedCredentials[contextName].Salt, cfg.SavedCredentials[contextName].Nonce)
+	require.NoError(t, err)
+	require.Equal(t, "encrypted-password", password)
+
+	// Disable encryption
+	require.NoError(t, cfg.SetEncryption(nil, nil))
+	require.False(t, secret.IsProtectionEnabled())
+
+	data, err = os.ReadFile(cfg.Filename)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), secret.AesGcmProtected)
+	require.NotContains(t, string(data), `"encryption"`)
+
+	cfg = loadEncryptionTestConfig(t, cfg.Filename)
+	require.Equal(t, apiSecretString, cfg.Credentials["api-key-abc-key-123"].APIKeyPair.Secret)
+}
+
+func TestConfig_SetEncryption_Rekey(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		return
+	}
+	t.Cleanup(func() { secret.SetProtectionKey(nil) })
+
+	cfg := SetupTestInputs(true).statefulConfig
+	cfg.Filename = filepath.Join(t.TempDir(), "config.json")
+	cfg.Credentials["oauth"] = &Credential{
+		Name:           "oauth",
+		CredentialType: Username,
+		OAuth:          &OAuthConfig{TokenUrl: "https://idp.example.com/token", ClientId: "client-id", ClientSecret: "client-secret"},
+	}
+	require.NoError(t, cfg.Save())
+
+	cfg = loadEncryptionTestConfig(t, cfg.Filename)
+	require.Equal(t, "client-secret", cfg.Credentials["oauth"].OAuth.ClientSecret)
+	encryption, key, err := NewEncryptionConfig("passphrase", "")
+	require.NoError(t, err)
+	require.NoError(t, cfg.SetEncryption(encryption, key))
+
+	// Rekey with a new passphrase
+	t.Setenv(ConfigPassphraseEnvVar, "passphrase")
+	cfg = loadEncryptionTestConfig(t, cfg.Filename)
+	encryption, key, err = NewEncryptionConfig("new-passphrase", "")
+	require.NoError(t, err)
+	require.NoError(t, cfg.SetEncryption(encryption, key))
+
+	data, err := os.ReadFile(cfg.Filename)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "client-secret")
+	require.Equal(t, 7, strings.Count(string(data), secret.AesGcmProtected))
+
+	t.Setenv(ConfigPassphraseEnvVar, "new-passphrase")
+	cfg = loadEncryptionTestConfig(t, cfg.Filename)
+	require.Equal(t, "client-secret", cfg.Credentials["oauth"].OAuth.ClientSecret)
+	require.Equal(t, apiSecretString, cfg.Credentials["api-key-abc-key-123"].APIKeyPair.Secret)
+
+	// Disable encryption
+	require.NoError(t, cfg.SetEncryption(nil, nil))
+	cfg = loadEncryptionTestConfig(t, cfg.Filename)
+	require.Equal(t, "client-secret", cfg.Credentials["oauth"].OAuth.ClientSecret)
+}
+
+func TestConfig_UnlockEncryptionKey(t *testing.T) {
+	t.Cleanup(func() { secret.SetProtectionKey(nil) })
+
+	cfg := New()
+	cfg.Filename = filepath.Join(t.TempDir(), "config.json")
+
+	var err error
+	cfg.Encryption, _, err = NewEncryptionConfig("passphrase", "")
+	require.NoError(t, err)
+
+	_, err = cfg.UnlockEncryptionKeyWithPrompt(func() (string, error) { return "wrong", nil })
+	require.Equal(t, IncorrectPassphraseErr, err)
+
+	key, err := cfg.UnlockEncryptionKeyWithPrompt(func() (string, error) { return "passphrase", nil })
+	require.NoError(t, err)
+	require.NoError(t, cfg.Encryption.Verify(key))
+
+	keyFile := filepath.Join(t.TempDir(), "key.txt")
+	identity, err := secret.GenerateIdentity()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(keyFile, identity, 0600))
+
+	cfg.Encryption, key, err = NewEncryptionConfig("", keyFile)
+	require.NoError(t, err)
+
+	unlocked, err := cfg.UnlockEncryptionKeyWithPrompt(func() (string, error) {
+		t.Fatal("a key file does not need a passphrase")
+		return "", nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, key, unlocked)
+}
@@ -31,7 +31,7 @@ func (k *KafkaClusterConfig) GetName() string {
 
 func (k *KafkaClusterConfig) DecryptAPIKeys() error {
 	for _, key := range k.APIKeys {
-		err := key.DecryptSecret()
+		err := key.DecryptSecret(k.ID)
 		if err != nil {
 			return err
 		}
@@ -245,7 +245,7 @@ func (k *KafkaClusterContext) validateApiKeysDict(cluster *KafkaClusterConfig) {
 			mismatchKey = true
 			continue
 		}
-		if pair.Secret == "" {
+		if pair.Secret == "" && pair.CredentialHelper == "" {
 			delete(cluster.APIKeys, k)
 			missingSecret = true
 		}
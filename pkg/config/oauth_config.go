@@ -0,0 +1,55 @@
+package config
+
+import (
+	"runtime"
+	"strings"
+
+	"github.com/confluentinc/cli/v3/pkg/secret"
+)
+
+// OAuthConfig holds the settings of an OAuth 2.0 client which logs in to Confluent Platform with the client credentials
+// grant. The client authenticates to the token endpoint with a secret, or with a JWT signed by a private key.
+type OAuthConfig struct {
+	TokenUrl               string   `json:"token_url"`
+	ClientId               string   `json:"client_id"`
+	ClientSecret           string   `json:"client_secret,omitempty"`
+	ClientAssertionKeyPath string   `json:"client_assertion_key_path,omitempty"`
+	Scopes                 []string `json:"scopes,omitempty"`
+	Salt                   []byte   `json:"salt,omitempty"`
+	Nonce                  []byte   `json:"nonce,omitempty"`
+}
+
+func (c *OAuthConfig) DecryptSecret() error {
+	if (strings.HasPrefix(c.ClientSecret, secret.AesGcm) && c.Salt != nil) || (runtime.GOOS == "windows" && c.ClientSecret != "") {
+		decryptedSecret, err := secret.Decrypt(c.ClientId, c.ClientSecret, c.Salt, c.Nonce)
+		if err != nil {
+			return err
+		}
+		c.ClientSecret = decryptedSecret
+	}
+	return nil
+}
+
+func (c *OAuthConfig) EncryptSecret() error {
+	if c.ClientSecret == "" {
+		return nil
+	}
+
+	if c.Salt == nil || c.Nonce == nil {
+		salt, nonce, err := secret.GenerateSaltAndNonce()
+		if err != nil {
+			return err
+		}
+		c.Salt = salt
+		c.Nonce = nonce
+	}
+
+	if !strings.HasPrefix(c.ClientSecret, secret.AesGcm) {
+		encryptedSecret, err := secret.Encrypt(c.ClientId, c.ClientSecret, c.Salt, c.Nonce)
+		if err != nil {
+			return err
+		}
+		c.ClientSecret = encryptedSecret
+	}
+	return nil
+}
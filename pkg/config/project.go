@@ -0,0 +1,186 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/log"
+	"github.com/confluentinc/cli/v3/pkg/utils"
+)
+
+const ProjectConfigFilename = ".confluent.json"
+
+// projectFlags are the flags which a project configuration can set defaults for, and the top-level commands each of them
+// is limited to, since a flag like "--cluster" only selects a Kafka cluster under "confluent kafka". Flags without
+// commands apply to every command. Any parent directory may contain a project configuration file, so it may only select
+// resources, and never change where credentials are sent or skip confirmations.
+var projectFlags = map[string][]string{
+	"cloud":        {"flink"},
+	"cluster":      {"kafka"},
+	"compute-pool": {"flink"},
+	"database":     {"flink"},
+	"environment":  nil,
+	"output":       nil,
+	"region":       {"flink"},
+}
+
+// ProjectConfig pins the context, resources, and default flag values used by the CLI in a directory and its
+// subdirectories. It is layered on top of the global configuration and is never written back to it.
+type ProjectConfig struct {
+	Context          string            `json:"context,omitempty"`
+	Environment      string            `json:"environment,omitempty"`
+	KafkaCluster     string            `json:"kafka_cluster,omitempty"`
+	FlinkComputePool string            `json:"flink_compute_pool,omitempty"`
+	Output           string            `json:"output,omitempty"`
+	Flags            map[string]string `json:"flags,omitempty"`
+
+	Filename string `json:"-"`
+}
+
+// FindProjectConfigFile returns the path of the closest project configuration file, walking up from a directory to the
+// root of the filesystem, or "" if there is none.
+func FindProjectConfigFile(dir string) string {
+	for {
+		filename := filepath.Join(dir, ProjectConfigFilename)
+		if info, err := os.Stat(filename); err == nil && !info.IsDir() {
+			return filename
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// LoadProjectConfig reads a project configuration file.
+func LoadProjectConfig(filename string) (*ProjectConfig, error) {
+	input, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf(errors.UnableToReadConfigurationFileErrorMsg, filename, err)
+	}
+
+	project := &ProjectConfig{Filename: filename}
+	if err := json.Unmarshal(input, project); err != nil {
+		return nil, fmt.Errorf(errors.UnableToReadConfigurationFileErrorMsg, filename, err)
+	}
+
+	names := make([]string, 0, len(project.Flags))
+	for name := range project.Flags {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		if _, ok := projectFlags[name]; !ok {
+			return nil, errors.NewErrorWithSuggestions(
+				fmt.Sprintf(`flag "--%s" cannot be set by project configuration file "%s"`, name, filename),
+				fmt.Sprintf("Project configuration files can only set the following flags: %s.", utils.ArrayToCommaDelimitedString(getProjectFlagNames(), "and")),
+			)
+		}
+	}
+
+	return project, nil
+}
+
+// LoadProject discovers the project configuration file of the working directory, if any, and switches to the context it
+// pins. The overwritten context is restored whenever the global configuration is saved.
+func (c *Config) LoadProject() error {
+	dir, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	filename := FindProjectConfigFile(dir)
+	if filename == "" {
+		return nil
+	}
+	log.CliLogger.Tracef("Using project configuration file %s", filename)
+
+	project, err := LoadProjectConfig(filename)
+	if err != nil {
+		return err
+	}
+
+	if project.Context != "" {
+		if _, err := c.FindContext(project.Context); err != nil {
+			return errors.NewErrorWithSuggestions(
+				fmt.Sprintf(`the context "%s" pinned by project configuration file "%s" does not exist`, project.Context, filename),
+				"List the available contexts with `confluent context list`.",
+			)
+		}
+		c.SetOverwrittenCurrentContext(c.CurrentContext)
+		c.CurrentContext = project.Context
+	}
+
+	c.Project = project
+	return nil
+}
+
+// SetFlagDefaults sets the value of each flag of a command which the project configuration provides a default for,
+// unless the flag was passed on the command line.
+func (p *ProjectConfig) SetFlagDefaults(cmd *cobra.Command) error {
+	if p == nil {
+		return nil
+	}
+
+	defaults := make(map[string]string, len(p.Flags)+1)
+	if p.Output != "" {
+		defaults["output"] = p.Output
+	}
+	for name, value := range p.Flags {
+		defaults[name] = value
+	}
+
+	names := make([]string, 0, len(defaults))
+	for name := range defaults {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	namespace := getNamespace(cmd)
+	for _, name := range names {
+		if commands := projectFlags[name]; commands != nil && !slices.Contains(commands, namespace) {
+			continue
+		}
+		flag := cmd.Flags().Lookup(name)
+		if flag == nil || flag.Changed {
+			continue
+		}
+		if err := flag.Value.Set(defaults[name]); err != nil {
+			return fmt.Errorf(`invalid default value "%s" for flag "--%s" in project configuration file "%s": %w`, defaults[name], name, p.Filename, err)
+		}
+	}
+
+	return nil
+}
+
+func getProjectFlagNames() []string {
+	names := make([]string, 0, len(projectFlags))
+	for name := range projectFlags {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// getNamespace returns the name of the top-level command a command belongs to, such as "kafka" for
+// "confluent kafka topic list"
+func getNamespace(cmd *cobra.Command) string {
+	for cmd.HasParent() && cmd.Parent().HasParent() {
+		cmd = cmd.Parent()
+	}
+	return cmd.Name()
+}
+
+// GetSource describes where a value pinned by the project configuration came from.
+func (p *ProjectConfig) GetSource() string {
+	return fmt.Sprintf(`project config "%s"`, p.Filename)
+}
@@ -0,0 +1,186 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindProjectConfigFile(t *testing.T) {
+	dir := t.TempDir()
+	nested := filepath.Join(dir, "a", "b")
+	require.NoError(t, os.MkdirAll(nested, 0700))
+
+	require.Empty(t, FindProjectConfigFile(nested))
+
+	filename := filepath.Join(dir, ProjectConfigFilename)
+	require.NoError(t, os.WriteFile(filename, []byte(`{"environment": "env-123"}`), 0600))
+	require.Equal(t, filename, FindProjectConfigFile(nested))
+
+	closer := filepath.Join(dir, "a", ProjectConfigFilename)
+	require.NoError(t, os.WriteFile(closer, []byte(`{}`), 0600))
+	require.Equal(t, closer, FindProjectConfigFile(nested))
+}
+
+func TestLoadProjectConfig(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), ProjectConfigFilename)
+	require.NoError(t, os.WriteFile(filename, []byte(`{"context": "dev", "kafka_cluster": "lkc-123", "output": "json", "flags": {"compute-pool": "lfcp-123"}}`), 0600))
+
+	project, err := LoadProjectConfig(filename)
+	require.NoError(t, err)
+	require.Equal(t, &ProjectConfig{
+		Context:      "dev",
+		KafkaCluster: "lkc-123",
+		Output:       "json",
+		Flags:        map[string]string{"compute-pool": "lfcp-123"},
+		Filename:     filename,
+	}, project)
+
+	require.NoError(t, os.WriteFile(filename, []byte(`{"flags": {"environment": "env-123", "url": "https://example.com"}}`), 0600))
+	_, err = LoadProjectConfig(filename)
+	require.ErrorContains(t, err, `flag "--url" cannot be set by project configuration file`)
+
+	require.NoError(t, os.WriteFile(filename, []byte(`{`), 0600))
+	_, err = LoadProjectConfig(filename)
+	require.Error(t, err)
+}
+
+func TestProjectConfig_SetFlagDefaults(t *testing.T) {
+	project := &ProjectConfig{
+		Output: "json",
+		Flags:  map[string]string{"partitions": "3", "config": "retention.ms=1", "unknown": "value"},
+	}
+
+	cmd := &cobra.Command{Run: func(cmd *cobra.Command, args []string) {}}
+	cmd.Flags().String("output", "human", "Output format.")
+	cmd.Flags().Uint32("partitions", 6, "Number of partitions.")
+	cmd.Flags().StringSlice("config", nil, "Topic configuration.")
+	require.NoError(t, cmd.ParseFlags([]string{"--partitions", "1"}))
+
+	require.NoError(t, project.SetFlagDefaults(cmd))
+
+	output, err := cmd.Flags().GetString("output")
+	require.NoError(t, err)
+	require.Equal(t, "json", output)
+	require.False(t, cmd.Flags().Changed("output"))
+
+	partitions, err := cmd.Flags().GetUint32("partitions")
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), partitions)
+
+	config, err := cmd.Flags().GetStringSlice("config")
+	require.NoError(t, err)
+	require.Equal(t, []string{"retention.ms=1"}, config)
+
+	project = &ProjectConfig{Flags: map[string]string{"partitions": "many"}, Filename: ProjectConfigFilename}
+	cmd = &cobra.Command{Run: func(cmd *cobra.Command, args []string) {}}
+	cmd.Flags().Uint32("partitions", 6, "Number of partitions.")
+	require.ErrorContains(t, project.SetFlagDefaults(cmd), `invalid default value "many" for flag "--partitions" in project configuration file ".confluent.json"`)
+
+	require.NoError(t, (*ProjectConfig)(nil).SetFlagDefaults(cmd))
+}
+
+func TestProjectConfig_SetFlagDefaults_Namespace(t *testing.T) {
+	project := &ProjectConfig{Flags: map[string]string{"cluster": "lkc-123", "environment": "env-123"}}
+
+	root := &cobra.Command{Use: "confluent"}
+	kafka := &cobra.Command{Use: "kafka"}
+	ksql := &cobra.Command{Use: "ksql"}
+	root.AddCommand(kafka, ksql)
+
+	kafkaTopicList := &cobra.Command{Use: "list", Run: func(cmd *cobra.Command, args []string) {}}
+	ksqlClusterList := &cobra.Command{Use: "list", Run: func(cmd *cobra.Command, args []string) {}}
+	kafka.AddCommand(kafkaTopicList)
+	ksql.AddCommand(ksqlClusterList)
+
+	for _, cmd := range []*cobra.Command{kafkaTopicList, ksqlClusterList} {
+		cmd.Flags().String("cluster", "", "Cluster ID.")
+		cmd.Flags().String("environment", "", "Environment ID.")
+		require.NoError(t, project.SetFlagDefaults(cmd))
+
+		environment, err := cmd.Flags().GetString("environment")
+		require.NoError(t, err)
+		require.Equal(t, "env-123", environment)
+	}
+
+	cluster, err := kafkaTopicList.Flags().GetString("cluster")
+	require.NoError(t, err)
+	require.Equal(t, "lkc-123", cluster)
+
+	cluster, err = ksqlClusterList.Flags().GetString("cluster")
+	require.NoError(t, err)
+	require.Empty(t, cluster)
+}
+
+func TestParseFlagsIntoContext_Project(t *testing.T) {
+	ctx := getEnvAndClusterFlagContext()
+	ctx.Config.Project = &ProjectConfig{Environment: flagEnvironment, KafkaCluster: flagClusterInEnv, FlinkComputePool: "lfcp-123"}
+	initialEnvironment := ctx.GetCurrentEnvironment()
+
+	cmd := &cobra.Command{Run: func(cmd *cobra.Command, args []string) {}}
+	cmd.Flags().String("environment", "", "Environment ID.")
+	cmd.Flags().String("cluster", "", "Kafka cluster ID.")
+	require.NoError(t, ctx.ParseFlagsIntoContext(cmd))
+
+	require.Equal(t, flagEnvironment, ctx.GetCurrentEnvironment())
+	require.Equal(t, flagClusterInEnv, ctx.KafkaClusterContext.GetActiveKafkaClusterId())
+	require.Equal(t, "lfcp-123", ctx.GetCurrentFlinkComputePool())
+
+	// the pinned values are not persisted
+	tempComputePool := ctx.Config.resolveOverwrittenFlinkComputePool()
+	require.Equal(t, "lfcp-123", tempComputePool)
+	require.Empty(t, ctx.GetCurrentFlinkComputePool())
+	ctx.Config.restoreOverwrittenFlinkComputePool(tempComputePool)
+	require.Equal(t, "lfcp-123", ctx.GetCurrentFlinkComputePool())
+
+	tempEnvironment := ctx.Config.resolveOverwrittenCurrentEnvironment()
+	require.Equal(t, initialEnvironment, ctx.GetCurrentEnvironment())
+	ctx.Config.restoreOverwrittenEnvironment(tempEnvironment)
+
+	// flags take precedence over the project configuration
+	ctx = getEnvAndClusterFlagContext()
+	ctx.Config.Project = &ProjectConfig{Environment: "env-project"}
+	require.NoError(t, cmd.ParseFlags([]string{"--environment", flagEnvironment}))
+	require.NoError(t, ctx.ParseFlagsIntoContext(cmd))
+	require.Equal(t, flagEnvironment, ctx.GetCurrentEnvironment())
+}
+
+func TestConfig_UseContext_Project(t *testing.T) {
+	cfg := AuthenticatedCloudConfigMock()
+	cfg.Filename = filepath.Join(t.TempDir(), "config.json")
+	name := cfg.CurrentContext
+
+	// the project configuration pins the current context, while the global configuration has none
+	cfg.SetOverwrittenCurrentContext("")
+	require.NoError(t, cfg.UseContext(name))
+
+	saved := New()
+	saved.Filename = cfg.Filename
+	require.NoError(t, saved.Load())
+	require.Equal(t, name, saved.CurrentContext)
+}
+
+func TestConfig_UseEnvironment_Project(t *testing.T) {
+	ctx := getEnvAndClusterFlagContext()
+	ctx.Config.Filename = filepath.Join(t.TempDir(), "config.json")
+	ctx.Config.Project = &ProjectConfig{Environment: flagEnvironment, KafkaCluster: flagClusterInEnv}
+
+	cmd := &cobra.Command{Run: func(cmd *cobra.Command, args []string) {}}
+	cmd.Flags().String("environment", "", "Environment ID.")
+	cmd.Flags().String("cluster", "", "Kafka cluster ID.")
+	require.NoError(t, ctx.ParseFlagsIntoContext(cmd))
+
+	require.NoError(t, ctx.Config.UseEnvironment("env-other"))
+	require.Equal(t, "env-other", ctx.GetCurrentEnvironment())
+
+	// the cluster pinned by the project configuration is not saved
+	require.Empty(t, ctx.KafkaClusterContext.KafkaEnvContexts[flagEnvironment].ActiveKafkaCluster)
+
+	saved := New()
+	saved.Filename = ctx.Config.Filename
+	require.NoError(t, saved.Load())
+	require.Equal(t, "env-other", saved.Context().GetCurrentEnvironment())
+}
@@ -0,0 +1,121 @@
+package credentialhelper
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strings"
+
+	"github.com/confluentinc/cli/v3/pkg/log"
+)
+
+// A credential helper is an executable named "confluent-credential-<name>" on the PATH. It is run with a single action
+// argument ("get", "store", or "erase"), receives a JSON request on stdin, and for "get" writes a JSON response to
+// stdout. A helper should respond to "get" with an empty secret when it has no matching credentials, and exit with a
+// non-zero status, with a message on stderr, if it fails.
+const ExecutablePrefix = "confluent-credential-"
+
+// The name of a helper is part of the executable which is looked up on the PATH, so it can't contain path separators
+var nameRegex = regexp.MustCompile(`^[a-z0-9-]+$`)
+
+const (
+	actionGet   = "get"
+	actionStore = "store"
+	actionErase = "erase"
+)
+
+// The types of credentials which are managed by credential helpers
+const (
+	Login                = "login"
+	APIKey               = "api-key"
+	SchemaRegistryAPIKey = "schema-registry-api-key"
+)
+
+// Request identifies the credentials to get, store, or erase. Login credentials are identified by their URL, and by the
+// name of their context when it is known.
+type Request struct {
+	Type string `json:"type"`
+
+	// For login credentials
+	Url      string `json:"url,omitempty"`
+	Context  string `json:"context,omitempty"`
+	IsCloud  bool   `json:"is_cloud,omitempty"`
+	Username string `json:"username,omitempty"`
+
+	// For API keys
+	Key      string `json:"key,omitempty"`
+	Resource string `json:"resource,omitempty"`
+
+	// The password or API secret, only sent with "store"
+	Secret string `json:"secret,omitempty"`
+}
+
+type Response struct {
+	Username string `json:"username,omitempty"`
+	Secret   string `json:"secret"`
+}
+
+// Get returns the credentials matching a request, or nil if the helper has none.
+func Get(name string, request *Request) (*Response, error) {
+	out, err := run(name, actionGet, request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := new(Response)
+	if err := json.Unmarshal(out, response); err != nil {
+		return nil, fmt.Errorf(`failed to parse the response of credential helper "%s": %w`, name, err)
+	}
+	if response.Secret == "" {
+		return nil, nil
+	}
+
+	return response, nil
+}
+
+// Store saves the credentials of a request, including its secret.
+func Store(name string, request *Request) error {
+	_, err := run(name, actionStore, request)
+	return err
+}
+
+// Erase deletes the credentials matching a request.
+func Erase(name string, request *Request) error {
+	_, err := run(name, actionErase, request)
+	return err
+}
+
+func run(name, action string, request *Request) ([]byte, error) {
+	if !nameRegex.MatchString(name) {
+		return nil, fmt.Errorf(`invalid credential helper name "%s": names may only contain lowercase letters, digits, and hyphens`, name)
+	}
+
+	path, err := exec.LookPath(ExecutablePrefix + name)
+	if err != nil {
+		return nil, fmt.Errorf(`credential helper "%s" not found: %w`, name, err)
+	}
+
+	in, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	log.CliLogger.Debugf(`Running credential helper "%s" to %s %s credentials`, path, action, request.Type)
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(path, action)
+	cmd.Stdin = bytes.NewReader(in)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return nil, fmt.Errorf(`credential helper "%s" failed to %s credentials: %s`, name, action, message)
+		}
+		return nil, fmt.Errorf(`credential helper "%s" failed to %s credentials: %w`, name, action, err)
+	}
+
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,68 @@
+package credentialhelper
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeHelper stores the last request it receives in a file next to itself, and responds to "get" with that file's
+// contents, so that a round trip through the protocol can be tested
+const fakeHelper = `#!/bin/sh
+dir=$(dirname "$0")
+case "$1" in
+get) if [ -f "$dir/stored" ]; then cat "$dir/stored"; else echo '{}'; fi ;;
+store) cat > "$dir/stored" ;;
+erase) rm -f "$dir/stored" ;;
+*) echo "unknown action $1" >&2; exit 1 ;;
+esac
+`
+
+func setupFakeHelper(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential helper tests use a shell script")
+	}
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ExecutablePrefix+"fake"), []byte(fakeHelper), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ExecutablePrefix+"broken"), []byte("#!/bin/sh\necho 'vault is sealed' >&2\nexit 1\n"), 0700))
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+func TestCredentialHelper(t *testing.T) {
+	setupFakeHelper(t)
+
+	request := &Request{Type: Login, Url: "https://confluent.cloud", IsCloud: true}
+
+	response, err := Get("fake", request)
+	require.NoError(t, err)
+	require.Nil(t, response)
+
+	require.NoError(t, Store("fake", &Request{Type: Login, Url: "https://confluent.cloud", IsCloud: true, Username: "user@example.com", Secret: "password"}))
+
+	response, err = Get("fake", request)
+	require.NoError(t, err)
+	require.Equal(t, &Response{Username: "user@example.com", Secret: "password"}, response)
+
+	require.NoError(t, Erase("fake", request))
+
+	response, err = Get("fake", request)
+	require.NoError(t, err)
+	require.Nil(t, response)
+}
+
+func TestCredentialHelperErrors(t *testing.T) {
+	setupFakeHelper(t)
+
+	_, err := Get("broken", &Request{Type: APIKey, Key: "key"})
+	require.EqualError(t, err, `credential helper "broken" failed to get credentials: vault is sealed`)
+
+	err = Store("missing", &Request{Type: APIKey, Key: "key", Secret: "secret"})
+	require.ErrorContains(t, err, `credential helper "missing" not found`)
+
+	_, err = Get("../fake", &Request{Type: APIKey, Key: "key"})
+	require.EqualError(t, err, `invalid credential helper name "../fake": names may only contain lowercase letters, digits, and hyphens`)
+}
@@ -45,10 +45,9 @@ const (
 	ExceedPartitionLimitSuggestions   = "The total partition limit for a dedicated cluster may be increased by expanding its CKU count using `confluent kafka cluster update <id> --cku <count>`."
 
 	// serialization/deserialization commands
-	JsonDocumentInvalidErrorMsg       = "the JSON document is invalid"
-	AvroReferenceNotSupportedErrorMsg = "avro reference not supported in cloud CLI"
-	ProtoSchemaInvalidErrorMsg        = "the protobuf schema is invalid"
-	ProtoDocumentInvalidErrorMsg      = "the protobuf document is invalid"
+	JsonDocumentInvalidErrorMsg  = "the JSON document is invalid"
+	ProtoSchemaInvalidErrorMsg   = "the protobuf schema is invalid"
+	ProtoDocumentInvalidErrorMsg = "the protobuf document is invalid"
 
 	// ksql commands
 	KsqldbNoServiceAccountErrorMsg = `ACLs do not need to be configured for the ksqlDB cluster, "%s", because it was created with user-level access to the Kafka cluster`
@@ -1,7 +1,9 @@
 package app
 
 import (
+	"io"
 	"sync"
+	"time"
 
 	"github.com/confluentinc/cli/v3/pkg/ccloudv2"
 	"github.com/confluentinc/cli/v3/pkg/flink/components"
@@ -40,12 +42,21 @@ func synchronizedTokenRefresh(tokenRefreshFunc func() error) func() error {
 
 func StartApp(client ccloudv2.GatewayClientInterface, tokenRefreshFunc func() error, appOptions types.ApplicationOptions, reportUsageFunc func()) {
 	// Load history of previous commands from cache file
-	historyStore := history.LoadHistory()
+	var contextName string
+	if appOptions.GetContext() != nil {
+		contextName = appOptions.GetContext().Name
+	}
+	historyStore := history.LoadHistory(contextName)
 
 	// Instantiate Application Controller - this is the top level controller that will be passed down to all other controllers
 	// and should be used for functions that are not specific to a component
 	appController := controller.NewApplicationController(historyStore)
 
+	// A client which records its responses is closed on exit, since exiting skips deferred calls
+	if closer, ok := client.(io.Closer); ok {
+		appController.AddCleanupFunction(func() { _ = closer.Close() })
+	}
+
 	// Store used to process statements and store local properties
 	dataStore := store.NewStore(client, appController.ExitApplication, &appOptions, synchronizedTokenRefresh(tokenRefreshFunc))
 	resultFetcher := results.NewResultFetcher(dataStore)
@@ -100,12 +111,18 @@ func (a *Application) readEvalPrint() {
 		a.appController.ExitApplication()
 		return
 	}
-	a.history.Append(userInput)
+	statement, processed := a.processHistoryStatement(userInput)
+	if processed {
+		a.history.Append(history.Entry{Statement: userInput, Timestamp: time.Now().UTC(), Status: string(types.COMPLETED)})
+		return
+	}
 
-	executedStatement, err := a.statementController.ExecuteStatement(userInput)
+	executedStatement, err := a.statementController.ExecuteStatement(statement)
 	if err != nil {
+		a.appendToHistory(statement, nil)
 		return
 	}
+	a.appendToHistory(statement, executedStatement)
 
 	a.resultFetcher.Init(*executedStatement)
 	a.getOutputController(*executedStatement).VisualizeResults()
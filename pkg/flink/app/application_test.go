@@ -39,7 +39,7 @@ func (s *ApplicationTestSuite) SetupTest() {
 	ctrl := gomock.NewController(s.T())
 	s.appController = mock.NewMockApplicationControllerInterface(ctrl)
 	s.inputController = mock.NewMockInputControllerInterface(ctrl)
-	s.history = &history.History{Data: []string{}}
+	s.history = &history.History{}
 	s.statementController = mock.NewMockStatementControllerInterface(ctrl)
 	s.interactiveOutputController = mock.NewMockOutputControllerInterface(ctrl)
 	s.basicOutputController = mock.NewMockOutputControllerInterface(ctrl)
@@ -109,7 +109,7 @@ func (s *ApplicationTestSuite) TestReplAppendsStatementToHistoryAndStopsOnExecut
 	actual := test.RunAndCaptureSTDOUT(s.T(), s.app.readEvalPrint)
 
 	cupaloy.SnapshotT(s.T(), actual)
-	require.Equal(s.T(), []string{userInput}, s.history.Data)
+	require.Equal(s.T(), []string{userInput}, s.history.Statements())
 }
 
 func (s *ApplicationTestSuite) TestReplStopsOnExecuteStatementError() {
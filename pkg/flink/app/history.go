@@ -0,0 +1,108 @@
+package app
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/confluentinc/cli/v3/pkg/flink/internal/history"
+	"github.com/confluentinc/cli/v3/pkg/flink/internal/utils"
+	"github.com/confluentinc/cli/v3/pkg/flink/types"
+)
+
+type (
+	History      = history.History
+	HistoryEntry = history.Entry
+)
+
+// LoadHistory loads the statement history of the given context
+func LoadHistory(contextName string) *History {
+	return history.LoadHistory(contextName)
+}
+
+// processHistoryStatement lists the history for "HISTORY;" and resolves the statement to run again for "RERUN 3;".
+// It returns the statement to execute and false if the statement still has to be executed.
+func (a *Application) processHistoryStatement(userInput string) (string, bool) {
+	if query, ok := history.ParseHistoryStatement(userInput); ok {
+		indexes := a.history.Search(query)
+		if len(indexes) == 0 {
+			utils.OutputInfo("No statements found in the history.")
+			return "", true
+		}
+		a.resultFetcher.Init(types.ProcessedStatement{
+			Kind:             "HISTORY",
+			Status:           types.COMPLETED,
+			StatementResults: historyResults(a.history.Entries, indexes),
+			IsLocalStatement: true,
+		})
+		a.basicOutputController.VisualizeResults()
+		return "", true
+	}
+
+	if reference, ok := history.ParseRerunStatement(userInput); ok {
+		entry, found := a.history.Get(reference)
+		if !found {
+			utils.OutputErrf(`Error: no statement "%s" found in the history`, reference)
+			return "", true
+		}
+		utils.OutputInfof("Running statement: %s\n", entry.Statement)
+		return entry.Statement, false
+	}
+
+	return userInput, false
+}
+
+// appendToHistory adds the statement to the history along with the metadata of its run
+func (a *Application) appendToHistory(statement string, processedStatement *types.ProcessedStatement) {
+	entry := history.Entry{
+		Statement:   statement,
+		Timestamp:   time.Now().UTC(),
+		Status:      string(types.FAILED),
+		ComputePool: a.appOptions.GetComputePoolId(),
+		Environment: a.appOptions.GetEnvironmentId(),
+		Catalog:     a.appOptions.GetEnvironmentName(),
+		Database:    a.appOptions.GetDatabase(),
+	}
+
+	// The current catalog and database are persisted to the context whenever they're changed with a USE statement
+	if context := a.appOptions.GetContext(); context != nil {
+		if catalog := context.GetCurrentFlinkCatalog(); catalog != "" {
+			entry.Catalog = catalog
+		}
+		if database := context.GetCurrentFlinkDatabase(); database != "" {
+			entry.Database = database
+		}
+	}
+
+	if processedStatement != nil {
+		entry.StatementName = processedStatement.StatementName
+		entry.Status = string(processedStatement.Status)
+		if processedStatement.ComputePool != "" {
+			entry.ComputePool = processedStatement.ComputePool
+		}
+	}
+
+	a.history.Append(entry)
+}
+
+func historyResults(entries []history.Entry, indexes []int) *types.StatementResults {
+	rows := make([]types.StatementResultRow, len(indexes))
+	for i, index := range indexes {
+		entry := entries[index-1]
+
+		timestamp := ""
+		if !entry.Timestamp.IsZero() {
+			timestamp = entry.Timestamp.Local().Format(time.DateTime)
+		}
+
+		values := []string{strconv.Itoa(index), timestamp, entry.StatementName, entry.Status, strings.Join(strings.Fields(entry.Statement), " ")}
+		for _, value := range values {
+			rows[i].Fields = append(rows[i].Fields, types.AtomicStatementResultField{Type: types.Varchar, Value: value})
+		}
+	}
+
+	return &types.StatementResults{
+		Headers: []string{"Index", "Timestamp", "Statement Name", "Status", "Statement"},
+		Rows:    rows,
+	}
+}
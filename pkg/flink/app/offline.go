@@ -0,0 +1,17 @@
+package app
+
+import (
+	"github.com/confluentinc/cli/v3/pkg/flink/internal/formatting"
+	"github.com/confluentinc/cli/v3/pkg/flink/internal/linting"
+	"github.com/confluentinc/cli/v3/pkg/flink/types"
+)
+
+// FormatStatements pretty-prints Flink SQL statements without contacting the Flink gateway.
+func FormatStatements(sql string) (string, error) {
+	return formatting.Format(sql)
+}
+
+// LintStatements checks Flink SQL statements for common mistakes without contacting the Flink gateway.
+func LintStatements(sql string) []types.LintIssue {
+	return linting.Lint(sql)
+}
@@ -33,5 +33,5 @@ func PrintWelcomeHeader() {
 
 	// Print shortcuts
 	c := fColor.New(color.AccentColor)
-	output.Printf(false, "[Ctrl-Q] %s [Ctrl-S] %s \n", c.Sprint("Quit"), c.Sprint("Toggle Smart Completion"))
+	output.Printf(false, "[Ctrl-Q] %s [Ctrl-S] %s [Ctrl-T] %s \n", c.Sprint("Quit"), c.Sprint("Toggle Smart Completion"), c.Sprint("Format Statement"))
 }
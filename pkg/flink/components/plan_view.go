@@ -0,0 +1,34 @@
+package components
+
+import (
+	"fmt"
+
+	"github.com/rivo/tview"
+
+	"github.com/confluentinc/cli/v3/pkg/flink/internal/explain"
+)
+
+// CreatePlanView shows the operator trees of an EXPLAIN statement in a panel next to the table. Exchanges are
+// highlighted in yellow and stateful operators, like joins and aggregations, in red.
+func CreatePlanView(tableRoot tview.Primitive, sections []explain.Section) *tview.Flex {
+	exchanges, stateful := explain.Summary(sections)
+	title := fmt.Sprintf(" Plan | %d exchange(s) | %d stateful operator(s) ", exchanges, stateful)
+
+	textView := tview.NewTextView().SetText(explain.Render(sections, highlightOperator))
+	textView.SetDynamicColors(true).SetBorder(true).SetTitle(title)
+
+	return tview.NewFlex().
+		AddItem(tableRoot, 0, 1, false).
+		AddItem(textView, 0, 1, false)
+}
+
+func highlightOperator(operator *explain.Operator, label string) string {
+	switch operator.Kind {
+	case explain.ExchangeOperator:
+		return fmt.Sprintf("[yellow]%s[white]", tview.Escape(label))
+	case explain.StatefulOperator:
+		return fmt.Sprintf("[red]%s[white]", tview.Escape(label))
+	default:
+		return tview.Escape(label)
+	}
+}
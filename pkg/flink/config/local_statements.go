@@ -10,7 +10,8 @@ const (
 	StatementTerminator = ";"
 
 	// config namespaces
-	NamespaceClient = "client."
+	NamespaceClient    = "client."
+	NamespaceClientVar = "client.var."
 
 	// keys
 	KeyCatalog        = "sql.current-catalog"
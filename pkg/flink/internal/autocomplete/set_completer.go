@@ -12,6 +12,7 @@ func SetCompleter(in prompt.Document) []prompt.Suggest {
 	s := []prompt.Suggest{
 		{Text: fmt.Sprintf("SET '%s' = '10000';", config.KeyResultsTimeout), Description: "Total amount of time in milliseconds to wait before timing out the request waiting for results to be ready."},
 		{Text: fmt.Sprintf("SET '%s' = 'Europe/Berlin';", config.KeyLocalTimeZone), Description: "Used to set the timezone for the current session either with a TZID ('Europe/Berlin'), a fixed offset ('GMT+02:00') or just 'UTC'"},
+		{Text: fmt.Sprintf("SET '%senv' = 'prod';", config.NamespaceClientVar), Description: "Defines a client-side variable which is substituted wherever ${env} is referenced in a statement."},
 	}
 
 	return SuggestFromPrefix(s, in.TextBeforeCursor())
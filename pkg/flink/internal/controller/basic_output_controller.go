@@ -3,11 +3,14 @@ package controller
 import (
 	"os"
 
+	fColor "github.com/fatih/color"
 	"github.com/olekukonko/tablewriter"
 
+	"github.com/confluentinc/cli/v3/pkg/flink/internal/explain"
 	"github.com/confluentinc/cli/v3/pkg/flink/internal/results"
 	"github.com/confluentinc/cli/v3/pkg/flink/internal/utils"
 	"github.com/confluentinc/cli/v3/pkg/flink/types"
+	"github.com/confluentinc/cli/v3/pkg/output"
 )
 
 type BasicOutputController struct {
@@ -36,6 +39,11 @@ func (c *BasicOutputController) printResultToSTDOUT() {
 		return
 	}
 
+	if sections, ok := getPlan(c.resultFetcher.GetStatement(), materializedStatementResults); ok {
+		output.Print(false, explain.Render(sections, colorOperator))
+		return
+	}
+
 	totalAvailableChars := c.calcTotalAvailableChars()
 	rows := c.getRows(totalAvailableChars)
 	rawTable := c.createTable(rows)
@@ -77,3 +85,15 @@ func (c *BasicOutputController) createTable(rows [][]string) *tablewriter.Table
 	rawTable.AppendBulk(rows)
 	return rawTable
 }
+
+// colorOperator highlights exchanges, which shuffle records over the network, and operators which keep state
+func colorOperator(operator *explain.Operator, label string) string {
+	switch operator.Kind {
+	case explain.ExchangeOperator:
+		return fColor.YellowString(label)
+	case explain.StatefulOperator:
+		return fColor.RedString(label)
+	default:
+		return label
+	}
+}
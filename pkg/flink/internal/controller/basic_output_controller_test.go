@@ -56,6 +56,38 @@ func (s *BasicOutputControllerTestSuite) TestVisualizeResultsShouldPrintTable()
 	mat := types.NewMaterializedStatementResults(executedStatementWithResults.StatementResults.GetHeaders(), 10)
 	mat.Append(executedStatementWithResults.StatementResults.GetRows()...)
 	s.resultFetcher.EXPECT().GetMaterializedStatementResults().Return(&mat).Times(4)
+	s.resultFetcher.EXPECT().GetStatement().Return(executedStatementWithResults)
+
+	stdout := test.RunAndCaptureSTDOUT(s.T(), s.basicOutputController.VisualizeResults)
+
+	cupaloy.SnapshotT(s.T(), stdout)
+}
+
+func (s *BasicOutputControllerTestSuite) TestVisualizeResultsShouldPrintPlan() {
+	plan := "== Optimized Execution Plan ==\nSink(table=[sink])\n+- GroupAggregate(groupBy=[id])\n   +- Exchange(distribution=[hash[id]])\n      +- TableSourceScan(table=[[orders]])\n"
+	mat := types.NewMaterializedStatementResults([]string{"plan"}, 10)
+	mat.SetTableMode(true)
+	mat.Append(types.StatementResultRow{
+		Operation: types.Insert,
+		Fields:    []types.StatementResultField{types.AtomicStatementResultField{Type: types.Varchar, Value: plan}},
+	})
+	s.resultFetcher.EXPECT().GetMaterializedStatementResults().Return(&mat)
+	s.resultFetcher.EXPECT().GetStatement().Return(types.ProcessedStatement{Statement: "EXPLAIN SELECT id, COUNT(*) FROM orders GROUP BY id;"})
+
+	stdout := test.RunAndCaptureSTDOUT(s.T(), s.basicOutputController.VisualizeResults)
+
+	cupaloy.SnapshotT(s.T(), stdout)
+}
+
+func (s *BasicOutputControllerTestSuite) TestVisualizeResultsShouldPrintPlanOnlyForExplainStatements() {
+	mat := types.NewMaterializedStatementResults([]string{"plan"}, 10)
+	mat.SetTableMode(true)
+	mat.Append(types.StatementResultRow{
+		Operation: types.Insert,
+		Fields:    []types.StatementResultField{types.AtomicStatementResultField{Type: types.Varchar, Value: "Sink(table=[sink])"}},
+	})
+	s.resultFetcher.EXPECT().GetMaterializedStatementResults().Return(&mat).Times(4)
+	s.resultFetcher.EXPECT().GetStatement().Return(types.ProcessedStatement{Statement: "SELECT 'Sink(table=[sink])' AS plan;"})
 
 	stdout := test.RunAndCaptureSTDOUT(s.T(), s.basicOutputController.VisualizeResults)
 
@@ -9,6 +9,7 @@ import (
 
 	"github.com/confluentinc/cli/v3/pkg/flink/components"
 	"github.com/confluentinc/cli/v3/pkg/flink/internal/autocomplete"
+	"github.com/confluentinc/cli/v3/pkg/flink/internal/formatting"
 	"github.com/confluentinc/cli/v3/pkg/flink/internal/highlighting"
 	"github.com/confluentinc/cli/v3/pkg/flink/internal/history"
 	"github.com/confluentinc/cli/v3/pkg/flink/internal/reverseisearch"
@@ -70,7 +71,7 @@ func (c *InputController) HasUserEnabledReverseSearch() bool {
 }
 
 func (c *InputController) StartReverseSearch() {
-	searchResult := c.reverseISearch.ReverseISearch(c.History.Data, c.prompt.Buffer().Text())
+	searchResult := c.reverseISearch.ReverseISearch(c.History.Entries, c.prompt.Buffer().Text())
 	c.reverseISearchEnabled = false
 	c.InitialBuffer = searchResult
 }
@@ -116,14 +117,14 @@ func (c *InputController) Prompt() prompt.IPrompt {
 		AddCompleter(autocomplete.ExamplesCompleter).
 		AddCompleter(autocomplete.SetCompleter).
 		AddCompleter(autocomplete.ShowCompleter).
-		AddCompleter(autocomplete.GenerateHistoryCompleter(c.History.Data)).
+		AddCompleter(autocomplete.GenerateHistoryCompleter(c.History.Statements())).
 		BuildCompleter()
 
 	return prompt.New(
 		nil,
 		completer,
 		prompt.OptionTitle("sql-prompt"),
-		prompt.OptionHistory(c.History.Data),
+		prompt.OptionHistory(c.History.Statements()),
 		prompt.OptionSwitchKeyBindMode(prompt.EmacsKeyBind),
 		prompt.OptionSetExitCheckerOnInput(func(input string, breakline bool) bool {
 			return c.reverseISearchEnabled || c.shouldExit
@@ -147,6 +148,10 @@ func (c *InputController) Prompt() prompt.IPrompt {
 				c.toggleSmartCompletion()
 			},
 		}),
+		prompt.OptionAddKeyBind(prompt.KeyBind{
+			Key: prompt.ControlT,
+			Fn:  c.formatBuffer,
+		}),
 		prompt.OptionAddKeyBind(prompt.KeyBind{
 			Key: prompt.ControlR,
 			Fn: func(b *prompt.Buffer) {
@@ -181,6 +186,19 @@ func (c *InputController) Prompt() prompt.IPrompt {
 	)
 }
 
+// formatBuffer pretty-prints the statement in the buffer, leaving the buffer untouched if it can't be formatted
+func (c *InputController) formatBuffer(b *prompt.Buffer) {
+	formatted, err := formatting.Format(b.Text())
+	if err != nil {
+		log.CliLogger.Debugf("Failed to format statement: %v", err)
+		return
+	}
+
+	b.DeleteBeforeCursor(len(b.Text()))
+	b.Delete(len(b.Text()))
+	b.InsertText(strings.TrimSuffix(formatted, "\n"), false, true)
+}
+
 func (c *InputController) getSmartCompletion() bool {
 	return c.smartCompletion
 }
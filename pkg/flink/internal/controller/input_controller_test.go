@@ -30,7 +30,7 @@ func TestInputControllerTestSuite(t *testing.T) {
 func (s *InputControllerTestSuite) SetupTest() {
 	ctrl := gomock.NewController(s.T())
 	s.appController = mock.NewMockApplicationControllerInterface(ctrl)
-	s.history = &history.History{Data: []string{}}
+	s.history = &history.History{Entries: []history.Entry{}}
 	s.prompt = mock.NewMockIPrompt(ctrl)
 	s.reverseISearch = mock.NewMockReverseISearch(ctrl)
 	s.inputController = NewInputController(s.history).(*InputController)
@@ -78,7 +78,7 @@ func (s *InputControllerTestSuite) TestHasUserEnabledReverseSearchShouldBeFalse(
 
 func (s *InputControllerTestSuite) TestStartReverseSearch() {
 	searchResult := "search result"
-	s.reverseISearch.EXPECT().ReverseISearch(s.history.Data, "").Return(searchResult)
+	s.reverseISearch.EXPECT().ReverseISearch(s.history.Entries, "").Return(searchResult)
 	s.prompt.EXPECT().Buffer().Return(prompt.NewBuffer())
 
 	s.inputController.StartReverseSearch()
@@ -9,6 +9,7 @@ import (
 	"github.com/rivo/tview"
 
 	"github.com/confluentinc/cli/v3/pkg/flink/components"
+	"github.com/confluentinc/cli/v3/pkg/flink/internal/explain"
 	"github.com/confluentinc/cli/v3/pkg/flink/internal/utils"
 	"github.com/confluentinc/cli/v3/pkg/flink/types"
 	"github.com/confluentinc/cli/v3/pkg/log"
@@ -22,6 +23,7 @@ type InteractiveOutputController struct {
 	tableView     components.TableViewInterface
 	resultFetcher types.ResultFetcherInterface
 	isRowViewOpen bool
+	plan          []explain.Section
 	debug         bool
 }
 
@@ -55,6 +57,7 @@ func (t *InteractiveOutputController) close() {
 
 func (t *InteractiveOutputController) init() {
 	t.isRowViewOpen = false
+	t.plan = nil
 	t.resultFetcher.SetRefreshCallback(t.renderTableAsync)
 	t.resultFetcher.ToggleRefresh()
 	t.app.SetInputCapture(t.inputCapture)
@@ -63,12 +66,23 @@ func (t *InteractiveOutputController) init() {
 }
 
 func (t *InteractiveOutputController) updateTable() {
-	t.tableView.RenderTable(t.getTableTitle(), t.resultFetcher.GetMaterializedStatementResults(), t.resultFetcher.GetLastRefreshTimestamp(), t.resultFetcher.GetRefreshState())
+	materializedStatementResults := t.resultFetcher.GetMaterializedStatementResults()
+	refreshState := t.resultFetcher.GetRefreshState()
+	t.tableView.RenderTable(t.getTableTitle(), materializedStatementResults, t.resultFetcher.GetLastRefreshTimestamp(), refreshState)
+
+	// The output of an EXPLAIN statement is shown as operator trees next to the table, which holds it in a single cell
+	if t.plan == nil && refreshState == types.Completed {
+		t.plan, _ = getPlan(t.resultFetcher.GetStatement(), materializedStatementResults)
+	}
 	t.renderTableView()
 }
 
 func (t *InteractiveOutputController) renderTableView() {
-	t.app.SetRoot(t.tableView.GetRoot(), true).EnableMouse(false)
+	var root tview.Primitive = t.tableView.GetRoot()
+	if t.plan != nil {
+		root = components.CreatePlanView(root, t.plan)
+	}
+	t.app.SetRoot(root, true).EnableMouse(false)
 	t.app.SetFocus(t.tableView.GetFocusableElement())
 }
 
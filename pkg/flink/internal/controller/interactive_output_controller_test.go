@@ -122,6 +122,27 @@ func (s *InteractiveOutputControllerTestSuite) TestToggleRefreshResultsDoesNothi
 	require.Nil(s.T(), result)
 }
 
+func (s *InteractiveOutputControllerTestSuite) TestShowPlanNextToTableForExplainStatement() {
+	mat := types.NewMaterializedStatementResults([]string{"plan"}, 10)
+	mat.Append(types.StatementResultRow{
+		Operation: types.Insert,
+		Fields:    []types.StatementResultField{types.AtomicStatementResultField{Type: types.Varchar, Value: "== Optimized Execution Plan ==\nSink(table=[sink])\n+- TableSourceScan(table=[[orders]])\n"}},
+	})
+	s.resultFetcher.EXPECT().IsTableMode().Return(true)
+	s.resultFetcher.EXPECT().GetRefreshState().Return(types.Completed)
+	s.resultFetcher.EXPECT().GetLastRefreshTimestamp().Return(nil)
+	s.resultFetcher.EXPECT().GetMaterializedStatementResults().Return(&mat)
+	s.resultFetcher.EXPECT().GetStatement().Return(types.ProcessedStatement{Statement: "EXPLAIN SELECT * FROM orders;"})
+	s.tableView.EXPECT().RenderTable(gomock.Any(), &mat, nil, types.Completed)
+	s.tableView.EXPECT().GetRoot().Return(tview.NewBox())
+	s.tableView.EXPECT().GetFocusableElement().Return(tview.NewTable())
+
+	s.interactiveOutputController.updateTable()
+
+	require.Len(s.T(), s.interactiveOutputController.plan, 1)
+	require.False(s.T(), s.interactiveOutputController.isRowViewOpen)
+}
+
 func (s *InteractiveOutputControllerTestSuite) TestNonSupportedUserInput() {
 	// Test a case when the event is neither 'Q', 'N', Ctrl-C, nor Escape
 	// When we return the event, it's forwarded to tview
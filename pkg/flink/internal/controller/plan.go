@@ -0,0 +1,22 @@
+package controller
+
+import (
+	"github.com/confluentinc/cli/v3/pkg/flink/internal/explain"
+	"github.com/confluentinc/cli/v3/pkg/flink/types"
+)
+
+// getPlan returns the operator trees of an EXPLAIN statement, whose result holds the plan in its first field
+func getPlan(statement types.ProcessedStatement, materializedStatementResults *types.MaterializedStatementResults) ([]explain.Section, bool) {
+	if !explain.IsExplainStatement(statement.Statement) {
+		return nil, false
+	}
+
+	var sections []explain.Section
+	var ok bool
+	materializedStatementResults.ForEach(func(_ int, row *types.StatementResultRow) {
+		if fields := row.GetFields(); !ok && len(fields) > 0 {
+			sections, ok = explain.Parse(fields[0].ToString())
+		}
+	})
+	return sections, ok
+}
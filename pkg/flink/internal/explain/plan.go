@@ -0,0 +1,159 @@
+package explain
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+type OperatorKind int
+
+const (
+	RegularOperator OperatorKind = iota
+	// ExchangeOperator shuffles records between tasks, usually over the network
+	ExchangeOperator
+	// StatefulOperator keeps state which grows with the input, like joins and aggregations
+	StatefulOperator
+)
+
+// Operators which keep state, matched by the suffix of their name, e.g. "Join" matches "IntervalJoin" and "WindowJoin"
+var statefulOperators = []string{"Aggregate", "ChangelogNormalize", "Deduplicate", "Join", "Limit", "Match", "Rank", "Sort"}
+
+var operatorPrefixRegex = regexp.MustCompile(`^(Stream|Batch|Logical|Flink|Physical)(Exec|Physical|Logical)?`)
+
+type Operator struct {
+	Name     string
+	Details  string
+	Kind     OperatorKind
+	Children []*Operator
+}
+
+// Section is a part of the EXPLAIN output, like the abstract syntax tree or the optimized execution plan
+type Section struct {
+	Title     string
+	Operators []*Operator
+}
+
+// IsExplainStatement returns true if the statement is an EXPLAIN statement
+func IsExplainStatement(statement string) bool {
+	fields := strings.Fields(statement)
+	return len(fields) > 0 && strings.EqualFold(strings.TrimSuffix(fields[0], ";"), "EXPLAIN")
+}
+
+/*
+Parse parses the text returned for an EXPLAIN statement into operator trees. The text consists of sections like
+
+	== Optimized Execution Plan ==
+	Sink(table=[...])
+	+- Join(joinType=[InnerJoin])
+	   :- Exchange(distribution=[hash[id]])
+	   :  +- TableSourceScan(table=[[orders]])
+	   +- Exchange(distribution=[hash[id]])
+	      +- TableSourceScan(table=[[customers]])
+
+where every level of the tree is indented by three characters. Sections without operators, such as the list of
+physical properties, are skipped. The boolean is false if the text doesn't contain a single operator.
+*/
+func Parse(text string) ([]Section, bool) {
+	var sections []Section
+	var stack []*Operator
+
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimRight(line, " \r\t")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		if title, ok := parseTitle(line); ok {
+			sections = append(sections, Section{Title: title})
+			stack = nil
+			continue
+		}
+
+		depth, operator, ok := parseOperator(line)
+		if !ok {
+			// A line which continues the details of the previous operator
+			if len(stack) > 0 {
+				previous := stack[len(stack)-1]
+				continuation := strings.TrimSuffix(strings.TrimSpace(strings.TrimLeft(line, " :|")), ")")
+				previous.Details = strings.TrimSpace(previous.Details + " " + continuation)
+			}
+			continue
+		}
+
+		if len(sections) == 0 {
+			sections = append(sections, Section{})
+		}
+
+		if depth > len(stack) {
+			depth = len(stack)
+		}
+		stack = stack[:depth]
+		if depth == 0 {
+			current := &sections[len(sections)-1]
+			current.Operators = append(current.Operators, operator)
+		} else {
+			parent := stack[depth-1]
+			parent.Children = append(parent.Children, operator)
+		}
+		stack = append(stack, operator)
+	}
+
+	var withOperators []Section
+	for _, section := range sections {
+		if len(section.Operators) > 0 {
+			withOperators = append(withOperators, section)
+		}
+	}
+	return withOperators, len(withOperators) > 0
+}
+
+func parseTitle(line string) (string, bool) {
+	trimmed := strings.TrimSpace(line)
+	if strings.HasPrefix(trimmed, "==") && strings.HasSuffix(trimmed, "==") && len(trimmed) > 4 {
+		return strings.TrimSpace(strings.Trim(trimmed, "=")), true
+	}
+	return "", false
+}
+
+// parseOperator parses a line like ":  +- Exchange(distribution=[hash[id]])" into its depth and operator
+func parseOperator(line string) (int, *Operator, bool) {
+	start := strings.IndexFunc(line, func(r rune) bool { return unicode.IsLetter(r) })
+	if start < 0 {
+		return 0, nil, false
+	}
+
+	prefix := line[:start]
+	if strings.Trim(prefix, " :+-|") != "" {
+		return 0, nil, false
+	}
+	// Lines of nested operators end with "+- " or ":- ", anything else continues the previous operator
+	if prefix != "" && !strings.HasSuffix(prefix, "- ") {
+		return 0, nil, false
+	}
+
+	rest := line[start:]
+	name, details := rest, ""
+	if i := strings.Index(rest, "("); i > 0 {
+		name = rest[:i]
+		details = strings.TrimSuffix(rest[i+1:], ")")
+	}
+	if strings.ContainsAny(name, " =") {
+		return 0, nil, false
+	}
+
+	return len(prefix) / 3, &Operator{Name: name, Details: details, Kind: classify(name)}, true
+}
+
+func classify(name string) OperatorKind {
+	base := operatorPrefixRegex.ReplaceAllString(name, "")
+	if base == "Exchange" || base == "Shuffle" {
+		return ExchangeOperator
+	}
+	for _, operator := range statefulOperators {
+		if strings.HasSuffix(base, operator) {
+			return StatefulOperator
+		}
+	}
+	return RegularOperator
+}
@@ -0,0 +1,103 @@
+package explain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const joinPlan = `== Abstract Syntax Tree ==
+LogicalSink(table=[default_catalog.default_database.sink], fields=[id, cnt])
++- LogicalAggregate(group=[{0}], cnt=[COUNT()])
+   +- LogicalJoin(condition=[=($0, $1)], joinType=[inner])
+      :- LogicalTableScan(table=[[default_catalog, default_database, orders]])
+      +- LogicalTableScan(table=[[default_catalog, default_database, customers]])
+
+== Optimized Physical Plan ==
+Sink(table=[default_catalog.default_database.sink], fields=[id, cnt])
++- GroupAggregate(groupBy=[id], select=[id, COUNT(*) AS cnt])
+   +- Exchange(distribution=[hash[id]])
+      +- Join(joinType=[InnerJoin], where=[=(id, customer_id)],
+         select=[id, customer_id])
+         :- Exchange(distribution=[hash[id]])
+         :  +- TableSourceScan(table=[[default_catalog, default_database, orders]], fields=[id])
+         +- Exchange(distribution=[hash[customer_id]])
+            +- Calc(select=[customer_id])
+               +- TableSourceScan(table=[[default_catalog, default_database, customers]], fields=[customer_id])
+`
+
+func TestParse(t *testing.T) {
+	sections, ok := Parse(joinPlan)
+	require.True(t, ok)
+	require.Len(t, sections, 2)
+	require.Equal(t, "Abstract Syntax Tree", sections[0].Title)
+	require.Equal(t, "Optimized Physical Plan", sections[1].Title)
+
+	sink := sections[1].Operators[0]
+	require.Equal(t, "Sink", sink.Name)
+	require.Equal(t, "table=[default_catalog.default_database.sink], fields=[id, cnt]", sink.Details)
+	require.Equal(t, RegularOperator, sink.Kind)
+
+	aggregate := sink.Children[0]
+	require.Equal(t, "GroupAggregate", aggregate.Name)
+	require.Equal(t, StatefulOperator, aggregate.Kind)
+
+	exchange := aggregate.Children[0]
+	require.Equal(t, ExchangeOperator, exchange.Kind)
+
+	join := exchange.Children[0]
+	require.Equal(t, "Join", join.Name)
+	require.Equal(t, StatefulOperator, join.Kind)
+	require.Equal(t, "joinType=[InnerJoin], where=[=(id, customer_id)], select=[id, customer_id]", join.Details)
+	require.Len(t, join.Children, 2)
+	require.Equal(t, "TableSourceScan", join.Children[0].Children[0].Name)
+	require.Equal(t, "Calc", join.Children[1].Children[0].Name)
+	require.Equal(t, "TableSourceScan", join.Children[1].Children[0].Children[0].Name)
+
+	logicalJoin := sections[0].Operators[0].Children[0].Children[0]
+	require.Equal(t, "LogicalJoin", logicalJoin.Name)
+	require.Equal(t, StatefulOperator, logicalJoin.Kind)
+	require.Len(t, logicalJoin.Children, 2)
+}
+
+func TestParseWithoutPlan(t *testing.T) {
+	for _, text := range []string{"", "1 row", "== Physical Properties ==\n\n"} {
+		_, ok := Parse(text)
+		require.False(t, ok, text)
+	}
+}
+
+func TestParseWithoutTitle(t *testing.T) {
+	sections, ok := Parse("Sink(table=[sink])\n+- TableSourceScan(table=[[orders]])")
+	require.True(t, ok)
+	require.Len(t, sections, 1)
+	require.Equal(t, "", sections[0].Title)
+	require.Len(t, sections[0].Operators[0].Children, 1)
+}
+
+func TestClassify(t *testing.T) {
+	cases := map[string]OperatorKind{
+		"Exchange":                    ExchangeOperator,
+		"StreamExecExchange":          ExchangeOperator,
+		"GroupAggregate":              StatefulOperator,
+		"StreamPhysicalOverAggregate": StatefulOperator,
+		"IntervalJoin":                StatefulOperator,
+		"WindowRank":                  StatefulOperator,
+		"ChangelogNormalize":          StatefulOperator,
+		"Deduplicate":                 StatefulOperator,
+		"Calc":                        RegularOperator,
+		"TableSourceScan":             RegularOperator,
+		"WatermarkAssigner":           RegularOperator,
+	}
+
+	for name, expected := range cases {
+		require.Equal(t, expected, classify(name), name)
+	}
+}
+
+func TestIsExplainStatement(t *testing.T) {
+	require.True(t, IsExplainStatement("EXPLAIN SELECT 1;"))
+	require.True(t, IsExplainStatement("  explain\nSELECT 1;"))
+	require.False(t, IsExplainStatement("SELECT 'EXPLAIN';"))
+	require.False(t, IsExplainStatement(""))
+}
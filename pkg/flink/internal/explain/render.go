@@ -0,0 +1,80 @@
+package explain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Label returns the text shown for the operator in a tree, marking exchanges and stateful operators
+func (o *Operator) Label() string {
+	label := o.Name
+	if o.Details != "" {
+		label = fmt.Sprintf("%s(%s)", o.Name, o.Details)
+	}
+
+	switch o.Kind {
+	case ExchangeOperator:
+		return label + " [exchange]"
+	case StatefulOperator:
+		return label + " [stateful]"
+	default:
+		return label
+	}
+}
+
+// Render draws the operator trees of all sections. The style function is applied to the label of every operator,
+// so callers can highlight operators, for example with terminal colors.
+func Render(sections []Section, style func(operator *Operator, label string) string) string {
+	if style == nil {
+		style = func(_ *Operator, label string) string { return label }
+	}
+
+	var sb strings.Builder
+	for i, section := range sections {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		if section.Title != "" {
+			sb.WriteString(fmt.Sprintf("== %s ==\n", section.Title))
+		}
+		for _, operator := range section.Operators {
+			sb.WriteString(style(operator, operator.Label()) + "\n")
+			renderChildren(&sb, operator.Children, "", style)
+		}
+	}
+	return sb.String()
+}
+
+func renderChildren(sb *strings.Builder, children []*Operator, indent string, style func(*Operator, string) string) {
+	for i, child := range children {
+		branch, nextIndent := "├── ", "│   "
+		if i == len(children)-1 {
+			branch, nextIndent = "└── ", "    "
+		}
+		sb.WriteString(indent + branch + style(child, child.Label()) + "\n")
+		renderChildren(sb, child.Children, indent+nextIndent, style)
+	}
+}
+
+// Summary counts the exchanges and stateful operators of all sections, which are the usual suspects for costly plans
+func Summary(sections []Section) (int, int) {
+	var exchanges, stateful int
+	var count func(operators []*Operator)
+	count = func(operators []*Operator) {
+		for _, operator := range operators {
+			switch operator.Kind {
+			case ExchangeOperator:
+				exchanges++
+			case StatefulOperator:
+				stateful++
+			}
+			count(operator.Children)
+		}
+	}
+
+	// The abstract syntax tree is a logical plan, so only the last section reflects what is executed
+	if len(sections) > 0 {
+		count(sections[len(sections)-1].Operators)
+	}
+	return exchanges, stateful
+}
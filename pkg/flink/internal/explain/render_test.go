@@ -0,0 +1,52 @@
+package explain
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	sections, ok := Parse(joinPlan)
+	require.True(t, ok)
+
+	expected := `== Abstract Syntax Tree ==
+LogicalSink(table=[default_catalog.default_database.sink], fields=[id, cnt])
+└── LogicalAggregate(group=[{0}], cnt=[COUNT()]) [stateful]
+    └── LogicalJoin(condition=[=($0, $1)], joinType=[inner]) [stateful]
+        ├── LogicalTableScan(table=[[default_catalog, default_database, orders]])
+        └── LogicalTableScan(table=[[default_catalog, default_database, customers]])
+
+== Optimized Physical Plan ==
+Sink(table=[default_catalog.default_database.sink], fields=[id, cnt])
+└── GroupAggregate(groupBy=[id], select=[id, COUNT(*) AS cnt]) [stateful]
+    └── Exchange(distribution=[hash[id]]) [exchange]
+        └── Join(joinType=[InnerJoin], where=[=(id, customer_id)], select=[id, customer_id]) [stateful]
+            ├── Exchange(distribution=[hash[id]]) [exchange]
+            │   └── TableSourceScan(table=[[default_catalog, default_database, orders]], fields=[id])
+            └── Exchange(distribution=[hash[customer_id]]) [exchange]
+                └── Calc(select=[customer_id])
+                    └── TableSourceScan(table=[[default_catalog, default_database, customers]], fields=[customer_id])
+`
+	require.Equal(t, expected, Render(sections, nil))
+}
+
+func TestRenderWithStyle(t *testing.T) {
+	sections, ok := Parse("Sink(table=[sink])\n+- Exchange(distribution=[single])")
+	require.True(t, ok)
+
+	style := func(operator *Operator, label string) string {
+		return fmt.Sprintf("<%d>%s", operator.Kind, label)
+	}
+	require.Equal(t, "<0>Sink(table=[sink])\n└── <1>Exchange(distribution=[single]) [exchange]\n", Render(sections, style))
+}
+
+func TestSummary(t *testing.T) {
+	sections, ok := Parse(joinPlan)
+	require.True(t, ok)
+
+	exchanges, stateful := Summary(sections)
+	require.Equal(t, 3, exchanges)
+	require.Equal(t, 2, stateful)
+}
@@ -0,0 +1,329 @@
+package formatting
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/confluentinc/cli/v3/pkg/flink/internal/highlighting"
+	"github.com/confluentinc/cli/v3/pkg/types"
+)
+
+const indentation = "  "
+
+// Reserved words can't be used as unquoted identifiers, so they never start a function call or end an expression
+var reservedKeywords = types.NewSet(
+	"ALL", "ALTER", "AND", "AS", "ASC", "BETWEEN", "BY", "CASE", "CAST", "CATALOG", "CREATE", "CROSS", "DATABASE",
+	"DESC", "DESCRIBE", "DISTINCT", "DROP", "ELSE", "END", "EXCEPT", "EXISTS", "EXPLAIN", "FALSE", "FOR", "FROM",
+	"FULL", "FUNCTION", "GROUP", "HAVING", "IF", "IN", "INNER", "INSERT", "INTERSECT", "INTERVAL", "INTO", "IS", "JOIN",
+	"LATERAL", "LEFT", "LIKE", "LIMIT", "NATURAL", "NOT", "NULL", "OF", "ON", "OR", "ORDER", "OUTER", "OVER",
+	"PARTITION", "PRIMARY", "RESET", "RIGHT", "SELECT", "SET", "SHOW", "SYSTEM_TIME", "TABLE", "THEN", "TRUE", "UNION", "UNNEST",
+	"USE", "VALUES", "VIEW", "WATERMARK", "WHEN", "WHERE", "WINDOW", "WITH",
+)
+
+// Clauses which start on a new line when they are part of a query
+var clauseKeywords = types.NewSet(
+	"CROSS", "EXCEPT", "FROM", "FULL", "GROUP", "HAVING", "INNER", "INTERSECT", "JOIN", "LEFT", "LIMIT", "NATURAL",
+	"ORDER", "RIGHT", "SELECT", "UNION", "VALUES", "WHERE", "WINDOW", "WITH",
+)
+
+// Reserved keywords which are called like functions, so no space is added before the opening parenthesis
+var functionKeywords = types.NewSet("CAST")
+
+// Non-reserved keywords which are followed by a parenthesized list instead of being called like functions
+var listKeywords = types.NewSet("KEY")
+
+var joinModifiers = types.NewSet("CROSS", "FULL", "INNER", "LEFT", "NATURAL", "OUTER", "RIGHT")
+
+type frameKind int
+
+const (
+	inlineFrame frameKind = iota
+	queryFrame
+	listFrame
+)
+
+type frame struct {
+	kind                frameKind
+	indent              int
+	inSelectList        bool
+	multilineSelectList bool
+}
+
+type formatter struct {
+	sb          strings.Builder
+	tokens      []highlighting.Token
+	frames      []*frame
+	previous    *highlighting.Token
+	pendingLine int
+	noSpace     bool
+	isCreate    bool
+}
+
+// Format pretty-prints one or more SQL statements. Keywords are upper-cased, every clause of a query starts on a new
+// line, and select lists, subqueries as well as column and option lists of CREATE statements are indented. Comments
+// are preserved. SQL containing unterminated quotes, comments or variable references is rejected, since it can't be
+// formatted safely.
+func Format(sql string) (string, error) {
+	tokens := highlighting.Tokenize(sql)
+	for _, token := range tokens {
+		if token.Unterminated {
+			return "", fmt.Errorf("unterminated %s at line %d, column %d", describeKind(token.Kind), token.Line, token.Column)
+		}
+	}
+
+	var statements []string
+	for _, statement := range SplitStatements(tokens) {
+		f := &formatter{tokens: statement, frames: []*frame{{kind: queryFrame}}, pendingLine: -1, isCreate: isCreateStatement(statement)}
+		if formatted := f.format(); formatted != "" {
+			statements = append(statements, formatted)
+		}
+	}
+	if len(statements) == 0 {
+		return "", nil
+	}
+
+	return strings.Join(statements, "\n\n") + "\n", nil
+}
+
+// SplitStatements splits tokens into statements at every terminator; the terminator is kept with its statement
+func SplitStatements(tokens []highlighting.Token) [][]highlighting.Token {
+	var statements [][]highlighting.Token
+	var current []highlighting.Token
+	for _, token := range tokens {
+		current = append(current, token)
+		if token.Kind == highlighting.TerminatorToken {
+			statements = append(statements, current)
+			current = nil
+		}
+	}
+	if len(current) > 0 {
+		statements = append(statements, current)
+	}
+	return statements
+}
+
+func (f *formatter) format() string {
+	for i, token := range f.tokens {
+		switch token.Kind {
+		case highlighting.WhitespaceToken:
+			continue
+		case highlighting.LineCommentToken:
+			f.write(token, strings.TrimRight(token.Text, " \t\r"))
+			f.pendingLine = f.currentFrame().indent
+		case highlighting.KeywordToken:
+			f.writeKeyword(i, token)
+		case highlighting.PunctuationToken:
+			f.writePunctuation(i, token)
+		case highlighting.TerminatorToken:
+			f.noSpace = true
+			f.write(token, token.Text)
+		default:
+			f.write(token, token.Text)
+		}
+	}
+	return strings.TrimSpace(f.sb.String())
+}
+
+func (f *formatter) writeKeyword(i int, token highlighting.Token) {
+	upper := strings.ToUpper(token.Text)
+	text := upper
+	// Keywords in qualified names, such as the column of t.key, are identifiers, which are case-sensitive
+	if f.previous != nil && f.previous.Text == "." || isQualified(f.tokens[i+1:]) {
+		text = token.Text
+	}
+
+	current := f.currentFrame()
+
+	if clauseKeywords.Contains(upper) && current.kind == queryFrame && f.previous != nil && !f.isClauseContinuation(upper) {
+		current.inSelectList = false
+		f.newLine(current.indent)
+	}
+
+	f.write(token, text)
+
+	if upper == "SELECT" && current.kind == queryFrame {
+		current.inSelectList = true
+		current.multilineSelectList = hasTopLevelComma(f.tokens[i+1:])
+		if current.multilineSelectList {
+			f.pendingLine = current.indent + 1
+		}
+	}
+}
+
+// isClauseContinuation returns true for keywords that don't start a new clause, such as JOIN in LEFT OUTER JOIN
+func (f *formatter) isClauseContinuation(keyword string) bool {
+	previous := strings.ToUpper(f.previous.Text)
+	switch keyword {
+	case "JOIN":
+		return joinModifiers.Contains(previous)
+	default:
+		return joinModifiers.Contains(previous) && joinModifiers.Contains(keyword)
+	}
+}
+
+func (f *formatter) writePunctuation(i int, token highlighting.Token) {
+	current := f.currentFrame()
+	switch token.Text {
+	case "(":
+		kind := inlineFrame
+		if isQueryStart(f.tokens[i+1:]) {
+			kind = queryFrame
+		} else if f.isCreate && len(f.frames) == 1 {
+			kind = listFrame
+		}
+
+		if kind != inlineFrame && f.previous != nil {
+			f.noSpace = false
+		} else if f.previous != nil && isCallable(*f.previous) {
+			f.noSpace = true
+		}
+		f.write(token, token.Text)
+		f.noSpace = true
+
+		indent := current.indent
+		if kind != inlineFrame {
+			indent++
+		}
+		f.frames = append(f.frames, &frame{kind: kind, indent: indent})
+		if kind == listFrame {
+			f.pendingLine = indent
+		}
+	case ")":
+		if len(f.frames) > 1 {
+			f.frames = f.frames[:len(f.frames)-1]
+		}
+		if current.kind != inlineFrame {
+			f.newLine(f.currentFrame().indent)
+		} else {
+			f.noSpace = true
+		}
+		f.write(token, token.Text)
+	case ",":
+		f.noSpace = true
+		f.write(token, token.Text)
+		if current.kind == listFrame {
+			f.pendingLine = current.indent
+		} else if current.inSelectList && current.multilineSelectList {
+			f.pendingLine = current.indent + 1
+		}
+	case ".", "[":
+		f.noSpace = true
+		f.write(token, token.Text)
+		f.noSpace = true
+	case "]":
+		f.noSpace = true
+		f.write(token, token.Text)
+	case "-", "+":
+		isUnary := f.previous == nil || f.previous.Kind == highlighting.PunctuationToken && f.previous.Text != ")" ||
+			f.previous.Kind == highlighting.KeywordToken && reservedKeywords.Contains(strings.ToUpper(f.previous.Text))
+		f.write(token, token.Text)
+		f.noSpace = isUnary
+	default:
+		f.write(token, token.Text)
+	}
+}
+
+func (f *formatter) write(token highlighting.Token, text string) {
+	// A line comment always ends the line, otherwise the terminator and DISTINCT or ALL stay on the same line
+	afterLineComment := f.previous != nil && f.previous.Kind == highlighting.LineCommentToken
+	stayOnLine := !afterLineComment && (token.Kind == highlighting.TerminatorToken || isSelectModifier(token))
+	if f.pendingLine >= 0 && !stayOnLine {
+		f.newLine(f.pendingLine)
+	} else if f.sb.Len() > 0 && !f.noSpace && !strings.HasSuffix(f.sb.String(), "\n") && !strings.HasSuffix(f.sb.String(), " ") {
+		f.sb.WriteString(" ")
+	}
+	f.sb.WriteString(text)
+	f.noSpace = false
+	f.previous = &token
+}
+
+func (f *formatter) newLine(indent int) {
+	if f.sb.Len() > 0 {
+		f.sb.WriteString("\n")
+	}
+	f.sb.WriteString(strings.Repeat(indentation, indent))
+	f.pendingLine = -1
+	f.noSpace = true
+}
+
+func (f *formatter) currentFrame() *frame {
+	return f.frames[len(f.frames)-1]
+}
+
+func isCreateStatement(tokens []highlighting.Token) bool {
+	for _, token := range tokens {
+		if token.IsSignificant() {
+			return token.IsKeyword("CREATE")
+		}
+	}
+	return false
+}
+
+// isCallable returns true for identifiers and function names, which are directly followed by their opening parenthesis
+func isCallable(token highlighting.Token) bool {
+	switch token.Kind {
+	case highlighting.WordToken, highlighting.QuotedIdentifierToken:
+		return true
+	case highlighting.KeywordToken:
+		upper := strings.ToUpper(token.Text)
+		return !reservedKeywords.Contains(upper) && !listKeywords.Contains(upper) || functionKeywords.Contains(upper)
+	default:
+		return false
+	}
+}
+
+// isQualified returns true if the tokens start with a ".", which follows a table or catalog name
+func isQualified(tokens []highlighting.Token) bool {
+	return len(tokens) > 0 && tokens[0].Text == "."
+}
+
+func isSelectModifier(token highlighting.Token) bool {
+	return token.IsKeyword("DISTINCT") || token.IsKeyword("ALL")
+}
+
+func isQueryStart(tokens []highlighting.Token) bool {
+	for _, token := range tokens {
+		if token.IsSignificant() {
+			return token.IsKeyword("SELECT") || token.IsKeyword("WITH") || token.IsKeyword("VALUES")
+		}
+	}
+	return false
+}
+
+// hasTopLevelComma returns true if the select list starting at the given tokens contains more than one item
+func hasTopLevelComma(tokens []highlighting.Token) bool {
+	depth := 0
+	for _, token := range tokens {
+		switch {
+		case token.Text == "(":
+			depth++
+		case token.Text == ")":
+			if depth == 0 {
+				return false
+			}
+			depth--
+		case token.Text == "," && depth == 0:
+			return true
+		case token.Kind == highlighting.TerminatorToken:
+			return false
+		case token.Kind == highlighting.KeywordToken && depth == 0 && clauseKeywords.Contains(strings.ToUpper(token.Text)):
+			return false
+		}
+	}
+	return false
+}
+
+func describeKind(kind highlighting.TokenKind) string {
+	switch kind {
+	case highlighting.StringToken:
+		return "string literal"
+	case highlighting.QuotedIdentifierToken:
+		return "quoted identifier"
+	case highlighting.BlockCommentToken:
+		return "comment"
+	case highlighting.WordToken:
+		return "variable reference"
+	default:
+		return "token"
+	}
+}
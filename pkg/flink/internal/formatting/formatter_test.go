@@ -0,0 +1,109 @@
+package formatting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/confluentinc/cli/v3/pkg/flink/types"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		sql      string
+		expected string
+	}{
+		{
+			name:     "simple select",
+			sql:      "select * from orders",
+			expected: "SELECT *\nFROM orders\n",
+		},
+		{
+			name: "select list with join and functions",
+			sql:  "select a, t.b, count(*) as cnt from `my table` t left outer join u on t.id=u.id where x>-1 and y in (1,2) group by a, t.b order by cnt desc limit 10;",
+			expected: "SELECT\n  a,\n  t.b,\n  COUNT(*) AS cnt\nFROM `my table` t\nLEFT OUTER JOIN u ON t.id = u.id\nWHERE x > -1 AND y IN (1, 2)\n" +
+				"GROUP BY a, t.b\nORDER BY cnt DESC\nLIMIT 10;\n",
+		},
+		{
+			name:     "insert with subquery and comment",
+			sql:      "-- comment\ninsert into sink select distinct a from (select a from src where name = 'it''s') as s;",
+			expected: "-- comment\nINSERT INTO sink\nSELECT DISTINCT a\nFROM (\n  SELECT a\n  FROM src\n  WHERE name = 'it''s'\n) AS s;\n",
+		},
+		{
+			name: "create table",
+			sql:  "create table t (a int, b decimal(10, 2), primary key (a) not enforced) with ('connector'='kafka');",
+			expected: "CREATE TABLE t (\n  a INT,\n  b DECIMAL(10, 2),\n  PRIMARY KEY (a) NOT ENFORCED\n)\n" +
+				"WITH (\n  'connector' = 'kafka'\n);\n",
+		},
+		{
+			name:     "multiple statements",
+			sql:      "set 'client.var.env'='prod';use catalog my_catalog;select cast(a as string), arr[1] from t",
+			expected: "SET 'client.var.env' = 'prod';\n\nUSE CATALOG my_catalog;\n\nSELECT\n  CAST(a AS STRING),\n  arr[1]\nFROM t\n",
+		},
+		{
+			name:     "keywords in qualified names",
+			sql:      "select t.key, `value` from catalog.t",
+			expected: "SELECT\n  t.key,\n  `value`\nFROM catalog.t\n",
+		},
+		{
+			name:     "set operators",
+			sql:      "select a from t union all select b from u except select c from v",
+			expected: "SELECT a\nFROM t\nUNION ALL\nSELECT b\nFROM u\nEXCEPT\nSELECT c\nFROM v\n",
+		},
+		{
+			name:     "variable references",
+			sql:      "select ${column} from ${catalog}.db.t where env = '${env}'",
+			expected: "SELECT ${column}\nFROM ${catalog}.db.t\nWHERE env = '${env}'\n",
+		},
+		{
+			name:     "line comment before terminator",
+			sql:      "select 1 -- done\n;",
+			expected: "SELECT 1 -- done\n;\n",
+		},
+		{
+			name:     "empty",
+			sql:      " \n",
+			expected: "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			formatted, err := Format(test.sql)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, formatted)
+
+			// formatting is idempotent
+			formattedAgain, err := Format(formatted)
+			require.NoError(t, err)
+			require.Equal(t, formatted, formattedAgain)
+		})
+	}
+}
+
+func TestFormatPreservesVariables(t *testing.T) {
+	sql := "insert into ${sink} select ${column} from t;"
+	formatted, err := Format(sql)
+	require.NoError(t, err)
+
+	variables := map[string]string{"sink": "orders", "column": "id"}
+	expected, statementErr := types.SubstituteVariables(sql, variables)
+	require.Nil(t, statementErr)
+	substituted, statementErr := types.SubstituteVariables(formatted, variables)
+	require.Nil(t, statementErr)
+
+	expectedFormatted, err := Format(expected)
+	require.NoError(t, err)
+	require.Equal(t, expectedFormatted, substituted)
+}
+
+func TestFormatFailsOnUnterminatedString(t *testing.T) {
+	_, err := Format("SELECT 'it's' FROM t;")
+	require.EqualError(t, err, "unterminated string literal at line 1, column 13")
+}
+
+func TestFormatFailsOnUnterminatedVariable(t *testing.T) {
+	_, err := Format("SELECT ${column FROM t;")
+	require.EqualError(t, err, "unterminated variable reference at line 1, column 8")
+}
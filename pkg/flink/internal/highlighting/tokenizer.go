@@ -0,0 +1,285 @@
+package highlighting
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/confluentinc/cli/v3/pkg/flink/config"
+	"github.com/confluentinc/cli/v3/pkg/flink/internal/utils"
+)
+
+type TokenKind int
+
+const (
+	WhitespaceToken TokenKind = iota
+	KeywordToken
+	WordToken
+	StringToken
+	QuotedIdentifierToken
+	LineCommentToken
+	BlockCommentToken
+	PunctuationToken
+	TerminatorToken
+)
+
+type Token struct {
+	Kind         TokenKind
+	Text         string
+	Line         int
+	Column       int
+	Unterminated bool
+}
+
+// IsSignificant returns false for whitespace and comments
+func (t Token) IsSignificant() bool {
+	return t.Kind != WhitespaceToken && t.Kind != LineCommentToken && t.Kind != BlockCommentToken
+}
+
+// IsKeyword returns true if the token is the given keyword, ignoring case
+func (t Token) IsKeyword(keyword string) bool {
+	return t.Kind == KeywordToken && strings.EqualFold(t.Text, keyword)
+}
+
+// Operators which are split into several words by the Lexer, or which are part of a word
+var multiCharOperators = []string{"<>", "<=", ">=", "!=", "||"}
+
+type tokenizer struct {
+	tokens []Token
+	// open is a string literal, quoted identifier or comment which may continue in the next word
+	open *Token
+	// inVariable is true while the last token is a variable reference, such as ${name}, which is missing its "}"
+	inVariable   bool
+	line, column int
+}
+
+// Tokenize splits a SQL string into tokens. It builds on the words of the Lexer, and joins the words of string
+// literals, quoted identifiers, comments and variable references, so that the tokens can be used for formatting and
+// linting. Concatenating the text of all tokens yields the input.
+func Tokenize(sql string) []Token {
+	t := &tokenizer{line: 1, column: 1}
+	for _, word := range splitWithSeparators(sql) {
+		t.scanWord([]rune(word))
+	}
+	t.close()
+	if t.inVariable {
+		t.last().Unterminated = true
+	}
+	return t.tokens
+}
+
+func (t *tokenizer) scanWord(word []rune) {
+	for i := 0; i < len(word); {
+		if t.open != nil {
+			i = t.continueOpen(word, i)
+			continue
+		}
+		if t.inVariable {
+			i = t.continueVariable(word, i)
+			continue
+		}
+
+		start := i
+		kind := PunctuationToken
+		switch char := word[i]; {
+		case unicode.IsSpace(char):
+			for i < len(word) && unicode.IsSpace(word[i]) {
+				i++
+			}
+			// The Lexer splits every separator into its own word, so consecutive whitespace is joined
+			if last := t.last(); last != nil && last.Kind == WhitespaceToken {
+				t.extend(last, string(word[start:i]))
+				continue
+			}
+			kind = WhitespaceToken
+		case char == ';':
+			i++
+			kind = TerminatorToken
+		case char == '{' && t.isVariableStart():
+			// The "{" of a variable reference joins the word ending in "$", so that ${name} is kept together
+			t.extend(t.last(), "{")
+			t.inVariable = true
+			i = t.continueVariable(word, i+1)
+			continue
+		case char == '\'' || char == '`' || char == '"':
+			t.start(StringToken, word[i:i+1])
+			if char != '\'' {
+				t.open.Kind = QuotedIdentifierToken
+			}
+			i = t.continueOpen(word, i+1)
+			continue
+		case strings.HasPrefix(string(word[i:]), "--"):
+			t.start(LineCommentToken, word[i:i+2])
+			i = t.continueOpen(word, i+2)
+			continue
+		case strings.HasPrefix(string(word[i:]), "/*"):
+			t.start(BlockCommentToken, word[i:i+2])
+			i = t.continueOpen(word, i+2)
+			continue
+		case isWordRune(char):
+			for i < len(word) && isWordRune(word[i]) {
+				i++
+			}
+			kind = WordToken
+			if config.SQLKeywords.Contains(strings.ToUpper(string(word[start:i]))) {
+				kind = KeywordToken
+			}
+		default:
+			i++
+			// The Lexer splits "<" and ">" from "=" and from each other, so they are joined with the previous operator
+			if last := t.last(); last != nil && last.Kind == PunctuationToken && isMultiCharOperator(last.Text+string(char)) {
+				t.extend(last, string(char))
+				continue
+			}
+			for _, operator := range multiCharOperators {
+				if strings.HasPrefix(string(word[start:]), operator) {
+					i = start + len(operator)
+					break
+				}
+			}
+		}
+
+		t.append(Token{Kind: kind, Text: string(word[start:i])})
+	}
+}
+
+// continueOpen adds the characters of the word to the open token until it is closed, and returns the index after them
+func (t *tokenizer) continueOpen(word []rune, i int) int {
+	start := i
+	switch t.open.Kind {
+	case LineCommentToken:
+		for i < len(word) && word[i] != '\n' {
+			i++
+		}
+		t.open.Text += string(word[start:i])
+		if i < len(word) {
+			t.close()
+		}
+		return i
+	case BlockCommentToken:
+		for i < len(word) {
+			t.open.Text += string(word[i])
+			i++
+			if isClosedBlockComment(t.open.Text) {
+				t.close()
+				return i
+			}
+		}
+		return i
+	default:
+		quote := []rune(t.open.Text)[0]
+		for ; i < len(word); i++ {
+			if word[i] != quote {
+				continue
+			}
+			// A doubled quote is an escaped quote; the Lexer never splits it, since quotes aren't separators
+			if i+1 < len(word) && word[i+1] == quote {
+				i++
+				continue
+			}
+			t.open.Text += string(word[start : i+1])
+			t.close()
+			return i + 1
+		}
+	}
+	t.open.Text += string(word[start:])
+	return len(word)
+}
+
+// continueVariable adds the characters of the word to the variable reference up to its closing "}", and returns the
+// index after them
+func (t *tokenizer) continueVariable(word []rune, i int) int {
+	start := i
+	for i < len(word) && word[i] != '}' {
+		i++
+	}
+	if i < len(word) {
+		i++
+		t.inVariable = false
+	}
+	t.extend(t.last(), string(word[start:i]))
+	return i
+}
+
+// isVariableStart returns true if the last token is a word ending in "$", which starts a variable reference with "{"
+func (t *tokenizer) isVariableStart() bool {
+	last := t.last()
+	return last != nil && last.Kind == WordToken && strings.HasSuffix(last.Text, "$")
+}
+
+func (t *tokenizer) start(kind TokenKind, text []rune) {
+	t.open = &Token{Kind: kind, Text: string(text), Line: t.line, Column: t.column}
+}
+
+// close appends the open token, which is unterminated if the input ended before its closing quote or "*/"
+func (t *tokenizer) close() {
+	if t.open == nil {
+		return
+	}
+	token := *t.open
+	t.open = nil
+
+	switch token.Kind {
+	case StringToken:
+		token.Unterminated = !isEnclosed(token.Text, "'") || utils.ContainsUnescapedSingleQuote(token.Text[1:len(token.Text)-1])
+	case QuotedIdentifierToken:
+		quote := token.Text[:1]
+		token.Unterminated = !isEnclosed(token.Text, quote) || strings.Contains(strings.ReplaceAll(token.Text[1:len(token.Text)-1], quote+quote, ""), quote)
+	case BlockCommentToken:
+		token.Unterminated = !isClosedBlockComment(token.Text)
+	}
+
+	t.append(token)
+}
+
+func (t *tokenizer) append(token Token) {
+	if token.Line == 0 {
+		token.Line, token.Column = t.line, t.column
+	}
+	t.tokens = append(t.tokens, token)
+	t.advance(token.Text)
+}
+
+func (t *tokenizer) extend(token *Token, text string) {
+	token.Text += text
+	t.advance(text)
+}
+
+func (t *tokenizer) advance(text string) {
+	for _, char := range text {
+		if char == '\n' {
+			t.line++
+			t.column = 1
+		} else {
+			t.column++
+		}
+	}
+}
+
+func (t *tokenizer) last() *Token {
+	if len(t.tokens) == 0 {
+		return nil
+	}
+	return &t.tokens[len(t.tokens)-1]
+}
+
+// isClosedBlockComment returns true if the comment ends with "*/", which can't share its "*" with the opening "/*"
+func isClosedBlockComment(text string) bool {
+	return len(text) >= 4 && strings.HasSuffix(text, "*/")
+}
+
+func isEnclosed(text, quote string) bool {
+	return len(text) >= 2 && strings.HasPrefix(text, quote) && strings.HasSuffix(text, quote)
+}
+
+func isMultiCharOperator(text string) bool {
+	for _, operator := range multiCharOperators {
+		if text == operator {
+			return true
+		}
+	}
+	return false
+}
+
+func isWordRune(char rune) bool {
+	return unicode.IsLetter(char) || unicode.IsDigit(char) || char == '_' || char == '$'
+}
@@ -0,0 +1,65 @@
+package highlighting
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+func TestTokenize(t *testing.T) {
+	tokens := Tokenize("SELECT `my col`, 'it''s' -- comment\nFROM t /* block */;")
+
+	expected := []Token{
+		{Kind: KeywordToken, Text: "SELECT", Line: 1, Column: 1},
+		{Kind: WhitespaceToken, Text: " ", Line: 1, Column: 7},
+		{Kind: QuotedIdentifierToken, Text: "`my col`", Line: 1, Column: 8},
+		{Kind: PunctuationToken, Text: ",", Line: 1, Column: 16},
+		{Kind: WhitespaceToken, Text: " ", Line: 1, Column: 17},
+		{Kind: StringToken, Text: "'it''s'", Line: 1, Column: 18},
+		{Kind: WhitespaceToken, Text: " ", Line: 1, Column: 25},
+		{Kind: LineCommentToken, Text: "-- comment", Line: 1, Column: 26},
+		{Kind: WhitespaceToken, Text: "\n", Line: 1, Column: 36},
+		{Kind: KeywordToken, Text: "FROM", Line: 2, Column: 1},
+		{Kind: WhitespaceToken, Text: " ", Line: 2, Column: 5},
+		{Kind: WordToken, Text: "t", Line: 2, Column: 6},
+		{Kind: WhitespaceToken, Text: " ", Line: 2, Column: 7},
+		{Kind: BlockCommentToken, Text: "/* block */", Line: 2, Column: 8},
+		{Kind: TerminatorToken, Text: ";", Line: 2, Column: 19},
+	}
+	require.Equal(t, expected, tokens)
+}
+
+func TestTokenizeOperators(t *testing.T) {
+	tokens := Tokenize("a<>b")
+	require.Equal(t, []string{"a", "<>", "b"}, tokenTexts(tokens))
+}
+
+func TestTokenizeVariables(t *testing.T) {
+	tokens := Tokenize("SELECT ${my col}, a${b} FROM t")
+	require.Equal(t, []string{"SELECT", " ", "${my col}", ",", " ", "a${b}", " ", "FROM", " ", "t"}, tokenTexts(tokens))
+	require.Equal(t, WordToken, tokens[2].Kind)
+}
+
+func TestTokenizeUnterminated(t *testing.T) {
+	for _, sql := range []string{"SELECT 'it's'", "SELECT `col", "SELECT /* comment", "SELECT /*/", "SELECT ${col"} {
+		tokens := Tokenize(sql)
+		require.True(t, tokens[len(tokens)-1].Unterminated, sql)
+	}
+}
+
+func TestTokenizeIsLossless(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		sql := rapid.String().Draw(t, "sql")
+		require.Equal(t, sql, strings.Join(tokenTexts(Tokenize(sql)), ""))
+	})
+}
+
+func tokenTexts(tokens []Token) []string {
+	texts := make([]string, len(tokens))
+	for i, token := range tokens {
+		texts[i] = token.Text
+	}
+	return texts
+}
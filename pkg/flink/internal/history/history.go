@@ -5,21 +5,55 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/confluentinc/cli/v3/pkg/flink/config"
 	"github.com/confluentinc/cli/v3/pkg/log"
 )
 
-const filename = "flink_statements_history.json"
+const (
+	// The history of all contexts used to be stored in a single file
+	legacyFilename = "flink_statements_history.json"
+	directory      = "flink_history"
+	maxEntries     = 500
+)
+
+var unsafeFilenameCharacters = regexp.MustCompile(`[^a-zA-Z0-9._@-]`)
+
+type Entry struct {
+	Statement     string    `json:"statement"`
+	Timestamp     time.Time `json:"timestamp"`
+	StatementName string    `json:"statement_name,omitempty"`
+	Status        string    `json:"status,omitempty"`
+	ComputePool   string    `json:"compute_pool,omitempty"`
+	Environment   string    `json:"environment,omitempty"`
+	Catalog       string    `json:"catalog,omitempty"`
+	Database      string    `json:"database,omitempty"`
+}
+
+// Metadata returns the searchable metadata of the entry, separated by spaces
+func (e Entry) Metadata() string {
+	return strings.Join([]string{e.StatementName, e.Status, e.ComputePool, e.Environment, e.Catalog, e.Database}, " ")
+}
 
 type History struct {
-	Data          []string `json:"data"`
+	Entries       []Entry
 	confluentPath string
 	historyPath   string
 }
 
-func LoadHistory() *History {
-	history := initPath()
+type historyFile struct {
+	Data    []string `json:"data,omitempty"`
+	Entries []Entry  `json:"entries"`
+}
+
+// LoadHistory loads the history of the given context. The legacy history, which was shared by all contexts, is moved
+// to the first context which loads its history, so that other contexts don't inherit its statements.
+func LoadHistory(contextName string) *History {
+	history := initPath(contextName)
 	return loadFromPath(history)
 }
 
@@ -27,6 +61,11 @@ func loadFromPath(history *History) *History {
 	if history == nil {
 		return nil
 	}
+
+	if _, err := os.Stat(history.historyPath); errors.Is(err, os.ErrNotExist) {
+		history.migrateLegacyHistory()
+	}
+
 	jsonFile, err := os.ReadFile(history.historyPath)
 	if errors.Is(err, os.ErrNotExist) {
 		log.CliLogger.Warnf("Couldn't load past statements: file doesn't exist: %s. This is expected if that's the first time you're using the Flink SQL Client!", history.historyPath)
@@ -37,13 +76,39 @@ func loadFromPath(history *History) *History {
 		log.CliLogger.Warnf("Couldn't load past statements history: unable to read file: %v", err)
 	}
 
-	if err := json.Unmarshal(jsonFile, history); err != nil {
+	var file historyFile
+	if err := json.Unmarshal(jsonFile, &file); err != nil {
 		log.CliLogger.Warnf("Couldn't load past statements history: %v", err)
 	}
+
+	history.Entries = file.Entries
+	if len(history.Entries) == 0 {
+		for _, statement := range file.Data {
+			history.Entries = append(history.Entries, Entry{Statement: statement})
+		}
+	}
+
 	return history
 }
 
-func initPath() *History {
+// migrateLegacyHistory moves the legacy history file to the history file of the context, whose loader understands
+// the legacy format
+func (history *History) migrateLegacyHistory() {
+	legacyPath := filepath.Join(history.confluentPath, legacyFilename)
+	if _, err := os.Stat(legacyPath); err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(history.historyPath), os.ModePerm); err != nil {
+		log.CliLogger.Warnf("Couldn't migrate past statements history: couldn't create directory: %v", err)
+		return
+	}
+	if err := os.Rename(legacyPath, history.historyPath); err != nil {
+		log.CliLogger.Warnf("Couldn't migrate past statements history: %v", err)
+	}
+}
+
+func initPath(contextName string) *History {
 	home, osHomedirErr := os.UserHomeDir()
 	if osHomedirErr != nil {
 		log.CliLogger.Warnf("Couldn't get homedir with os.UserHomeDir(): %v", osHomedirErr)
@@ -55,31 +120,36 @@ func initPath() *History {
 		confluentDir = config.HomeConfluentPathDefault
 	}
 	confluentPath := filepath.Join(home, confluentDir)
-	historyPath := filepath.Join(confluentPath, filename)
+	historyPath := filepath.Join(confluentPath, directory, filename(contextName))
 
 	return &History{
-		Data:          nil,
 		confluentPath: confluentPath,
 		historyPath:   historyPath,
 	}
 }
 
+// filename returns a file name for the context, since context names usually contain URLs
+func filename(contextName string) string {
+	if contextName == "" {
+		contextName = "default"
+	}
+	return unsafeFilenameCharacters.ReplaceAllString(contextName, "_") + ".json"
+}
+
 func (history *History) Save() {
 	// Limit history to 500 entries
-	if len(history.Data) > 500 {
-		history.Data = history.Data[len(history.Data)-500:]
+	if len(history.Entries) > maxEntries {
+		history.Entries = history.Entries[len(history.Entries)-maxEntries:]
 	}
 
 	// Convert struct to JSON
-	b, err := json.Marshal(history)
+	b, err := json.Marshal(historyFile{Entries: history.Entries})
 	if err != nil {
 		log.CliLogger.Warnf("Couldn't save past statements history: couldn't marshal history: %v", err)
 	}
 
-	if err := os.Mkdir(history.confluentPath, os.ModePerm); err != nil {
-		if !errors.Is(err, os.ErrExist) {
-			log.CliLogger.Warnf("Couldn't save past statements history: couldn't create directory: %v", err)
-		}
+	if err := os.MkdirAll(filepath.Dir(history.historyPath), os.ModePerm); err != nil {
+		log.CliLogger.Warnf("Couldn't save past statements history: couldn't create directory: %v", err)
 	}
 
 	// Write JSON to file
@@ -94,23 +164,57 @@ func (history *History) Save() {
 	}
 }
 
-func (history *History) Append(statements ...string) {
-	formattedStatements := formatStatements(statements)
-	history.Data = append(history.Data, formattedStatements...)
+// Append adds entries to the history. Empty statements are skipped, and an entry repeating the statement of the
+// previous entry replaces it, so that the history keeps the metadata of the latest run.
+func (history *History) Append(entries ...Entry) {
+	for _, entry := range entries {
+		if entry.Statement == "" {
+			continue
+		}
+		if last := len(history.Entries) - 1; last >= 0 && history.Entries[last].Statement == entry.Statement {
+			history.Entries[last] = entry
+			continue
+		}
+		history.Entries = append(history.Entries, entry)
+	}
+}
+
+// Get returns the entry referenced by its 1-based index or, if the reference isn't a number, the latest entry
+// with the given statement name
+func (history *History) Get(reference string) (Entry, bool) {
+	if index, err := strconv.Atoi(reference); err == nil {
+		if index < 1 || index > len(history.Entries) {
+			return Entry{}, false
+		}
+		return history.Entries[index-1], true
+	}
+
+	for i := len(history.Entries) - 1; i >= 0; i-- {
+		if history.Entries[i].StatementName == reference {
+			return history.Entries[i], true
+		}
+	}
+	return Entry{}, false
 }
 
-// This removes empty statements
-// and also repeated consecutive statetments
-func formatStatements(statements []string) []string {
-	formattedStatements := []string{}
-	var previousStatement string
+// Search returns the 1-based indexes of the entries whose statement or metadata contains the query, ignoring case
+func (history *History) Search(query string) []int {
+	query = strings.ToUpper(query)
 
-	for _, statement := range statements {
-		if statement != "" && statement != previousStatement {
-			formattedStatements = append(formattedStatements, statement)
-			previousStatement = statement
+	var indexes []int
+	for i, entry := range history.Entries {
+		if strings.Contains(strings.ToUpper(entry.Statement), query) || strings.Contains(strings.ToUpper(entry.Metadata()), query) {
+			indexes = append(indexes, i+1)
 		}
 	}
+	return indexes
+}
 
-	return formattedStatements
+// Statements returns the statements of all entries, in the same order
+func (history *History) Statements() []string {
+	statements := make([]string, len(history.Entries))
+	for i, entry := range history.Entries {
+		statements[i] = entry.Statement
+	}
+	return statements
 }
@@ -4,59 +4,65 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
 
 func TestLoadHistory(t *testing.T) {
-	history := LoadHistory()
+	history := LoadHistory("login-user@example.com-https://confluent.cloud")
 
 	require.NotNil(t, history, "Expected non-nil history object")
 	require.NotEmpty(t, history.confluentPath, "Expected non-empty confluent path")
 	require.NotEmpty(t, history.historyPath, "Expected non-empty history path")
+	require.Equal(t, "login-user@example.com-https___confluent.cloud.json", filepath.Base(history.historyPath))
 
 	// Create temporary file
-	tmpDir, err := os.MkdirTemp("", "confluent-test")
-	defer os.RemoveAll(tmpDir)
-
-	require.NoError(t, err, "Error creating temp dir")
-
-	tmpFile := filepath.Join(tmpDir, filename)
+	tmpDir := t.TempDir()
+	tmpFile := filepath.Join(tmpDir, directory, "context.json")
+	require.NoError(t, os.MkdirAll(filepath.Dir(tmpFile), os.ModePerm))
 
 	// Write sample data
-	sampleData := `{"data":["statement1;","statement2"]}`
-	err = os.WriteFile(tmpFile, []byte(sampleData), 0644)
-
+	sampleData := `{"entries":[{"statement":"statement1;","timestamp":"2023-01-01T00:00:00Z","statement_name":"name1","status":"COMPLETED"},{"statement":"statement2","timestamp":"2023-01-02T00:00:00Z"}]}`
+	err := os.WriteFile(tmpFile, []byte(sampleData), 0644)
 	require.NoError(t, err, "Error writing sample data to temp file")
 
-	// Update historical data path
-	prevPath := history.historyPath
-	history.historyPath = tmpFile
-
 	// Reload history
-	history = loadFromPath(history)
+	history = loadFromPath(&History{confluentPath: tmpDir, historyPath: tmpFile})
 	require.NotNil(t, history, "Expected non-nil history object after reloading history")
-	require.NotEmpty(t, history.confluentPath, "Expected non-empty confluent path after reloading history")
-	require.NotEmpty(t, history.historyPath, "Expected non-empty history path after reloading history")
 
 	// Verify data
-	require.Len(t, history.Data, 2, "Expected two history items")
-	require.Equal(t, "statement1;", history.Data[0], "Expected statement1; as first item in history")
+	require.Len(t, history.Entries, 2, "Expected two history items")
+	require.Equal(t, "name1", history.Entries[0].StatementName)
+	require.Equal(t, []string{"statement1;", "statement2"}, history.Statements())
+}
+
+func TestLoadLegacyHistory(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	// The legacy file is moved to the first context which loads its history
+	err := os.WriteFile(filepath.Join(tmpDir, legacyFilename), []byte(`{"data":["statement1;","statement2"]}`), 0644)
+	require.NoError(t, err)
 
-	// Reset history path
-	history.historyPath = prevPath
+	history := loadFromPath(&History{confluentPath: tmpDir, historyPath: filepath.Join(tmpDir, directory, "context.json")})
+	require.Equal(t, []Entry{{Statement: "statement1;"}, {Statement: "statement2"}}, history.Entries)
+	require.Equal(t, []string{"statement1;", "statement2"}, history.Statements())
+	require.NoFileExists(t, filepath.Join(tmpDir, legacyFilename))
+
+	// Other contexts don't inherit the legacy history
+	history = loadFromPath(&History{confluentPath: tmpDir, historyPath: filepath.Join(tmpDir, directory, "other-context.json")})
+	require.Empty(t, history.Entries)
 }
 
 func TestHistorySave(t *testing.T) {
 	// Create a temp directory
-	tmpDir, _ := os.MkdirTemp("", "confluent-test")
-	defer os.RemoveAll(tmpDir)
+	tmpDir := t.TempDir()
 
 	history := &History{
 		confluentPath: tmpDir,
-		historyPath:   filepath.Join(tmpDir, filename),
-		Data:          []string{"statement1", "statement2"},
+		historyPath:   filepath.Join(tmpDir, directory, "context.json"),
 	}
+	history.Append(Entry{Statement: "statement1", Timestamp: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), StatementName: "name1"})
 
 	// Save the history
 	history.Save()
@@ -64,50 +70,86 @@ func TestHistorySave(t *testing.T) {
 	// Check if file exists and has the correct content
 	fileContent, err := os.ReadFile(history.historyPath)
 	require.NoError(t, err)
-	expectedJSON := `{"data":["statement1","statement2"]}`
+	expectedJSON := `{"entries":[{"statement":"statement1","timestamp":"2023-01-01T00:00:00Z","statement_name":"name1"}]}`
 	require.Equal(t, expectedJSON, string(fileContent))
 
 	// Check that the history was truncated to 500 entries
-	history.Data = append(history.Data, make([]string, 501)...)
+	for i := 0; i < 501; i++ {
+		history.Entries = append(history.Entries, Entry{Statement: "statement"})
+	}
 	history.Save()
-	require.Len(t, history.Data, 500)
+	require.Len(t, history.Entries, 500)
+	require.Len(t, history.Statements(), 500)
 }
 
 func TestAppendHistory(t *testing.T) {
 	// Create a History instance for testing
-	history := &History{
-		Data: []string{"statement1"},
-	}
+	history := &History{}
+	history.Append(Entry{Statement: "statement1"})
 
 	// Append statements to history with correct format
-	history.Append("statement2", "statement3")
+	history.Append(Entry{Statement: "statement2"}, Entry{Statement: "statement3"})
 	expectedData := []string{"statement1", "statement2", "statement3"}
-	require.Equal(t, expectedData, history.Data)
+	require.Equal(t, expectedData, history.Statements())
 
 	// Append empty list of statements, should not modify history
 	history.Append()
-	require.Equal(t, expectedData, history.Data)
+	history.Append(Entry{})
+	require.Equal(t, expectedData, history.Statements())
 
 	// Append statements without trimming white spaces in front and back
-	history.Append(" statement4 ", "\tstatement5\t")
+	history.Append(Entry{Statement: " statement4 "}, Entry{Statement: "\tstatement5\t"})
 	expectedData = []string{"statement1", "statement2", "statement3", " statement4 ", "\tstatement5\t"}
-	require.Equal(t, expectedData, history.Data)
+	require.Equal(t, expectedData, history.Statements())
+
+	// Repeating the previous statement only updates its metadata
+	history.Append(Entry{Statement: "\tstatement5\t", Status: "COMPLETED"})
+	require.Equal(t, expectedData, history.Statements())
+	require.Len(t, history.Entries, 5)
+	require.Equal(t, "COMPLETED", history.Entries[4].Status)
 }
 
-func TestFormatStatements(t *testing.T) {
+func TestGet(t *testing.T) {
+	history := &History{}
+	history.Append(
+		Entry{Statement: "SELECT 1;", StatementName: "my-statement"},
+		Entry{Statement: "SELECT 2;", StatementName: "other-statement"},
+		Entry{Statement: "SELECT 3;", StatementName: "my-statement"},
+	)
+
 	cases := []struct {
-		statements     []string
-		expectedOutput []string
+		reference string
+		statement string
+		found     bool
 	}{
-		{[]string{"SELECT * FROM orders;", "SELECT * FROM products;"}, []string{"SELECT * FROM orders;", "SELECT * FROM products;"}},
-		{[]string{"INSERT INTO users (name, age) VALUES ('Alice', 25);"}, []string{"INSERT INTO users (name, age) VALUES ('Alice', 25);"}},
-		{[]string{"", "SELECT * FROM users;", ""}, []string{"SELECT * FROM users;"}},
-		{[]string{"SELECT * FROM users;", "SELECT * FROM users;"}, []string{"SELECT * FROM users;"}},
-		{[]string{}, []string{}},
+		{"1", "SELECT 1;", true},
+		{"3", "SELECT 3;", true},
+		{"0", "", false},
+		{"4", "", false},
+		{"other-statement", "SELECT 2;", true},
+		{"my-statement", "SELECT 3;", true},
+		{"unknown", "", false},
 	}
 
 	for _, c := range cases {
-		actualOutput := formatStatements(c.statements)
-		require.Equal(t, c.expectedOutput, actualOutput)
+		entry, found := history.Get(c.reference)
+		require.Equal(t, c.found, found, c.reference)
+		require.Equal(t, c.statement, entry.Statement, c.reference)
 	}
 }
+
+func TestSearch(t *testing.T) {
+	history := &History{}
+	history.Append(
+		Entry{Statement: "SELECT * FROM orders;", StatementName: "orders", Status: "COMPLETED", Catalog: "env", Database: "cluster"},
+		Entry{Statement: "INSERT INTO sink SELECT * FROM orders;", Status: "FAILED", ComputePool: "lfcp-123456"},
+		Entry{Statement: "SET 'client.var.env' = 'prod';"},
+	)
+
+	require.Equal(t, []int{1, 2}, history.Search("ORDERS"))
+	require.Equal(t, []int{2}, history.Search("failed"))
+	require.Equal(t, []int{2}, history.Search("lfcp"))
+	require.Equal(t, []int{1, 3}, history.Search("env"))
+	require.Equal(t, []int{1, 2, 3}, history.Search(""))
+	require.Empty(t, history.Search("unknown"))
+}
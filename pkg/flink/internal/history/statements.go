@@ -0,0 +1,36 @@
+package history
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	historyStatementRegex = regexp.MustCompile(`(?is)^\s*HISTORY(?:\s+'((?:[^']|'')*)')?\s*;?\s*$`)
+	rerunStatementRegex   = regexp.MustCompile(`(?is)^\s*RERUN\s+(?:(\d+)|'((?:[^']|'')*)')\s*;?\s*$`)
+)
+
+// ParseHistoryStatement parses "HISTORY;" and "HISTORY 'query';", which list the (matching) entries of the history
+func ParseHistoryStatement(statement string) (string, bool) {
+	matches := historyStatementRegex.FindStringSubmatch(statement)
+	if matches == nil {
+		return "", false
+	}
+	return unescape(matches[1]), true
+}
+
+// ParseRerunStatement parses "RERUN 3;" and "RERUN 'statement-name';", which run an entry of the history again
+func ParseRerunStatement(statement string) (string, bool) {
+	matches := rerunStatementRegex.FindStringSubmatch(statement)
+	if matches == nil {
+		return "", false
+	}
+	if matches[1] != "" {
+		return matches[1], true
+	}
+	return unescape(matches[2]), true
+}
+
+func unescape(s string) string {
+	return strings.ReplaceAll(s, "''", "'")
+}
@@ -0,0 +1,48 @@
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHistoryStatement(t *testing.T) {
+	cases := []struct {
+		statement string
+		query     string
+		ok        bool
+	}{
+		{"HISTORY;", "", true},
+		{"history", "", true},
+		{" HISTORY 'orders' ; ", "orders", true},
+		{"HISTORY 'it''s';", "it's", true},
+		{"HISTORY orders;", "", false},
+		{"SELECT * FROM history;", "", false},
+	}
+
+	for _, c := range cases {
+		query, ok := ParseHistoryStatement(c.statement)
+		require.Equal(t, c.ok, ok, c.statement)
+		require.Equal(t, c.query, query, c.statement)
+	}
+}
+
+func TestParseRerunStatement(t *testing.T) {
+	cases := []struct {
+		statement string
+		reference string
+		ok        bool
+	}{
+		{"RERUN 3;", "3", true},
+		{"rerun 'my-statement'", "my-statement", true},
+		{"RERUN;", "", false},
+		{"RERUN my-statement;", "", false},
+		{"RERUN 'a' 'b';", "", false},
+	}
+
+	for _, c := range cases {
+		reference, ok := ParseRerunStatement(c.statement)
+		require.Equal(t, c.ok, ok, c.statement)
+		require.Equal(t, c.reference, reference, c.statement)
+	}
+}
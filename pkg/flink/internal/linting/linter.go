@@ -0,0 +1,155 @@
+package linting
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/confluentinc/cli/v3/pkg/flink/config"
+	"github.com/confluentinc/cli/v3/pkg/flink/internal/formatting"
+	"github.com/confluentinc/cli/v3/pkg/flink/internal/highlighting"
+	"github.com/confluentinc/cli/v3/pkg/flink/types"
+	ptypes "github.com/confluentinc/cli/v3/pkg/types"
+)
+
+const (
+	RuleUnterminatedQuote  = "unterminated-quote"
+	RuleMissingTerminator  = "missing-terminator"
+	RuleSelectStarInInsert = "select-star-in-insert"
+	RuleUnknownSetKey      = "unknown-set-key"
+)
+
+var clientKeys = ptypes.NewSet(config.KeyResultsTimeout, config.KeyServiceAccount, config.KeyStatementName)
+
+var serverKeys = ptypes.NewSet(
+	config.KeyLocalTimeZone,
+	"sql.state-ttl",
+	"sql.tables.scan.bounded.mode",
+	"sql.tables.scan.bounded.timestamp-millis",
+	"sql.tables.scan.idle-timeout",
+	"sql.tables.scan.startup.mode",
+	"sql.tables.scan.startup.timestamp-millis",
+)
+
+// Lint checks SQL statements for common mistakes without contacting the Flink gateway.
+func Lint(sql string) []types.LintIssue {
+	var issues []types.LintIssue
+
+	statements := formatting.SplitStatements(highlighting.Tokenize(sql))
+	for i, statement := range statements {
+		issues = append(issues, lintUnterminatedQuotes(statement)...)
+
+		significant := significantTokens(statement)
+		if len(significant) == 0 {
+			continue
+		}
+
+		if last := significant[len(significant)-1]; i == len(statements)-1 && last.Kind != highlighting.TerminatorToken {
+			issues = append(issues, types.LintIssue{
+				Line:     last.Line,
+				Column:   last.Column,
+				Severity: types.LintError,
+				Rule:     RuleMissingTerminator,
+				Message:  fmt.Sprintf(`statement is not terminated with "%s"`, config.StatementTerminator),
+			})
+		}
+
+		switch {
+		case significant[0].IsKeyword(config.OpSet):
+			issues = append(issues, lintSetKey(significant)...)
+		case significant[0].IsKeyword("INSERT"):
+			issues = append(issues, lintSelectStar(significant)...)
+		}
+	}
+
+	return issues
+}
+
+func significantTokens(tokens []highlighting.Token) []highlighting.Token {
+	var significant []highlighting.Token
+	for _, token := range tokens {
+		if token.IsSignificant() {
+			significant = append(significant, token)
+		}
+	}
+	return significant
+}
+
+func lintUnterminatedQuotes(tokens []highlighting.Token) []types.LintIssue {
+	var issues []types.LintIssue
+	for _, token := range tokens {
+		if !token.Unterminated {
+			continue
+		}
+
+		message := "unterminated comment"
+		switch token.Kind {
+		case highlighting.StringToken:
+			message = "unterminated string literal, single quotes inside of strings must be escaped with another single quote"
+		case highlighting.QuotedIdentifierToken:
+			message = "unterminated quoted identifier"
+		case highlighting.WordToken:
+			message = `unterminated variable reference, variable names must be closed with "}"`
+		}
+		issues = append(issues, types.LintIssue{
+			Line:     token.Line,
+			Column:   token.Column,
+			Severity: types.LintError,
+			Rule:     RuleUnterminatedQuote,
+			Message:  message,
+		})
+	}
+	return issues
+}
+
+// lintSelectStar reports "*" in the select lists of INSERT statements, since the inserted columns then depend on the source schema
+func lintSelectStar(tokens []highlighting.Token) []types.LintIssue {
+	var issues []types.LintIssue
+	for i := 1; i < len(tokens); i++ {
+		if tokens[i].Text != "*" {
+			continue
+		}
+
+		previous := tokens[i-1]
+		if previous.IsKeyword("SELECT") || previous.IsKeyword("DISTINCT") || previous.IsKeyword("ALL") || previous.Text == "." || previous.Text == "," {
+			issues = append(issues, types.LintIssue{
+				Line:     tokens[i].Line,
+				Column:   tokens[i].Column,
+				Severity: types.LintWarning,
+				Rule:     RuleSelectStarInInsert,
+				Message:  "avoid SELECT * in INSERT statements, list the columns explicitly so that schema changes of the source don't break the sink",
+			})
+		}
+	}
+	return issues
+}
+
+func lintSetKey(tokens []highlighting.Token) []types.LintIssue {
+	// SET without a key lists the current configuration
+	if len(tokens) < 2 || tokens[1].Kind != highlighting.StringToken || tokens[1].Unterminated {
+		return nil
+	}
+
+	key := strings.ReplaceAll(tokens[1].Text[1:len(tokens[1].Text)-1], "''", "'")
+	issue := types.LintIssue{Line: tokens[1].Line, Column: tokens[1].Column, Rule: RuleUnknownSetKey}
+
+	switch {
+	case key == config.KeyCatalog || key == config.KeyDatabase:
+		issue.Severity = types.LintError
+		issue.Message = `cannot set a catalog or a database with SET, use "USE CATALOG catalog-name" and "USE db-name" instead`
+	case key == config.NamespaceClientVar:
+		issue.Severity = types.LintError
+		issue.Message = "missing variable name"
+	case strings.HasPrefix(key, config.NamespaceClientVar):
+		return nil
+	case strings.HasPrefix(key, config.NamespaceClient) && !clientKeys.Contains(key):
+		issue.Severity = types.LintError
+		issue.Message = fmt.Sprintf(`unknown client configuration key "%s"`, key)
+	case !strings.HasPrefix(key, config.NamespaceClient) && !serverKeys.Contains(key):
+		issue.Severity = types.LintWarning
+		issue.Message = fmt.Sprintf(`unknown configuration key "%s"`, key)
+	default:
+		return nil
+	}
+
+	return []types.LintIssue{issue}
+}
@@ -0,0 +1,32 @@
+package linting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/confluentinc/cli/v3/pkg/flink/types"
+)
+
+func TestLint(t *testing.T) {
+	sql := "SET 'client.unknown' = 'value';\n" +
+		"SET 'table.exec.mini-batch.enabled' = 'true';\n" +
+		"SET 'client.var.env' = 'prod';\n" +
+		"SET 'sql.current-catalog' = 'my_catalog';\n" +
+		"INSERT INTO sink SELECT o.*, COUNT(*) FROM orders o;\n" +
+		"SELECT 'unterminated FROM orders"
+
+	expected := []types.LintIssue{
+		{Line: 1, Column: 5, Severity: types.LintError, Rule: RuleUnknownSetKey, Message: `unknown client configuration key "client.unknown"`},
+		{Line: 2, Column: 5, Severity: types.LintWarning, Rule: RuleUnknownSetKey, Message: `unknown configuration key "table.exec.mini-batch.enabled"`},
+		{Line: 4, Column: 5, Severity: types.LintError, Rule: RuleUnknownSetKey, Message: `cannot set a catalog or a database with SET, use "USE CATALOG catalog-name" and "USE db-name" instead`},
+		{Line: 5, Column: 27, Severity: types.LintWarning, Rule: RuleSelectStarInInsert, Message: "avoid SELECT * in INSERT statements, list the columns explicitly so that schema changes of the source don't break the sink"},
+		{Line: 6, Column: 8, Severity: types.LintError, Rule: RuleUnterminatedQuote, Message: "unterminated string literal, single quotes inside of strings must be escaped with another single quote"},
+		{Line: 6, Column: 8, Severity: types.LintError, Rule: RuleMissingTerminator, Message: `statement is not terminated with ";"`},
+	}
+	require.Equal(t, expected, Lint(sql))
+}
+
+func TestLintNoIssues(t *testing.T) {
+	require.Empty(t, Lint("-- comment\nSET 'sql.local-time-zone' = 'UTC';\nINSERT INTO sink SELECT a, b FROM source;\n-- trailing comment\n"))
+}
@@ -4,12 +4,14 @@ import (
 	"strings"
 
 	"github.com/confluentinc/go-prompt"
+
+	"github.com/confluentinc/cli/v3/pkg/flink/internal/history"
 )
 
 const BckISearch = "bck-i-search: "
 
 type ReverseISearch interface {
-	ReverseISearch(history []string, initialText string) string
+	ReverseISearch(entries []history.Entry, initialText string) string
 }
 
 type reverseISearch struct{}
@@ -34,9 +36,17 @@ func reverseISearchLivePrefix(livePrefixState *LivePrefixState) func() (string,
 	}
 }
 
-func (r reverseISearch) ReverseISearch(history []string, initialBufferText string) string {
+func (r reverseISearch) ReverseISearch(entries []history.Entry, initialBufferText string) string {
 	writer := prompt.NewStdoutWriter()
 
+	// Entries are also found by their metadata, like the statement name or status
+	history := make([]string, len(entries))
+	metadata := make([]string, len(entries))
+	for i, entry := range entries {
+		history[i] = entry.Statement
+		metadata[i] = entry.Metadata()
+	}
+
 	livePrefixState := &LivePrefixState{
 		LivePrefix: BckISearch,
 		IsEnable:   true,
@@ -55,7 +65,7 @@ func (r reverseISearch) ReverseISearch(history []string, initialBufferText strin
 	}
 	in := prompt.New(
 		func(s string) {},
-		searchCompleter(history, writer, searchState, livePrefixState),
+		searchCompleter(history, metadata, writer, searchState, livePrefixState),
 		prompt.OptionSetExitCheckerOnInput(func(input string, lineBreak bool) bool {
 			return !reverseISearchEnabled
 		}),
@@ -73,7 +83,7 @@ func (r reverseISearch) ReverseISearch(history []string, initialBufferText strin
 		}),
 		prompt.OptionAddKeyBind(prompt.KeyBind{
 			Key: prompt.ControlR,
-			Fn:  nextResult(writer, history, searchState, livePrefixState),
+			Fn:  nextResult(writer, history, metadata, searchState, livePrefixState),
 		}),
 		prompt.OptionWriter(writer),
 		prompt.OptionTitle("bck-i-search"),
@@ -94,7 +104,7 @@ func (r reverseISearch) ReverseISearch(history []string, initialBufferText strin
 
 // The searchCompleter is writing on console the suggestions from the history, appending the
 // `bck-i-search: ` string. It always returns an empty []prompt.Suggest, because we are not using the built-in suggest.
-func searchCompleter(history []string, writer prompt.ConsoleWriter, searchState *SearchState, livePrefix *LivePrefixState) prompt.Completer {
+func searchCompleter(history, metadata []string, writer prompt.ConsoleWriter, searchState *SearchState, livePrefix *LivePrefixState) prompt.Completer {
 	return func(document prompt.Document) []prompt.Suggest {
 		// User selected the command or key binding for next match
 		if document.LastKeyStroke() == prompt.Escape || document.LastKeyStroke() == prompt.ControlR {
@@ -103,23 +113,23 @@ func searchCompleter(history []string, writer prompt.ConsoleWriter, searchState
 		// user inserted a char, search start from top again
 		searchState.CurrentIndex = len(history) - 1
 
-		updateSuggestion(history, document.Text, writer, searchState, livePrefix)
+		updateSuggestion(history, metadata, document.Text, writer, searchState, livePrefix)
 
 		return []prompt.Suggest{}
 	}
 }
 
 // nextResult will update console text with the next match from the history.
-func nextResult(writer prompt.ConsoleWriter, history []string, searchState *SearchState, livePrefix *LivePrefixState) func(buffer *prompt.Buffer) {
+func nextResult(writer prompt.ConsoleWriter, history, metadata []string, searchState *SearchState, livePrefix *LivePrefixState) func(buffer *prompt.Buffer) {
 	return func(buffer *prompt.Buffer) {
 		searchState.CurrentIndex--
-		updateSuggestion(history, buffer.Text(), writer, searchState, livePrefix)
+		updateSuggestion(history, metadata, buffer.Text(), writer, searchState, livePrefix)
 	}
 }
 
-func updateSuggestion(history []string, substr string, writer prompt.ConsoleWriter, searchState *SearchState, livePrefix *LivePrefixState) {
+func updateSuggestion(history, metadata []string, substr string, writer prompt.ConsoleWriter, searchState *SearchState, livePrefix *LivePrefixState) {
 	clearCurrentSuggestion(writer, searchState)
-	result := search(substr, history, searchState.CurrentIndex)
+	result := search(substr, history, metadata, searchState.CurrentIndex)
 	writeSuggestion(writer, result.match, result.matchIndexStart, len(substr))
 	updateSearchState(searchState, result.match, result.index)
 	updateLivePrefix(result.match, substr, livePrefix)
@@ -192,15 +202,17 @@ func writeSuggestion(writer prompt.ConsoleWriter, match string, matchIndex, matc
 // searchResult represent a match in the history:
 // index is the index of the match in the history array, -1 otherwise
 // match is the matched string: history[index] = match
-// matchIndexStart is the index where the match start, -1 otherwise. e.g. "search(tch, {matching}" return 2
+// matchIndexStart is the index where the match start, -1 otherwise. e.g. "search(tch, {matching}" return 2. It's also -1
+// if only the metadata of the entry matched, so nothing is highlighted.
 type searchResult struct {
 	index           int
 	match           string
 	matchIndexStart int
 }
 
-// search for substr in the s slice backwards starting from the startIndex if specified.
-func search(substr string, s []string, startIndex int) searchResult {
+// search for substr in the s slice backwards starting from the startIndex if specified. An entry also matches if
+// its metadata, which has the same index in the metadata slice, contains substr.
+func search(substr string, s, metadata []string, startIndex int) searchResult {
 	// We want our backward search to be case insensitive, since flink sql is case insensitive for keywords.
 	substr = strings.ToUpper(substr)
 
@@ -215,6 +227,9 @@ func search(substr string, s []string, startIndex int) searchResult {
 		if strings.Contains(substrI, substr) {
 			return searchResult{i, s[i], strings.Index(substrI, substr)}
 		}
+		if i < len(metadata) && strings.Contains(strings.ToUpper(metadata[i]), substr) {
+			return searchResult{i, s[i], -1}
+		}
 	}
 	return searchResult{-1, "", -1}
 }
@@ -16,7 +16,7 @@ func TestSearchString(t *testing.T) {
 		index := rapid.IntRange(0, len(slice)-1).Draw(t, "Index")
 		s := slice[index]
 
-		result := search(s, slice, len(slice)-1)
+		result := search(s, slice, nil, len(slice)-1)
 		assert.NotEqual(t, -1, result.index)
 		assert.Contains(t, strings.ToUpper(result.match), strings.ToUpper(s))
 	})
@@ -31,7 +31,7 @@ func TestSearchCaseInsensitiveInputString(t *testing.T) {
 		str := slice[index]
 		upperCaseStr := strings.ToUpper(str)
 
-		result := search(upperCaseStr, slice, len(slice)-1)
+		result := search(upperCaseStr, slice, nil, len(slice)-1)
 		assert.NotEqual(t, -1, result.index)
 		assert.Contains(t, strings.ToUpper(result.match), upperCaseStr)
 	})
@@ -44,7 +44,7 @@ func TestSearchCaseInsensitiveHistoryString(t *testing.T) {
 		index := rapid.IntRange(0, len(slice)-1).Draw(t, "Index")
 		str := slice[index]
 
-		result := search(str, slice, len(slice)-1)
+		result := search(str, slice, nil, len(slice)-1)
 		assert.NotEqual(t, -1, result.index)
 		assert.Contains(t, strings.ToUpper(result.match), strings.ToUpper(str))
 	})
@@ -54,40 +54,59 @@ func TestSearchWithIndex(t *testing.T) {
 	slice := []string{"first", "second", "third one", "third two", "third three"}
 
 	// last element
-	result := search("third", slice, len(slice)-1)
+	result := search("third", slice, nil, len(slice)-1)
 
 	assert.Equal(t, len(slice)-1, result.index)
 	assert.Equal(t, "third three", result.match)
 
 	// last element -1
-	result = search("third", slice, len(slice)-2)
+	result = search("third", slice, nil, len(slice)-2)
 
 	assert.Equal(t, len(slice)-2, result.index)
 	assert.Equal(t, "third two", result.match)
 
 	// last element - 2
-	result = search("third", slice, len(slice)-3)
+	result = search("third", slice, nil, len(slice)-3)
 
 	assert.Equal(t, len(slice)-3, result.index)
 	assert.Equal(t, "third one", result.match)
 
 	// last element
-	result = search("third", slice, 0)
+	result = search("third", slice, nil, 0)
 
 	assert.Equal(t, -1, result.index)
 	assert.Equal(t, "", result.match)
 }
 
+func TestSearchMetadata(t *testing.T) {
+	slice := []string{"SELECT * FROM orders;", "SELECT * FROM products;"}
+	metadata := []string{"orders-statement COMPLETED lfcp-123456", "products-statement FAILED lfcp-123456"}
+
+	// matches in the statement are highlighted
+	result := search("orders", slice, metadata, len(slice)-1)
+	assert.Equal(t, 0, result.index)
+	assert.Equal(t, 14, result.matchIndexStart)
+
+	// matches in the metadata are not highlighted
+	result = search("failed", slice, metadata, len(slice)-1)
+	assert.Equal(t, 1, result.index)
+	assert.Equal(t, "SELECT * FROM products;", result.match)
+	assert.Equal(t, -1, result.matchIndexStart)
+
+	result = search("lfcp", slice, metadata, 0)
+	assert.Equal(t, 0, result.index)
+}
+
 func TestSearchWithOutOfBoundIndex(t *testing.T) {
 	slice := []string{"first", "second", "third one", "third two", "third three"}
 
 	// when out of bound index, will just start from last element
-	result := search("third", slice, len(slice)+100)
+	result := search("third", slice, nil, len(slice)+100)
 	assert.Equal(t, 4, result.index)
 	assert.Equal(t, "third three", result.match)
 
 	// negative indexes are just ignored
-	result = search("third", slice, -100)
+	result = search("third", slice, nil, -100)
 
 	assert.Equal(t, -1, result.index)
 	assert.Equal(t, "", result.match)
@@ -98,7 +117,7 @@ func TestNoMatchEmptyString(t *testing.T) {
 		// create a random array string
 		slice := rapid.SliceOfN(rapid.StringN(1, -1, -1), 1, 500).Draw(t, "Slice of strings")
 
-		res := search("", slice, len(slice)-1)
+		res := search("", slice, nil, len(slice)-1)
 		assert.Equal(t, -1, res.index)
 		assert.Equal(t, "", res.match)
 	})
@@ -109,7 +128,7 @@ func TestNoMatchString(t *testing.T) {
 		slice := rapid.SliceOfN(rapid.StringMatching("[0-9]"), 1, 500).Draw(t, "Slice of strings")
 		s := rapid.StringMatching("[a-zA-Z]").Draw(t, "Literal String")
 
-		res := search(s, slice, len(slice)-1)
+		res := search(s, slice, nil, len(slice)-1)
 		assert.Equal(t, -1, res.index)
 		assert.Equal(t, "", res.match)
 	})
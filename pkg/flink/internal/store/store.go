@@ -70,6 +70,11 @@ func (s *Store) ProcessStatement(statement string) (*types.ProcessedStatement, *
 	// We trim the statement here once so we don't have to do it in every function
 	statement = strings.TrimSpace(statement)
 
+	statement, sErr := s.substituteVariables(statement)
+	if sErr != nil {
+		return nil, sErr
+	}
+
 	// Process local statements: set, use, reset
 	result, sErr := s.ProcessLocalStatement(statement)
 	if result != nil || sErr != nil {
@@ -104,6 +109,17 @@ func (s *Store) ProcessStatement(statement string) (*types.ProcessedStatement, *
 	return types.NewProcessedStatement(statementObj), nil
 }
 
+// substituteVariables replaces variable references like "${env}" with the values of the 'client.var.' properties.
+// SET and RESET statements are left untouched so variables can be defined and reset with literal values.
+func (s *Store) substituteVariables(statement string) (string, *types.StatementError) {
+	switch parseStatementType(statement) {
+	case SetStatement, ResetStatement:
+		return statement, nil
+	default:
+		return types.SubstituteVariables(statement, s.Properties.GetVariables())
+	}
+}
+
 func createSqlV1beta1Statement(statement string, statementName string, computePoolId string, properties map[string]string) flinkgatewayv1beta1.SqlV1beta1Statement {
 	return flinkgatewayv1beta1.SqlV1beta1Statement{
 		Name: &statementName,
@@ -341,6 +357,9 @@ func getInitialProperties(appOptions *types.ApplicationOptions) map[string]strin
 	if appOptions.GetDatabase() != "" {
 		properties[config.KeyDatabase] = appOptions.GetDatabase()
 	}
+	for name, value := range appOptions.GetVariables() {
+		properties[config.NamespaceClientVar+name] = value
+	}
 
 	return properties
 }
@@ -1276,6 +1276,66 @@ func (s *StoreTestSuite) TestProcessStatementUsesUserProvidedStatementName() {
 	require.False(s.T(), store.Properties.HasKey(flinkconfig.KeyStatementName))
 }
 
+func (s *StoreTestSuite) TestProcessStatementSubstitutesVariables() {
+	client := mock.NewMockGatewayClientInterface(gomock.NewController(s.T()))
+	appOptions := &types.ApplicationOptions{
+		OrganizationId: "orgId",
+		EnvironmentId:  "envId",
+		ComputePoolId:  "computePoolId",
+		Variables:      map[string]string{"env": "prod"},
+	}
+	serviceAccountId := "sa-123"
+	store := Store{
+		Properties:       NewUserProperties(map[string]string{flinkconfig.KeyServiceAccount: serviceAccountId}, getInitialProperties(appOptions)),
+		client:           client,
+		appOptions:       appOptions,
+		tokenRefreshFunc: tokenRefreshFunc,
+	}
+	store.Properties.Set(flinkconfig.NamespaceClientVar+"topic", "orders")
+
+	expectedStatement := "SELECT * FROM `prod_orders`"
+	statementObj := flinkgatewayv1beta1.SqlV1beta1Statement{
+		Status: &flinkgatewayv1beta1.SqlV1beta1StatementStatus{Phase: "PENDING"},
+		Spec: &flinkgatewayv1beta1.SqlV1beta1StatementSpec{
+			Properties:    &map[string]string{},
+			ComputePoolId: &appOptions.ComputePoolId,
+			Statement:     &expectedStatement,
+		},
+	}
+
+	client.EXPECT().CreateStatement(SqlV1beta1StatementMatcher{statementObj}, serviceAccountId, appOptions.EnvironmentId, appOptions.OrganizationId).
+		Return(statementObj, nil)
+
+	processedStatement, err := store.ProcessStatement("SELECT * FROM `${env}_${topic}`")
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), types.NewProcessedStatement(statementObj), processedStatement)
+}
+
+func (s *StoreTestSuite) TestProcessStatementFailsOnUndefinedVariable() {
+	client := mock.NewMockGatewayClientInterface(gomock.NewController(s.T()))
+	store := Store{
+		Properties:       NewUserProperties(map[string]string{}, map[string]string{}),
+		client:           client,
+		appOptions:       &types.ApplicationOptions{},
+		tokenRefreshFunc: tokenRefreshFunc,
+	}
+
+	processedStatement, err := store.ProcessStatement("SELECT * FROM ${topic}")
+	require.Nil(s.T(), processedStatement)
+	require.Equal(s.T(), `undefined variable(s): "topic"`, err.Message)
+}
+
+func (s *StoreTestSuite) TestProcessStatementDoesNotSubstituteVariablesInSetStatement() {
+	store := Store{
+		Properties: NewUserProperties(map[string]string{}, map[string]string{}),
+		appOptions: &types.ApplicationOptions{},
+	}
+
+	_, err := store.ProcessStatement("SET 'client.var.topic'='${env}_orders'")
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), "${env}_orders", store.Properties.GetVariables()["topic"])
+}
+
 func (s *StoreTestSuite) TestWaitPendingStatement() {
 	client := mock.NewMockGatewayClientInterface(gomock.NewController(s.T()))
 	appOptions := &types.ApplicationOptions{
@@ -13,6 +13,7 @@ import (
 	flinkgatewayv1beta1 "github.com/confluentinc/ccloud-sdk-go-v2/flink-gateway/v1beta1"
 
 	"github.com/confluentinc/cli/v3/pkg/flink/config"
+	"github.com/confluentinc/cli/v3/pkg/flink/internal/utils"
 	"github.com/confluentinc/cli/v3/pkg/flink/types"
 )
 
@@ -229,7 +230,7 @@ func parseSetStatement(statement string) (string, string, error) {
 	keyWithQuotes = keyWithQuotes[1 : len(keyWithQuotes)-1]
 	valueWithQuotes = valueWithQuotes[1 : len(valueWithQuotes)-1]
 
-	if containsUnescapedSingleQuote(keyWithQuotes) {
+	if utils.ContainsUnescapedSingleQuote(keyWithQuotes) {
 		return "", "", &types.StatementError{
 			Message:    "key contains unescaped single quotes (')",
 			Usage:      []string{"SET 'key'='value'"},
@@ -237,7 +238,7 @@ func parseSetStatement(statement string) (string, string, error) {
 		}
 	}
 
-	if containsUnescapedSingleQuote(valueWithQuotes) {
+	if utils.ContainsUnescapedSingleQuote(valueWithQuotes) {
 		return "", "", &types.StatementError{
 			Message:    "value contains unescaped single quotes (')",
 			Usage:      []string{"SET 'key'='value'"},
@@ -330,7 +331,7 @@ func parseResetStatement(statement string) (string, error) {
 	// remove enclosing quotes
 	strAfterReset = strAfterReset[1 : len(strAfterReset)-1]
 
-	if containsUnescapedSingleQuote(strAfterReset) {
+	if utils.ContainsUnescapedSingleQuote(strAfterReset) {
 		return "", &types.StatementError{
 			Message:    "key contains unescaped single quotes (')",
 			Usage:      []string{"RESET 'key'"},
@@ -464,9 +465,3 @@ func (s *Store) getTimeout() time.Duration {
 		return config.DefaultTimeoutDuration
 	}
 }
-
-func containsUnescapedSingleQuote(str string) bool {
-	// remove escaped quotes and check if there are still single quotes
-	str = strings.ReplaceAll(str, "''", "")
-	return strings.Contains(str, "'")
-}
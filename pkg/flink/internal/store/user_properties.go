@@ -70,6 +70,17 @@ func (p *UserProperties) GetNonLocalProperties() map[string]string {
 	return nonLocalProperties
 }
 
+// GetVariables returns the client-side variables (identified by the 'client.var.' prefix) keyed by their names without the prefix
+func (p *UserProperties) GetVariables() map[string]string {
+	variables := map[string]string{}
+	for key, value := range p.properties {
+		if name, ok := strings.CutPrefix(key, config.NamespaceClientVar); ok {
+			variables[name] = value
+		}
+	}
+	return variables
+}
+
 func (p *UserProperties) Delete(key string) {
 	defaultValue, isDefaultKey := p.defaultProperties[key]
 	if isDefaultKey {
@@ -177,3 +177,14 @@ func (s *UserPropertiesTestSuite) TestShouldOnlyReturnNonLocalNamespacePropertie
 		"default-key":     "default-value",
 	}, s.userProperties.GetNonLocalProperties())
 }
+
+func (s *UserPropertiesTestSuite) TestShouldOnlyReturnVariables() {
+	s.userProperties.Set(config.KeyResultsTimeout, "1000")
+	s.userProperties.Set(config.NamespaceClientVar+"env", "prod")
+	s.userProperties.Set(config.NamespaceClientVar+"topic", "orders")
+
+	require.Equal(s.T(), map[string]string{
+		"env":   "prod",
+		"topic": "orders",
+	}, s.userProperties.GetVariables())
+}
@@ -0,0 +1,9 @@
+package utils
+
+import "strings"
+
+func ContainsUnescapedSingleQuote(str string) bool {
+	// remove escaped quotes and check if there are still single quotes
+	str = strings.ReplaceAll(str, "''", "")
+	return strings.Contains(str, "'")
+}
@@ -0,0 +1,297 @@
+package mock
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	flinkgatewayv1beta1 "github.com/confluentinc/ccloud-sdk-go-v2/flink-gateway/v1beta1"
+
+	"github.com/confluentinc/cli/v3/pkg/ccloudv2"
+	perrors "github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/errors/flink"
+)
+
+const (
+	recordingVersion     = 2
+	maxRecordingLineSize = 64 * 1024 * 1024
+)
+
+const (
+	createStatementMethod     = "CreateStatement"
+	deleteStatementMethod     = "DeleteStatement"
+	getExceptionsMethod       = "GetExceptions"
+	getStatementMethod        = "GetStatement"
+	getStatementResultsMethod = "GetStatementResults"
+	listStatementsMethod      = "ListStatements"
+	updateStatementMethod     = "UpdateStatement"
+)
+
+// RecordingHeader is the first line of a recording file. Every following line is an Interaction, so that interactions
+// are appended to the file as they happen.
+type RecordingHeader struct {
+	Version int `json:"version"`
+}
+
+// Interaction is a single recorded call to the gateway. Calls are identified by their method and a key, which is the
+// SQL of created statements, the statement name (and page token) for calls on a statement, and the compute pool for lists.
+// Errors of the gateway keep their status code and suggestions, which callers depend on.
+type Interaction struct {
+	Method      string          `json:"method"`
+	Key         string          `json:"key"`
+	Response    json.RawMessage `json:"response,omitempty"`
+	Error       string          `json:"error,omitempty"`
+	StatusCode  int             `json:"status_code,omitempty"`
+	Suggestions string          `json:"suggestions,omitempty"`
+}
+
+// RecordingFlinkGatewayClient forwards all calls to a gateway client and appends the responses to a recording file
+type RecordingFlinkGatewayClient struct {
+	client ccloudv2.GatewayClientInterface
+	file   *os.File
+	mu     sync.Mutex
+}
+
+func NewRecordingFlinkGatewayClient(client ccloudv2.GatewayClientInterface, path string) (*RecordingFlinkGatewayClient, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create recording: %w", err)
+	}
+
+	c := &RecordingFlinkGatewayClient{client: client, file: file}
+	if err := c.writeLine(RecordingHeader{Version: recordingVersion}); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+// Close closes the recording file. Closing it more than once is not an error.
+func (c *RecordingFlinkGatewayClient) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.file.Close(); err != nil && !errors.Is(err, os.ErrClosed) {
+		return fmt.Errorf("failed to close recording: %w", err)
+	}
+	return nil
+}
+
+func (c *RecordingFlinkGatewayClient) DeleteStatement(environmentId, statementName, orgId string) error {
+	err := c.client.DeleteStatement(environmentId, statementName, orgId)
+	return c.record(deleteStatementMethod, statementName, nil, err)
+}
+
+func (c *RecordingFlinkGatewayClient) UpdateStatement(environmentId, statementName, orgId string, statement flinkgatewayv1beta1.SqlV1beta1Statement) error {
+	err := c.client.UpdateStatement(environmentId, statementName, orgId, statement)
+	return c.record(updateStatementMethod, statementName, nil, err)
+}
+
+func (c *RecordingFlinkGatewayClient) GetStatement(environmentId, statementName, orgId string) (flinkgatewayv1beta1.SqlV1beta1Statement, error) {
+	statement, err := c.client.GetStatement(environmentId, statementName, orgId)
+	return statement, c.record(getStatementMethod, statementName, statement, err)
+}
+
+func (c *RecordingFlinkGatewayClient) ListStatements(environmentId, orgId, computePoolId string) ([]flinkgatewayv1beta1.SqlV1beta1Statement, error) {
+	statements, err := c.client.ListStatements(environmentId, orgId, computePoolId)
+	return statements, c.record(listStatementsMethod, computePoolId, statements, err)
+}
+
+func (c *RecordingFlinkGatewayClient) CreateStatement(statement flinkgatewayv1beta1.SqlV1beta1Statement, principal, environmentId, orgId string) (flinkgatewayv1beta1.SqlV1beta1Statement, error) {
+	createdStatement, err := c.client.CreateStatement(statement, principal, environmentId, orgId)
+	return createdStatement, c.record(createStatementMethod, statement.Spec.GetStatement(), createdStatement, err)
+}
+
+func (c *RecordingFlinkGatewayClient) GetStatementResults(environmentId, statementId, orgId, pageToken string) (flinkgatewayv1beta1.SqlV1beta1StatementResult, error) {
+	results, err := c.client.GetStatementResults(environmentId, statementId, orgId, pageToken)
+	return results, c.record(getStatementResultsMethod, resultsKey(statementId, pageToken), results, err)
+}
+
+func (c *RecordingFlinkGatewayClient) GetExceptions(environmentId, statementId, orgId string) ([]flinkgatewayv1beta1.SqlV1beta1StatementException, error) {
+	exceptions, err := c.client.GetExceptions(environmentId, statementId, orgId)
+	return exceptions, c.record(getExceptionsMethod, statementId, exceptions, err)
+}
+
+// record appends the interaction to the recording file as soon as it happens, so that the recording is complete even
+// if the session ends abruptly. The error of the gateway call is passed through unless the recording can't be written.
+func (c *RecordingFlinkGatewayClient) record(method, key string, response any, err error) error {
+	interaction := &Interaction{Method: method, Key: key}
+	if err != nil {
+		interaction.Error = err.Error()
+		if coder, ok := err.(flink.Coder); ok {
+			interaction.StatusCode = coder.StatusCode()
+		}
+		if suggester, ok := err.(perrors.ErrorWithSuggestions); ok {
+			interaction.Suggestions = suggester.GetSuggestionsMsg()
+		}
+	} else if response != nil {
+		out, marshalErr := json.Marshal(response)
+		if marshalErr != nil {
+			return marshalErr
+		}
+		interaction.Response = out
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if writeErr := c.writeLine(interaction); writeErr != nil {
+		return writeErr
+	}
+
+	return err
+}
+
+func (c *RecordingFlinkGatewayClient) writeLine(v any) error {
+	out, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if _, err := c.file.Write(append(out, '\n')); err != nil {
+		return fmt.Errorf("failed to write recording: %w", err)
+	}
+	return nil
+}
+
+// ReplayFlinkGatewayClient serves the responses of a recording instead of calling the gateway. Responses for the same
+// method and key are served in the order they were recorded; once they are used up, the last one is repeated, so
+// that polling a statement or its results always ends in the recorded final state.
+type ReplayFlinkGatewayClient struct {
+	interactions map[string][]*Interaction
+	served       map[string]int
+	// Statement names are generated on creation, so names of a replayed session are mapped to the recorded names
+	names map[string]string
+	mu    sync.Mutex
+}
+
+func NewReplayFlinkGatewayClient(path string) (*ReplayFlinkGatewayClient, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	c := &ReplayFlinkGatewayClient{
+		interactions: make(map[string][]*Interaction),
+		served:       make(map[string]int),
+		names:        make(map[string]string),
+	}
+
+	scanner := bufio.NewScanner(file)
+	// Lines hold whole responses, such as pages of statement results
+	scanner.Buffer(nil, maxRecordingLineSize)
+
+	var header RecordingHeader
+	if !scanner.Scan() || json.Unmarshal(scanner.Bytes(), &header) != nil {
+		return nil, fmt.Errorf("failed to parse recording %s", path)
+	}
+	if header.Version != recordingVersion {
+		return nil, fmt.Errorf(`unsupported recording version %d in %s`, header.Version, path)
+	}
+
+	for line := 2; scanner.Scan(); line++ {
+		interaction := new(Interaction)
+		if err := json.Unmarshal(scanner.Bytes(), interaction); err != nil {
+			return nil, fmt.Errorf("failed to parse line %d of recording %s: %w", line, path, err)
+		}
+		id := interactionId(interaction.Method, interaction.Key)
+		c.interactions[id] = append(c.interactions[id], interaction)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read recording %s: %w", path, err)
+	}
+
+	return c, nil
+}
+
+func (c *ReplayFlinkGatewayClient) DeleteStatement(_, statementName, _ string) error {
+	return c.replay(deleteStatementMethod, c.recordedName(statementName), nil)
+}
+
+func (c *ReplayFlinkGatewayClient) UpdateStatement(_, statementName, _ string, _ flinkgatewayv1beta1.SqlV1beta1Statement) error {
+	return c.replay(updateStatementMethod, c.recordedName(statementName), nil)
+}
+
+func (c *ReplayFlinkGatewayClient) GetStatement(_, statementName, _ string) (flinkgatewayv1beta1.SqlV1beta1Statement, error) {
+	var statement flinkgatewayv1beta1.SqlV1beta1Statement
+	err := c.replay(getStatementMethod, c.recordedName(statementName), &statement)
+	return statement, err
+}
+
+func (c *ReplayFlinkGatewayClient) ListStatements(_, _, computePoolId string) ([]flinkgatewayv1beta1.SqlV1beta1Statement, error) {
+	var statements []flinkgatewayv1beta1.SqlV1beta1Statement
+	err := c.replay(listStatementsMethod, computePoolId, &statements)
+	return statements, err
+}
+
+func (c *ReplayFlinkGatewayClient) CreateStatement(statement flinkgatewayv1beta1.SqlV1beta1Statement, _, _, _ string) (flinkgatewayv1beta1.SqlV1beta1Statement, error) {
+	var createdStatement flinkgatewayv1beta1.SqlV1beta1Statement
+	err := c.replay(createStatementMethod, statement.Spec.GetStatement(), &createdStatement)
+	if createdStatement.GetName() != "" {
+		c.mu.Lock()
+		c.names[statement.GetName()] = createdStatement.GetName()
+		c.mu.Unlock()
+	}
+	return createdStatement, err
+}
+
+func (c *ReplayFlinkGatewayClient) GetStatementResults(_, statementId, _, pageToken string) (flinkgatewayv1beta1.SqlV1beta1StatementResult, error) {
+	var results flinkgatewayv1beta1.SqlV1beta1StatementResult
+	err := c.replay(getStatementResultsMethod, resultsKey(c.recordedName(statementId), pageToken), &results)
+	return results, err
+}
+
+func (c *ReplayFlinkGatewayClient) GetExceptions(_, statementId, _ string) ([]flinkgatewayv1beta1.SqlV1beta1StatementException, error) {
+	var exceptions []flinkgatewayv1beta1.SqlV1beta1StatementException
+	err := c.replay(getExceptionsMethod, c.recordedName(statementId), &exceptions)
+	return exceptions, err
+}
+
+func (c *ReplayFlinkGatewayClient) replay(method, key string, response any) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id := interactionId(method, key)
+	interactions := c.interactions[id]
+	if len(interactions) == 0 {
+		return fmt.Errorf(`no recorded response for %s "%s"`, method, key)
+	}
+
+	interaction := interactions[min(c.served[id], len(interactions)-1)]
+	c.served[id]++
+
+	if interaction.Error != "" {
+		if interaction.StatusCode == 0 && interaction.Suggestions == "" {
+			return errors.New(interaction.Error)
+		}
+		return flink.NewError(interaction.Error, interaction.Suggestions, interaction.StatusCode)
+	}
+	if response != nil && len(interaction.Response) > 0 {
+		return json.Unmarshal(interaction.Response, response)
+	}
+	return nil
+}
+
+func (c *ReplayFlinkGatewayClient) recordedName(statementName string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if name, ok := c.names[statementName]; ok {
+		return name
+	}
+	return statementName
+}
+
+func interactionId(method, key string) string {
+	return method + "\x00" + key
+}
+
+func resultsKey(statementName, pageToken string) string {
+	if pageToken == "" {
+		return statementName
+	}
+	return fmt.Sprintf("%s?page_token=%s", statementName, pageToken)
+}
@@ -0,0 +1,95 @@
+package mock
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	flinkgatewayv1beta1 "github.com/confluentinc/ccloud-sdk-go-v2/flink-gateway/v1beta1"
+
+	"github.com/confluentinc/cli/v3/pkg/errors/flink"
+	"github.com/confluentinc/cli/v3/pkg/flink/types"
+)
+
+func newStatement(name, sql, phase string) flinkgatewayv1beta1.SqlV1beta1Statement {
+	return flinkgatewayv1beta1.SqlV1beta1Statement{
+		Name:   flinkgatewayv1beta1.PtrString(name),
+		Spec:   &flinkgatewayv1beta1.SqlV1beta1StatementSpec{Statement: flinkgatewayv1beta1.PtrString(sql)},
+		Status: &flinkgatewayv1beta1.SqlV1beta1StatementStatus{Phase: phase},
+	}
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording.jsonl")
+	client := NewMockGatewayClientInterface(gomock.NewController(t))
+
+	request := newStatement("recorded-name", "SELECT 1;", "")
+	client.EXPECT().CreateStatement(request, "u-123", "env-123", "org-123").Return(newStatement("recorded-name", "SELECT 1;", "PENDING"), nil)
+	client.EXPECT().GetStatement("env-123", "recorded-name", "org-123").Return(newStatement("recorded-name", "SELECT 1;", "PENDING"), nil)
+	client.EXPECT().GetStatement("env-123", "recorded-name", "org-123").Return(newStatement("recorded-name", "SELECT 1;", "COMPLETED"), nil)
+	client.EXPECT().DeleteStatement("env-123", "unknown", "org-123").Return(flink.NewError("statement not found", "List statements.", http.StatusNotFound))
+	client.EXPECT().ListStatements("env-123", "org-123", "lfcp-123").Return(nil, fmt.Errorf("connection refused"))
+
+	recorder, err := NewRecordingFlinkGatewayClient(client, path)
+	require.NoError(t, err)
+	defer recorder.Close()
+	_, err = recorder.CreateStatement(request, "u-123", "env-123", "org-123")
+	require.NoError(t, err)
+	_, err = recorder.GetStatement("env-123", "recorded-name", "org-123")
+	require.NoError(t, err)
+	_, err = recorder.GetStatement("env-123", "recorded-name", "org-123")
+	require.NoError(t, err)
+	require.EqualError(t, recorder.DeleteStatement("env-123", "unknown", "org-123"), "statement not found")
+	_, err = recorder.ListStatements("env-123", "org-123", "lfcp-123")
+	require.EqualError(t, err, "connection refused")
+	require.NoError(t, recorder.Close())
+
+	// Interactions are appended to the recording, one per line after the header
+	out, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Len(t, strings.Split(strings.TrimSpace(string(out)), "\n"), 6)
+
+	replay, err := NewReplayFlinkGatewayClient(path)
+	require.NoError(t, err)
+
+	// The statement name generated during the replay is mapped to the recorded name
+	statement, err := replay.CreateStatement(newStatement("replayed-name", "SELECT 1;", ""), "", "", "")
+	require.NoError(t, err)
+	require.Equal(t, "recorded-name", statement.GetName())
+	require.Equal(t, "PENDING", statement.Status.GetPhase())
+
+	for _, expected := range []string{"PENDING", "COMPLETED", "COMPLETED"} {
+		statement, err = replay.GetStatement("", "replayed-name", "")
+		require.NoError(t, err)
+		require.Equal(t, expected, statement.Status.GetPhase())
+	}
+
+	// Errors of the gateway are replayed with their status code and suggestions
+	err = replay.DeleteStatement("", "unknown", "")
+	require.Equal(t, flink.NewError("statement not found", "List statements.", http.StatusNotFound), err)
+	_, err = replay.ListStatements("", "", "lfcp-123")
+	require.EqualError(t, err, "connection refused")
+	require.Equal(t, 0, types.StatusCode(err))
+
+	_, err = replay.CreateStatement(newStatement("", "SELECT 2;", ""), "", "", "")
+	require.EqualError(t, err, `no recorded response for CreateStatement "SELECT 2;"`)
+}
+
+func TestReplayMissingFile(t *testing.T) {
+	_, err := NewReplayFlinkGatewayClient(filepath.Join(t.TempDir(), "missing.jsonl"))
+	require.Error(t, err)
+}
+
+func TestReplayUnsupportedVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte(`{"version":1}`+"\n"), 0600))
+
+	_, err := NewReplayFlinkGatewayClient(path)
+	require.ErrorContains(t, err, "unsupported recording version 1")
+}
@@ -7,6 +7,7 @@ package mock
 import (
 	reflect "reflect"
 
+	history "github.com/confluentinc/cli/v3/pkg/flink/internal/history"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -34,7 +35,7 @@ func (m *MockReverseISearch) EXPECT() *MockReverseISearchMockRecorder {
 }
 
 // ReverseISearch mocks base method.
-func (m *MockReverseISearch) ReverseISearch(arg0 []string, arg1 string) string {
+func (m *MockReverseISearch) ReverseISearch(arg0 []history.Entry, arg1 string) string {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ReverseISearch", arg0, arg1)
 	ret0, _ := ret[0].(string)
@@ -14,6 +14,7 @@ type ApplicationOptions struct {
 	ComputePoolId    string
 	ServiceAccountId string
 	Verbose          bool
+	Variables        map[string]string
 	Context          *config.Context
 }
 
@@ -80,6 +81,13 @@ func (a *ApplicationOptions) GetVerbose() bool {
 	return false
 }
 
+func (a *ApplicationOptions) GetVariables() map[string]string {
+	if a != nil {
+		return a.Variables
+	}
+	return nil
+}
+
 func (a *ApplicationOptions) GetContext() *config.Context {
 	if a != nil {
 		return a.Context
@@ -0,0 +1,16 @@
+package types
+
+type LintSeverity string
+
+const (
+	LintError   LintSeverity = "error"
+	LintWarning LintSeverity = "warning"
+)
+
+type LintIssue struct {
+	Line     int
+	Column   int
+	Severity LintSeverity
+	Rule     string
+	Message  string
+}
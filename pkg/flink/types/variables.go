@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var variableReferenceRegex = regexp.MustCompile(`\$\{([^}]*)\}`)
+
+// SubstituteVariables replaces every "${name}" reference in the statement with the value of the variable "name".
+// All references must resolve to a defined variable; undefined variables are reported together in a single error.
+func SubstituteVariables(statement string, variables map[string]string) (string, *StatementError) {
+	undefined := map[string]bool{}
+	substituted := variableReferenceRegex.ReplaceAllStringFunc(statement, func(reference string) string {
+		name := strings.TrimSpace(variableReferenceRegex.FindStringSubmatch(reference)[1])
+		value, ok := variables[name]
+		if !ok {
+			undefined[name] = true
+			return reference
+		}
+		return value
+	})
+
+	if len(undefined) > 0 {
+		names := make([]string, 0, len(undefined))
+		for name := range undefined {
+			names = append(names, fmt.Sprintf(`"%s"`, name))
+		}
+		sort.Strings(names)
+		return "", &StatementError{
+			Message:    fmt.Sprintf("undefined variable(s): %s", strings.Join(names, ", ")),
+			Suggestion: `define a variable with "SET 'client.var.name'='value'" or with the "--var" and "--var-file" flags`,
+		}
+	}
+
+	return substituted, nil
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubstituteVariables(t *testing.T) {
+	variables := map[string]string{"env": "prod", "cluster": "lkc-123456"}
+
+	statement, err := SubstituteVariables("SELECT * FROM `${env}_orders` /* ${ cluster } */", variables)
+	require.Nil(t, err)
+	require.Equal(t, "SELECT * FROM `prod_orders` /* lkc-123456 */", statement)
+
+	statement, err = SubstituteVariables("SELECT 1", nil)
+	require.Nil(t, err)
+	require.Equal(t, "SELECT 1", statement)
+}
+
+func TestSubstituteVariablesFailsOnUndefinedVariables(t *testing.T) {
+	_, err := SubstituteVariables("SELECT ${b}, ${a}, ${b}, ${env}", map[string]string{"env": "prod"})
+	require.NotNil(t, err)
+	require.Equal(t, `undefined variable(s): "a", "b"`, err.Message)
+}
@@ -3,6 +3,7 @@ package keystore
 import (
 	"github.com/confluentinc/cli/v3/pkg/ccloudv2"
 	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/credentialhelper"
 	"github.com/confluentinc/cli/v3/pkg/kafka"
 )
 
@@ -21,12 +22,26 @@ func (c *ConfigKeyStore) HasAPIKey(client *ccloudv2.Client, key, clusterId strin
 }
 
 // StoreAPIKey creates a new API key pair in the local key store for later usage
+// If a credential helper is configured, the secret is stored by the helper instead of in the config
 func (c *ConfigKeyStore) StoreAPIKey(client *ccloudv2.Client, key *config.APIKeyPair, clusterId string) error {
 	kcc, err := kafka.FindCluster(client, c.Config.Context(), clusterId)
 	if err != nil {
 		return err
 	}
 
+	if c.Config.CredentialHelper != "" {
+		request := &credentialhelper.Request{
+			Type:     credentialhelper.APIKey,
+			Key:      key.Key,
+			Resource: clusterId,
+			Secret:   key.Secret,
+		}
+		if err := credentialhelper.Store(c.Config.CredentialHelper, request); err != nil {
+			return err
+		}
+		key.CredentialHelper = c.Config.CredentialHelper
+	}
+
 	kcc.APIKeys[key.Key] = key
 	if err := kcc.EncryptAPIKeys(); err != nil {
 		return err
@@ -36,6 +51,31 @@ func (c *ConfigKeyStore) StoreAPIKey(client *ccloudv2.Client, key *config.APIKey
 }
 
 func (c *ConfigKeyStore) DeleteAPIKey(key string) error {
-	c.Config.Context().KafkaClusterContext.DeleteApiKey(key)
+	kafkaClusterContext := c.Config.Context().KafkaClusterContext
+	if id := kafkaClusterContext.FindApiKeyClusterId(key); id != "" {
+		if pair, ok := kafkaClusterContext.GetKafkaClusterConfig(id).APIKeys[key]; ok && pair.CredentialHelper != "" {
+			request := &credentialhelper.Request{
+				Type:     credentialhelper.APIKey,
+				Key:      key,
+				Resource: id,
+			}
+			if err := credentialhelper.Erase(pair.CredentialHelper, request); err != nil {
+				return err
+			}
+		}
+	}
+
+	kafkaClusterContext.DeleteApiKey(key)
 	return c.Config.Save()
 }
+
+// StoreSchemaRegistryAPIKey stores the secret of a Schema Registry API key with the configured credential helper
+func (c *ConfigKeyStore) StoreSchemaRegistryAPIKey(key *config.APIKeyPair, clusterId string) error {
+	request := &credentialhelper.Request{
+		Type:     credentialhelper.SchemaRegistryAPIKey,
+		Key:      key.Key,
+		Resource: clusterId,
+		Secret:   key.Secret,
+	}
+	return credentialhelper.Store(c.Config.CredentialHelper, request)
+}
@@ -0,0 +1,87 @@
+package log
+
+import (
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-hclog"
+)
+
+// SetLogFile starts writing every log message to a file as JSON, along with the HTTP requests and responses of the
+// CLI's API clients. Each entry is tagged with a correlation ID, which is unique to this invocation of the CLI. Fields
+// are logged with the first entry, followed by the messages which were buffered before the file was opened.
+func (l *Logger) SetLogFile(path string, fields ...any) error {
+	if l.fileLogger != nil {
+		return nil
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	l.file = file
+	l.fileLogger = hclog.New(&hclog.LoggerOptions{
+		Output:     file,
+		Level:      hclog.Trace,
+		JSONFormat: true,
+		TimeFormat: time.RFC3339Nano,
+	}).With("correlation_id", uuid.New().String())
+	l.started = time.Now()
+
+	l.fileLogger.Info("Started command", redactArgs(fields)...)
+	for _, lm := range l.buffer {
+		l.writeToFile(lm.level, lm.message)
+	}
+	return nil
+}
+
+// IsLogFileEnabled determines if log messages are written to a log file.
+func (l *Logger) IsLogFileEnabled() bool {
+	return l.fileLogger != nil
+}
+
+// CloseLogFile records how a command ended, and closes the log file.
+func (l *Logger) CloseLogFile(err error) {
+	if l.fileLogger == nil {
+		return
+	}
+
+	args := []any{"duration_ms", milliseconds(time.Since(l.started))}
+	if err != nil {
+		l.fileLogger.Error("Finished command", append(args, "error", Redact(err.Error()))...)
+	} else {
+		l.fileLogger.Info("Finished command", args...)
+	}
+
+	_ = l.file.Close()
+	l.file = nil
+	l.fileLogger = nil
+}
+
+func (l *Logger) writeToFile(level Level, message string) {
+	if l.fileLogger != nil {
+		l.fileLogger.Log(mapToHclogLevel(level), Redact(message))
+	}
+}
+
+// redactArgs redacts the values of key-value pairs to be logged.
+func redactArgs(args []any) []any {
+	redactedArgs := make([]any, len(args))
+	for i, arg := range args {
+		if i%2 == 1 {
+			if key, ok := args[i-1].(string); ok && isSensitiveName(key) {
+				arg = redacted
+			} else if value, ok := arg.(string); ok {
+				arg = Redact(value)
+			}
+		}
+		redactedArgs[i] = arg
+	}
+	return redactedArgs
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
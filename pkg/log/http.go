@@ -0,0 +1,132 @@
+package log
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Bodies larger than this are not logged
+const maxLoggedBodySize = 64 * 1024
+
+var requestCount atomic.Int64
+
+type transport struct {
+	next http.RoundTripper
+}
+
+// NewTransport wraps an HTTP transport so that, if there is a log file, each request and response is written to it
+// along with its timing. Authorization headers, API secrets, and tokens are redacted.
+func NewTransport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &transport{next: next}
+}
+
+// UnwrapTransport returns the transport wrapped by NewTransport, or the transport itself if it isn't wrapped.
+func UnwrapTransport(rt http.RoundTripper) http.RoundTripper {
+	if t, ok := rt.(*transport); ok {
+		return t.next
+	}
+	return rt
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	fileLogger := CliLogger.fileLogger
+	if fileLogger == nil {
+		return t.next.RoundTrip(req)
+	}
+
+	id := requestCount.Add(1)
+	fileLogger.Debug("HTTP request",
+		"request_id", id,
+		"method", req.Method,
+		"url", redactURL(req.URL),
+		"headers", redactHeaders(req.Header),
+		"body", readRequestBody(req),
+	)
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	duration := time.Since(start)
+	if err != nil {
+		fileLogger.Error("HTTP request failed",
+			"request_id", id,
+			"duration_ms", milliseconds(duration),
+			"error", Redact(err.Error()),
+		)
+		return nil, err
+	}
+
+	// The response is logged once its body has been read, so that reading it is left to the caller
+	body := &loggedBody{ReadCloser: resp.Body}
+	body.log = func() {
+		fileLogger.Debug("HTTP response",
+			"request_id", id,
+			"status", resp.StatusCode,
+			"duration_ms", milliseconds(duration),
+			"headers", redactHeaders(resp.Header),
+			"body", redactBody(resp.Header.Get("Content-Type"), body.buffer.Bytes(), body.truncated),
+		)
+	}
+	resp.Body = body
+
+	return resp, nil
+}
+
+func readRequestBody(req *http.Request) any {
+	if req.Body == nil || req.GetBody == nil {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(body, maxLoggedBodySize+1))
+	if err != nil {
+		return nil
+	}
+
+	truncated := len(data) > maxLoggedBodySize
+	if truncated {
+		data = data[:maxLoggedBodySize]
+	}
+	return redactBody(req.Header.Get("Content-Type"), data, truncated)
+}
+
+// loggedBody keeps a copy of a response body as it is read, and logs the response at the end of the body, or when it is
+// closed.
+type loggedBody struct {
+	io.ReadCloser
+	buffer    bytes.Buffer
+	truncated bool
+	log       func()
+	once      sync.Once
+}
+
+func (b *loggedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if !b.truncated {
+		if b.buffer.Len()+n > maxLoggedBodySize {
+			b.truncated = true
+		} else {
+			b.buffer.Write(p[:n])
+		}
+	}
+	if err == io.EOF {
+		b.once.Do(b.log)
+	}
+	return n, err
+}
+
+func (b *loggedBody) Close() error {
+	b.once.Do(b.log)
+	return b.ReadCloser.Close()
+}
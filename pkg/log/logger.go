@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"github.com/hashicorp/go-hclog"
 )
@@ -23,6 +24,11 @@ type Logger struct {
 	Level  Level
 	logger hclog.Logger
 	buffer []leveledMessage
+
+	// The log file receives every message as JSON, regardless of verbosity, with credentials redacted
+	file       io.Closer
+	fileLogger hclog.Logger
+	started    time.Time
 }
 
 type leveledMessage struct {
@@ -68,6 +74,7 @@ func (l *Logger) SetVerbosity(verbosity int) {
 
 func (l *Logger) Trace(args ...any) {
 	message := fmt.Sprint(args...)
+	l.writeToFile(TRACE, message)
 	if l.logger.IsTrace() {
 		l.logger.Trace(message)
 	} else {
@@ -81,6 +88,7 @@ func (l *Logger) Tracef(format string, args ...any) {
 
 func (l *Logger) Debug(args ...any) {
 	message := fmt.Sprint(args...)
+	l.writeToFile(DEBUG, message)
 	if l.logger.IsDebug() {
 		l.logger.Debug(message)
 	} else {
@@ -94,6 +102,7 @@ func (l *Logger) Debugf(format string, args ...any) {
 
 func (l *Logger) Info(args ...any) {
 	message := fmt.Sprint(args...)
+	l.writeToFile(INFO, message)
 	if l.logger.IsInfo() {
 		l.logger.Info(message)
 	} else {
@@ -107,6 +116,7 @@ func (l *Logger) Infof(format string, args ...any) {
 
 func (l *Logger) Warn(args ...any) {
 	message := fmt.Sprint(args...)
+	l.writeToFile(WARN, message)
 	if l.logger.IsWarn() {
 		l.logger.Warn(message)
 	} else {
@@ -120,6 +130,7 @@ func (l *Logger) Warnf(format string, args ...any) {
 
 func (l *Logger) Error(args ...any) {
 	message := fmt.Sprint(args...)
+	l.writeToFile(ERROR, message)
 	if l.logger.IsError() {
 		l.logger.Error(message)
 	} else {
@@ -141,18 +152,8 @@ func (l *Logger) Flush() {
 			continue
 		}
 
-		switch lm.level {
-		case ERROR:
-			l.Error(lm.message)
-		case WARN:
-			l.Warn(lm.message)
-		case INFO:
-			l.Info(lm.message)
-		case DEBUG:
-			l.Debug(lm.message)
-		case TRACE:
-			l.Trace(lm.message)
-		}
+		// Buffered messages were already written to the log file, if there is one
+		l.logger.Log(mapToHclogLevel(lm.level), lm.message)
 	}
 
 	l.buffer = []leveledMessage{}
@@ -161,6 +162,9 @@ func (l *Logger) Flush() {
 // Log logs a "msg" and key-value pairs.
 // Example: Log("msg", "hello", "key1", "val1", "key2", "val2")
 func (l *Logger) Log(args ...any) {
+	if l.fileLogger != nil && len(args) > 1 {
+		l.fileLogger.Debug(Redact(fmt.Sprint(args[1])), redactArgs(args[2:])...)
+	}
 	if l.logger.IsDebug() {
 		if args[0] != "msg" {
 			l.logger.Debug(`unexpected logging call, first key should be "msg": ` + fmt.Sprint(args...))
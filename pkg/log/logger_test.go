@@ -2,6 +2,10 @@ package log
 
 import (
 	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -44,3 +48,19 @@ func TestLogger_Flush(t *testing.T) {
 		})
 	}
 }
+
+func TestLogger_SetLogFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "log.json")
+	l := New(ERROR, io.Discard)
+
+	l.Debug("before")
+	require.NoError(t, l.SetLogFile(path))
+	l.Debug("after")
+	l.Flush()
+	l.CloseLogFile(nil)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, 1, strings.Count(string(data), `"@message":"before"`))
+	require.Equal(t, 1, strings.Count(string(data), `"@message":"after"`))
+}
@@ -0,0 +1,128 @@
+package log
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const redacted = "[REDACTED]"
+
+// Headers, query parameters, form fields, and JSON fields whose names match this are redacted
+var sensitiveNameRegex = regexp.MustCompile(`(?i)(secret|password|passphrase|token|authorization|credential|cookie|private[_-]?key|assertion)`)
+
+// Credentials which may appear anywhere in a log message
+var sensitiveValueRegexes = []struct {
+	regex       *regexp.Regexp
+	replacement string
+}{
+	{regexp.MustCompile(`(?i)\b(bearer|basic)\s+[\w\-.~+/]+=*`), "$1 " + redacted},
+	{regexp.MustCompile(`\beyJ[\w-]*\.[\w-]*\.[\w-]*`), redacted},
+	{regexp.MustCompile(`(?i)("[\w-]*(?:secret|password|passphrase|token|credential)[\w-]*"\s*:\s*)"(?:[^"\\]|\\.)*"`), `$1"` + redacted + `"`},
+	{regexp.MustCompile(`(?i)(--[\w-]*(?:secret|password|passphrase|token)[\w-]*(?:=|\s+))\S+`), "${1}" + redacted},
+}
+
+// Redact removes credentials, such as authorization headers, API secrets, and tokens, from a log message.
+func Redact(message string) string {
+	for _, v := range sensitiveValueRegexes {
+		message = v.regex.ReplaceAllString(message, v.replacement)
+	}
+	return message
+}
+
+func isSensitiveName(name string) bool {
+	return sensitiveNameRegex.MatchString(name)
+}
+
+func redactHeaders(header http.Header) map[string]string {
+	redactedHeader := make(map[string]string, len(header))
+	for name, values := range header {
+		if isSensitiveName(name) {
+			redactedHeader[name] = redacted
+		} else {
+			redactedHeader[name] = Redact(strings.Join(values, ", "))
+		}
+	}
+	return redactedHeader
+}
+
+func redactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+
+	redactedURL := *u
+	if _, ok := u.User.Password(); ok {
+		redactedURL.User = url.UserPassword(u.User.Username(), redacted)
+	}
+	if u.RawQuery != "" {
+		redactedURL.RawQuery = redactValues(u.Query()).Encode()
+	}
+	return redactedURL.String()
+}
+
+func redactValues(values url.Values) url.Values {
+	for name := range values {
+		if isSensitiveName(name) {
+			values[name] = []string{redacted}
+		}
+	}
+	return values
+}
+
+// redactBody returns a body to be logged; JSON bodies are logged as JSON with the values of sensitive fields redacted,
+// form bodies are logged with the values of sensitive fields redacted, and other bodies are omitted.
+func redactBody(contentType string, body []byte, truncated bool) any {
+	if truncated {
+		return fmt.Sprintf("<omitted body of more than %d bytes>", maxLoggedBodySize)
+	}
+	if len(body) == 0 {
+		return nil
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		var v any
+		if err := json.Unmarshal(body, &v); err != nil {
+			break
+		}
+		redactedBody, err := json.Marshal(redactJSON(v))
+		if err != nil {
+			break
+		}
+		return json.RawMessage(redactedBody)
+	case mediaType == "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			break
+		}
+		return redactValues(values).Encode()
+	}
+
+	return fmt.Sprintf("<omitted %d bytes of %s>", len(body), contentType)
+}
+
+func redactJSON(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for name, value := range v {
+			if isSensitiveName(name) && value != nil && value != "" {
+				v[name] = redacted
+			} else {
+				v[name] = redactJSON(value)
+			}
+		}
+	case []any:
+		for i, value := range v {
+			v[i] = redactJSON(value)
+		}
+	case string:
+		return Redact(v)
+	}
+	return v
+}
@@ -13,20 +13,25 @@ import (
 // GetMap reads newline-separated configuration files or comma-separated lists of key=value pairs, and supports configuration values containing commas.
 func GetMap(config []string) (map[string]string, error) {
 	if len(config) == 1 && utils.FileExists(config[0]) {
-		return fileToMap(config[0])
+		return FileToMap(config[0])
 	}
 
 	return ConfigFlagToMap(config)
 }
 
-// fileToMap reads key=value pairs from a properties file, ignoring comments and empty lines.
-func fileToMap(filename string) (map[string]string, error) {
+// FileToMap reads key=value pairs from a properties file, ignoring comments and empty lines.
+func FileToMap(filename string) (map[string]string, error) {
 	buf, err := os.ReadFile(filename)
 	if err != nil {
 		return nil, err
 	}
 
-	return toMap(parseLines(string(buf)))
+	return StringToMap(string(buf))
+}
+
+// StringToMap reads key=value pairs from the contents of a properties file, ignoring comments and empty lines.
+func StringToMap(content string) (map[string]string, error) {
+	return toMap(parseLines(content))
 }
 
 func parseLines(content string) []string {
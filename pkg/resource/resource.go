@@ -14,6 +14,7 @@ import (
 const (
 	Unknown                     = "unknown"
 	ACL                         = "ACL"
+	Alias                       = "alias"
 	ApiKey                      = "API key"
 	Broker                      = "broker"
 	ByokKey                     = "self-managed key"
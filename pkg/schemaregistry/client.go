@@ -79,6 +79,11 @@ func (c *Client) UpdateTopLevelMode(req srsdk.ModeUpdateRequest) (srsdk.ModeUpda
 	return res, err
 }
 
+func (c *Client) GetMode(subject string) (srsdk.Mode, error) {
+	res, _, err := c.DefaultApi.GetMode(c.context(), subject).Execute()
+	return res, err
+}
+
 func (c *Client) UpdateMode(subject string, req srsdk.ModeUpdateRequest) (srsdk.ModeUpdateRequest, error) {
 	res, _, err := c.DefaultApi.UpdateMode(c.context(), subject).Body(req).Execute()
 	return res, err
@@ -0,0 +1,336 @@
+package compatibility
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+var avroPrimitives = []string{"null", "boolean", "int", "long", "float", "double", "bytes", "string"}
+
+// Writer types which a reader of another type can read, see https://avro.apache.org/docs/1.11.1/specification/#schema-resolution
+var avroPromotions = map[string][]string{
+	"int":    {"long", "float", "double"},
+	"long":   {"float", "double"},
+	"float":  {"double"},
+	"string": {"bytes"},
+	"bytes":  {"string"},
+}
+
+type avroSchema struct {
+	// kind is the name of a primitive type, or one of record, enum, array, map, fixed, and union
+	kind        string
+	name        string
+	aliases     []string
+	fields      []*avroField
+	symbols     []string
+	enumDefault bool
+	items       *avroSchema
+	values      *avroSchema
+	size        int
+	members     []*avroSchema
+}
+
+type avroField struct {
+	name       string
+	aliases    []string
+	schema     *avroSchema
+	hasDefault bool
+}
+
+type avroParser struct {
+	names map[string]*avroSchema
+}
+
+func parseAvro(schema Schema) (*avroSchema, error) {
+	parser := &avroParser{names: make(map[string]*avroSchema)}
+
+	// Referenced schemas define named types, which are used by name in the schema
+	names := make([]string, 0, len(schema.References))
+	for name := range schema.References {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, err := parser.parseDefinition(schema.References[name]); err != nil {
+			return nil, fmt.Errorf(`failed to parse referenced schema "%s": %w`, name, err)
+		}
+	}
+
+	return parser.parseDefinition(schema.Definition)
+}
+
+func (p *avroParser) parseDefinition(definition string) (*avroSchema, error) {
+	var v any
+	if err := json.Unmarshal([]byte(definition), &v); err != nil {
+		return nil, fmt.Errorf("invalid Avro schema: %w", err)
+	}
+	return p.parse(v, "")
+}
+
+func (p *avroParser) parse(v any, namespace string) (*avroSchema, error) {
+	switch v := v.(type) {
+	case string:
+		if slices.Contains(avroPrimitives, v) {
+			return &avroSchema{kind: v}, nil
+		}
+		if schema, ok := p.names[fullName(v, namespace)]; ok {
+			return schema, nil
+		}
+		if schema, ok := p.names[v]; ok {
+			return schema, nil
+		}
+		return nil, fmt.Errorf(`unknown Avro type "%s"`, v)
+	case []any:
+		union := &avroSchema{kind: "union"}
+		for _, member := range v {
+			schema, err := p.parse(member, namespace)
+			if err != nil {
+				return nil, err
+			}
+			union.members = append(union.members, schema)
+		}
+		return union, nil
+	case map[string]any:
+		return p.parseComplex(v, namespace)
+	default:
+		return nil, fmt.Errorf("invalid Avro schema: unexpected %v", v)
+	}
+}
+
+func (p *avroParser) parseComplex(v map[string]any, namespace string) (*avroSchema, error) {
+	kind, ok := v["type"].(string)
+	if !ok {
+		// A type like {"type": {"type": "array", ...}}
+		return p.parse(v["type"], namespace)
+	}
+
+	switch kind {
+	case "record", "error", "enum", "fixed":
+		if ns, ok := v["namespace"].(string); ok {
+			namespace = ns
+		}
+		name, _ := v["name"].(string)
+		name = fullName(name, namespace)
+		if i := strings.LastIndex(name, "."); i >= 0 {
+			namespace = name[:i]
+		}
+
+		schema := &avroSchema{kind: kind, name: name, aliases: parseAliases(v, namespace)}
+		if kind == "error" {
+			schema.kind = "record"
+		}
+		// Register the name before parsing the fields, since records may be recursive
+		p.names[name] = schema
+
+		switch schema.kind {
+		case "record":
+			fields, _ := v["fields"].([]any)
+			for _, f := range fields {
+				field, ok := f.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf(`invalid field of record "%s"`, name)
+				}
+				fieldSchema, err := p.parse(field["type"], namespace)
+				if err != nil {
+					return nil, err
+				}
+				fieldName, _ := field["name"].(string)
+				_, hasDefault := field["default"]
+				schema.fields = append(schema.fields, &avroField{
+					name:       fieldName,
+					aliases:    parseAliases(field, ""),
+					schema:     fieldSchema,
+					hasDefault: hasDefault,
+				})
+			}
+		case "enum":
+			symbols, _ := v["symbols"].([]any)
+			for _, symbol := range symbols {
+				schema.symbols = append(schema.symbols, fmt.Sprint(symbol))
+			}
+			_, schema.enumDefault = v["default"]
+		case "fixed":
+			size, _ := v["size"].(float64)
+			schema.size = int(size)
+		}
+		return schema, nil
+	case "array":
+		items, err := p.parse(v["items"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		return &avroSchema{kind: kind, items: items}, nil
+	case "map":
+		values, err := p.parse(v["values"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		return &avroSchema{kind: kind, values: values}, nil
+	default:
+		// Primitive types, including those with a logical type like {"type": "int", "logicalType": "date"}
+		return p.parse(kind, namespace)
+	}
+}
+
+func fullName(name, namespace string) string {
+	if strings.Contains(name, ".") || namespace == "" {
+		return name
+	}
+	return namespace + "." + name
+}
+
+func parseAliases(v map[string]any, namespace string) []string {
+	aliases, _ := v["aliases"].([]any)
+	names := make([]string, len(aliases))
+	for i, alias := range aliases {
+		names[i] = fullName(fmt.Sprint(alias), namespace)
+	}
+	return names
+}
+
+func checkAvro(reader, writer Schema) ([]Incompatibility, error) {
+	r, err := parseAvro(reader)
+	if err != nil {
+		return nil, err
+	}
+	w, err := parseAvro(writer)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &avroChecker{seen: make(map[[2]*avroSchema]bool)}
+	c.check(r, w, "")
+	return c.incompatibilities, nil
+}
+
+type avroChecker struct {
+	incompatibilities []Incompatibility
+	// Pairs of reader and writer schemas which were already checked, since named types may be recursive
+	seen map[[2]*avroSchema]bool
+}
+
+func (c *avroChecker) add(path, format string, args ...any) {
+	if path == "" {
+		path = "/"
+	}
+	c.incompatibilities = append(c.incompatibilities, Incompatibility{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (c *avroChecker) check(r, w *avroSchema, path string) {
+	pair := [2]*avroSchema{r, w}
+	if c.seen[pair] {
+		return
+	}
+	c.seen[pair] = true
+
+	if w.kind == "union" {
+		for i, member := range w.members {
+			memberPath := fmt.Sprintf("%s/%d", path, i)
+			if r.kind == "union" {
+				if match := findAvroUnionMember(r, member); match != nil {
+					c.check(match, member, memberPath)
+				} else {
+					c.add(memberPath, "reader union doesn't contain writer type %s", member.typeName())
+				}
+			} else {
+				c.check(r, member, memberPath)
+			}
+		}
+		return
+	}
+
+	if r.kind == "union" {
+		if match := findAvroUnionMember(r, w); match != nil {
+			c.check(match, w, path)
+		} else {
+			c.add(path, "reader union doesn't contain writer type %s", w.typeName())
+		}
+		return
+	}
+
+	if r.kind != w.kind {
+		if !slices.Contains(avroPromotions[w.kind], r.kind) {
+			c.add(path, "reader type %s doesn't match writer type %s", r.typeName(), w.typeName())
+		}
+		return
+	}
+
+	switch r.kind {
+	case "record":
+		if !r.matchesName(w) {
+			c.add(path, "reader name %s doesn't match writer name %s", r.name, w.name)
+			return
+		}
+		for _, field := range r.fields {
+			fieldPath := fmt.Sprintf("%s/fields/%s", path, field.name)
+			if writerField := w.findField(field); writerField != nil {
+				c.check(field.schema, writerField.schema, fieldPath)
+			} else if !field.hasDefault {
+				c.add(fieldPath, `reader field "%s" has no default value and is missing from the writer schema`, field.name)
+			}
+		}
+	case "enum":
+		if !r.matchesName(w) {
+			c.add(path, "reader name %s doesn't match writer name %s", r.name, w.name)
+			return
+		}
+		if !r.enumDefault {
+			for _, symbol := range w.symbols {
+				if !slices.Contains(r.symbols, symbol) {
+					c.add(path+"/symbols", `reader enum %s is missing writer symbol "%s" and has no default`, r.name, symbol)
+				}
+			}
+		}
+	case "fixed":
+		if !r.matchesName(w) {
+			c.add(path, "reader name %s doesn't match writer name %s", r.name, w.name)
+			return
+		}
+		if r.size != w.size {
+			c.add(path+"/size", "reader size %d doesn't match writer size %d", r.size, w.size)
+		}
+	case "array":
+		c.check(r.items, w.items, path+"/items")
+	case "map":
+		c.check(r.values, w.values, path+"/values")
+	}
+}
+
+func (s *avroSchema) typeName() string {
+	if s.name != "" {
+		return s.name
+	}
+	return s.kind
+}
+
+func (s *avroSchema) matchesName(writer *avroSchema) bool {
+	return s.name == writer.name || slices.Contains(s.aliases, writer.name)
+}
+
+func (s *avroSchema) findField(readerField *avroField) *avroField {
+	for _, field := range s.fields {
+		if field.name == readerField.name || slices.Contains(readerField.aliases, field.name) {
+			return field
+		}
+	}
+	return nil
+}
+
+// findAvroUnionMember returns the first member of the reader union which matches the writer schema, preferring
+// members of the same type over promotions
+func findAvroUnionMember(union, writer *avroSchema) *avroSchema {
+	for _, member := range union.members {
+		if member.kind == writer.kind && (member.name == "" || member.matchesName(writer)) {
+			return member
+		}
+	}
+	for _, member := range union.members {
+		if slices.Contains(avroPromotions[writer.kind], member.kind) {
+			return member
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package compatibility
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const avroPayment = `{"type":"record","name":"Payment","namespace":"com.acme","fields":[{"name":"id","type":"string"},{"name":"amount","type":"int"}]}`
+
+func checkAvroDefinitions(t *testing.T, reader, writer string) []string {
+	incompatibilities, err := checkAvro(Schema{Definition: reader}, Schema{Definition: writer})
+	require.NoError(t, err)
+
+	messages := make([]string, len(incompatibilities))
+	for i, incompatibility := range incompatibilities {
+		messages[i] = incompatibility.String()
+	}
+	return messages
+}
+
+func TestCheckAvro(t *testing.T) {
+	cases := map[string]struct {
+		reader   string
+		expected []string
+	}{
+		"same schema": {reader: avroPayment},
+		"added field with default": {
+			reader: `{"type":"record","name":"Payment","namespace":"com.acme","fields":[{"name":"id","type":"string"},{"name":"amount","type":"int"},{"name":"currency","type":"string","default":"USD"}]}`,
+		},
+		"added field without default": {
+			reader:   `{"type":"record","name":"Payment","namespace":"com.acme","fields":[{"name":"id","type":"string"},{"name":"amount","type":"int"},{"name":"currency","type":"string"}]}`,
+			expected: []string{`/fields/currency: reader field "currency" has no default value and is missing from the writer schema`},
+		},
+		"removed field": {
+			reader: `{"type":"record","name":"Payment","namespace":"com.acme","fields":[{"name":"id","type":"string"}]}`,
+		},
+		"promoted type": {
+			reader: `{"type":"record","name":"Payment","namespace":"com.acme","fields":[{"name":"id","type":"bytes"},{"name":"amount","type":"long"}]}`,
+		},
+		"narrowed type": {
+			reader:   `{"type":"record","name":"Payment","namespace":"com.acme","fields":[{"name":"id","type":"string"},{"name":"amount","type":"boolean"}]}`,
+			expected: []string{"/fields/amount: reader type boolean doesn't match writer type int"},
+		},
+		"renamed field with alias": {
+			reader: `{"type":"record","name":"Payment","namespace":"com.acme","fields":[{"name":"id","type":"string"},{"name":"total","aliases":["amount"],"type":"int"}]}`,
+		},
+		"renamed record": {
+			reader:   `{"type":"record","name":"Order","namespace":"com.acme","fields":[{"name":"id","type":"string"},{"name":"amount","type":"int"}]}`,
+			expected: []string{"/: reader name com.acme.Order doesn't match writer name com.acme.Payment"},
+		},
+		"optional field": {
+			reader: `{"type":"record","name":"Payment","namespace":"com.acme","fields":[{"name":"id","type":"string"},{"name":"amount","type":["null","int"]}]}`,
+		},
+	}
+
+	for name, c := range cases {
+		require.Equal(t, c.expected, nilIfEmpty(checkAvroDefinitions(t, c.reader, avroPayment)), name)
+	}
+}
+
+func TestCheckAvroUnion(t *testing.T) {
+	require.Equal(t, []string{"/0: reader union doesn't contain writer type string"}, checkAvroDefinitions(t, `["null","int"]`, `["string","int"]`))
+	require.Equal(t, []string{"/1: reader type int doesn't match writer type null"}, checkAvroDefinitions(t, `"int"`, `["int","null"]`))
+	require.Empty(t, checkAvroDefinitions(t, `["null","long"]`, `"int"`))
+}
+
+func TestCheckAvroEnum(t *testing.T) {
+	writer := `{"type":"enum","name":"Suit","symbols":["SPADES","HEARTS","CLUBS"]}`
+	require.Equal(t, []string{`/symbols: reader enum Suit is missing writer symbol "CLUBS" and has no default`}, checkAvroDefinitions(t, `{"type":"enum","name":"Suit","symbols":["SPADES","HEARTS"]}`, writer))
+	require.Empty(t, checkAvroDefinitions(t, `{"type":"enum","name":"Suit","symbols":["SPADES","HEARTS"],"default":"SPADES"}`, writer))
+}
+
+func TestCheckAvroRecursive(t *testing.T) {
+	list := `{"type":"record","name":"Node","fields":[{"name":"value","type":"int"},{"name":"next","type":["null","Node"]}]}`
+	require.Empty(t, checkAvroDefinitions(t, list, list))
+}
+
+func TestCheckAvroReferences(t *testing.T) {
+	references := map[string]string{"address": `{"type":"record","name":"Address","namespace":"com.acme","fields":[{"name":"street","type":"string"}]}`}
+	customer := Schema{Definition: `{"type":"record","name":"Customer","namespace":"com.acme","fields":[{"name":"address","type":"Address"}]}`, References: references}
+
+	incompatibilities, err := checkAvro(customer, customer)
+	require.NoError(t, err)
+	require.Empty(t, incompatibilities)
+}
+
+func nilIfEmpty(messages []string) []string {
+	if len(messages) == 0 {
+		return nil
+	}
+	return messages
+}
@@ -0,0 +1,149 @@
+// Package compatibility checks the compatibility of schemas locally, without a Schema Registry. It implements the
+// compatibility levels of Schema Registry for Avro, Protobuf, and JSON Schema.
+package compatibility
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type Level string
+
+const (
+	None               Level = "NONE"
+	Backward           Level = "BACKWARD"
+	BackwardTransitive Level = "BACKWARD_TRANSITIVE"
+	Forward            Level = "FORWARD"
+	ForwardTransitive  Level = "FORWARD_TRANSITIVE"
+	Full               Level = "FULL"
+	FullTransitive     Level = "FULL_TRANSITIVE"
+)
+
+var Levels = []Level{Backward, BackwardTransitive, Forward, ForwardTransitive, Full, FullTransitive, None}
+
+const (
+	Avro     = "AVRO"
+	Protobuf = "PROTOBUF"
+	Json     = "JSON"
+)
+
+type Schema struct {
+	// Type is one of AVRO, PROTOBUF, or JSON. An empty type is AVRO, like in Schema Registry.
+	Type       string
+	Definition string
+	// References maps the names of referenced schemas, such as the files imported by a Protobuf schema, to their definitions
+	References map[string]string
+}
+
+type Incompatibility struct {
+	// Previous is the index of the previous schema which the schema is incompatible with
+	Previous int
+	// Direction is BACKWARD if the schema can't read data written with the previous schema, and FORWARD if the
+	// previous schema can't read data written with the schema
+	Direction Level
+	Path      string
+	Message   string
+}
+
+func (i Incompatibility) String() string {
+	return fmt.Sprintf("%s: %s", i.Path, i.Message)
+}
+
+// checker returns the changes which prevent a reader from reading data written with a writer schema
+type checker func(reader, writer Schema) ([]Incompatibility, error)
+
+var checkers = map[string]checker{
+	Avro:     checkAvro,
+	Protobuf: checkProtobuf,
+	Json:     checkJson,
+}
+
+func ParseLevel(level string) (Level, error) {
+	for _, l := range Levels {
+		if strings.EqualFold(level, string(l)) {
+			return l, nil
+		}
+	}
+	return "", fmt.Errorf(`invalid compatibility level "%s"`, level)
+}
+
+/*
+Check returns the incompatibilities of a schema with its previous schemas, ordered from oldest to newest:
+
+	BACKWARD: the schema can read data written with the latest previous schema
+	FORWARD:  the latest previous schema can read data written with the schema
+	FULL:     both BACKWARD and FORWARD
+
+The transitive levels check all previous schemas instead of only the latest one.
+*/
+func Check(level Level, schema Schema, previous []Schema) ([]Incompatibility, error) {
+	if level == None || len(previous) == 0 {
+		return nil, nil
+	}
+
+	first := len(previous) - 1
+	if strings.HasSuffix(string(level), "_TRANSITIVE") {
+		first = 0
+	}
+	backward := level == Backward || level == BackwardTransitive || level == Full || level == FullTransitive
+	forward := level == Forward || level == ForwardTransitive || level == Full || level == FullTransitive
+
+	var incompatibilities []Incompatibility
+	for i := first; i < len(previous); i++ {
+		if normalizeType(schema.Type) != normalizeType(previous[i].Type) {
+			incompatibilities = append(incompatibilities, Incompatibility{
+				Previous:  i,
+				Direction: level,
+				Path:      "/",
+				Message:   fmt.Sprintf("schema type changed from %s to %s", normalizeType(previous[i].Type), normalizeType(schema.Type)),
+			})
+			continue
+		}
+
+		check, ok := checkers[normalizeType(schema.Type)]
+		if !ok {
+			return nil, fmt.Errorf(`unsupported schema type "%s"`, schema.Type)
+		}
+
+		if backward {
+			found, err := check(schema, previous[i])
+			if err != nil {
+				return nil, err
+			}
+			incompatibilities = append(incompatibilities, withDirection(found, i, Backward)...)
+		}
+		if forward {
+			found, err := check(previous[i], schema)
+			if err != nil {
+				return nil, err
+			}
+			incompatibilities = append(incompatibilities, withDirection(found, i, Forward)...)
+		}
+	}
+	return incompatibilities, nil
+}
+
+func normalizeType(schemaType string) string {
+	if schemaType == "" {
+		return Avro
+	}
+	return strings.ToUpper(schemaType)
+}
+
+func withDirection(incompatibilities []Incompatibility, previous int, direction Level) []Incompatibility {
+	for i := range incompatibilities {
+		incompatibilities[i].Previous = previous
+		incompatibilities[i].Direction = direction
+	}
+	return incompatibilities
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,49 @@
+package compatibility
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck(t *testing.T) {
+	v1 := Schema{Definition: `{"type":"record","name":"Payment","fields":[{"name":"id","type":"string"}]}`}
+	v2 := Schema{Definition: `{"type":"record","name":"Payment","fields":[{"name":"id","type":"string"},{"name":"amount","type":"int","default":0}]}`}
+	// Adding a field without a default breaks BACKWARD, removing one breaks FORWARD
+	v3 := Schema{Definition: `{"type":"record","name":"Payment","fields":[{"name":"amount","type":"int","default":0},{"name":"currency","type":"string"}]}`}
+
+	incompatibilities, err := Check(Backward, v3, []Schema{v1, v2})
+	require.NoError(t, err)
+	require.Equal(t, []Incompatibility{{Previous: 1, Direction: Backward, Path: "/fields/currency", Message: `reader field "currency" has no default value and is missing from the writer schema`}}, incompatibilities)
+
+	incompatibilities, err = Check(FullTransitive, v3, []Schema{v1, v2})
+	require.NoError(t, err)
+	require.Len(t, incompatibilities, 4)
+	require.Equal(t, 0, incompatibilities[0].Previous)
+	require.Equal(t, Backward, incompatibilities[0].Direction)
+	require.Equal(t, Forward, incompatibilities[1].Direction)
+	require.Equal(t, `/fields/id: reader field "id" has no default value and is missing from the writer schema`, incompatibilities[1].String())
+
+	incompatibilities, err = Check(Forward, v2, []Schema{v1})
+	require.NoError(t, err)
+	require.Empty(t, incompatibilities)
+
+	incompatibilities, err = Check(None, v3, []Schema{v1, v2})
+	require.NoError(t, err)
+	require.Empty(t, incompatibilities)
+}
+
+func TestCheckSchemaTypeChanged(t *testing.T) {
+	incompatibilities, err := Check(Backward, Schema{Type: Json, Definition: "{}"}, []Schema{{Definition: `"string"`}})
+	require.NoError(t, err)
+	require.Equal(t, []Incompatibility{{Previous: 0, Direction: Backward, Path: "/", Message: "schema type changed from AVRO to JSON"}}, incompatibilities)
+}
+
+func TestParseLevel(t *testing.T) {
+	level, err := ParseLevel("backward_transitive")
+	require.NoError(t, err)
+	require.Equal(t, BackwardTransitive, level)
+
+	_, err = ParseLevel("sideways")
+	require.EqualError(t, err, `invalid compatibility level "sideways"`)
+}
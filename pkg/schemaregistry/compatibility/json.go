@@ -0,0 +1,194 @@
+package compatibility
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// Keywords which restrict values, and whether a larger value is more restrictive
+var jsonLimits = map[string]bool{
+	"maximum":          false,
+	"exclusiveMaximum": false,
+	"maxLength":        false,
+	"maxItems":         false,
+	"maxProperties":    false,
+	"minimum":          true,
+	"exclusiveMinimum": true,
+	"minLength":        true,
+	"minItems":         true,
+	"minProperties":    true,
+}
+
+func parseJson(definition string) (map[string]any, error) {
+	var schema any
+	if err := json.Unmarshal([]byte(definition), &schema); err != nil {
+		return nil, fmt.Errorf("invalid JSON schema: %w", err)
+	}
+	return jsonObject(schema), nil
+}
+
+// jsonObject returns the keywords of a schema. The boolean schema true and a missing schema accept any value.
+func jsonObject(schema any) map[string]any {
+	if object, ok := schema.(map[string]any); ok {
+		return object
+	}
+	if accept, ok := schema.(bool); ok && !accept {
+		return map[string]any{"not": map[string]any{}}
+	}
+	return map[string]any{}
+}
+
+func checkJson(reader, writer Schema) ([]Incompatibility, error) {
+	r, err := parseJson(reader.Definition)
+	if err != nil {
+		return nil, err
+	}
+	w, err := parseJson(writer.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &jsonChecker{}
+	c.check(r, w, "#")
+	return c.incompatibilities, nil
+}
+
+// jsonChecker checks that every value which is valid against the writer schema is valid against the reader schema
+type jsonChecker struct {
+	incompatibilities []Incompatibility
+}
+
+func (c *jsonChecker) add(path, format string, args ...any) {
+	c.incompatibilities = append(c.incompatibilities, Incompatibility{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (c *jsonChecker) check(r, w map[string]any, path string) {
+	if !reflect.DeepEqual(r["$ref"], w["$ref"]) {
+		c.add(path+"/$ref", "reader reference %v doesn't match writer reference %v", r["$ref"], w["$ref"])
+		return
+	}
+
+	readerTypes := jsonTypes(r)
+	writerTypes := jsonTypes(w)
+	if len(readerTypes) > 0 {
+		if len(writerTypes) == 0 {
+			c.add(path+"/type", "reader restricts the type to %v, writer allows any type", readerTypes)
+		}
+		for _, writerType := range writerTypes {
+			if !slices.Contains(readerTypes, writerType) && !(writerType == "integer" && slices.Contains(readerTypes, "number")) {
+				c.add(path+"/type", `reader types %v don't include writer type "%s"`, readerTypes, writerType)
+			}
+		}
+	}
+
+	if readerEnum, ok := r["enum"].([]any); ok {
+		writerEnum, ok := w["enum"].([]any)
+		if !ok {
+			c.add(path+"/enum", "reader restricts values to an enum, writer doesn't")
+		}
+		for _, value := range writerEnum {
+			if !containsJsonValue(readerEnum, value) {
+				c.add(path+"/enum", "reader enum doesn't include writer value %v", value)
+			}
+		}
+	}
+
+	for _, keyword := range sortedKeys(jsonLimits) {
+		readerLimit, ok := r[keyword].(float64)
+		if !ok {
+			continue
+		}
+		writerLimit, ok := w[keyword].(float64)
+		if !ok {
+			c.add(path+"/"+keyword, "reader adds %s %v", keyword, readerLimit)
+		} else if jsonLimits[keyword] && readerLimit > writerLimit || !jsonLimits[keyword] && readerLimit < writerLimit {
+			c.add(path+"/"+keyword, "reader narrows %s from %v to %v", keyword, writerLimit, readerLimit)
+		}
+	}
+
+	c.checkObject(r, w, path)
+
+	if readerItems, ok := r["items"].(map[string]any); ok {
+		c.check(readerItems, jsonObject(w["items"]), path+"/items")
+	}
+}
+
+func (c *jsonChecker) checkObject(r, w map[string]any, path string) {
+	readerProperties := jsonObject(r["properties"])
+	writerProperties := jsonObject(w["properties"])
+
+	writerRequired := jsonStrings(w["required"])
+	for _, property := range jsonStrings(r["required"]) {
+		if !slices.Contains(writerRequired, property) {
+			c.add(fmt.Sprintf("%s/required/%s", path, property), `reader requires property "%s", writer doesn't`, property)
+		}
+	}
+
+	readerAdditional, readerOpen := r["additionalProperties"]
+	readerClosed := readerOpen && reflect.DeepEqual(readerAdditional, false)
+	writerAdditional, writerOpen := w["additionalProperties"]
+	writerClosed := writerOpen && reflect.DeepEqual(writerAdditional, false)
+	if readerClosed && !writerClosed {
+		c.add(path+"/additionalProperties", "reader doesn't allow additional properties, writer does")
+	}
+
+	for _, property := range sortedKeys(writerProperties) {
+		propertyPath := fmt.Sprintf("%s/properties/%s", path, property)
+		readerProperty, ok := readerProperties[property]
+		if !ok {
+			if readerClosed {
+				c.add(propertyPath, `property "%s" was removed from the reader's closed content model`, property)
+			}
+			continue
+		}
+		c.check(jsonObject(readerProperty), jsonObject(writerProperties[property]), propertyPath)
+	}
+
+	// The writer's open content model allows any value, or any value matching additionalProperties, for properties
+	// it doesn't declare, so a property the reader adds must accept all of them
+	if writerClosed {
+		return
+	}
+	for _, property := range sortedKeys(readerProperties) {
+		if _, ok := writerProperties[property]; ok {
+			continue
+		}
+		additional := &jsonChecker{}
+		additional.check(jsonObject(readerProperties[property]), jsonObject(writerAdditional), "")
+		if len(additional.incompatibilities) > 0 {
+			c.add(fmt.Sprintf("%s/properties/%s", path, property), `property "%s" was added to the reader, but the writer's open content model allows other values for it`, property)
+		}
+	}
+}
+
+func jsonTypes(schema map[string]any) []string {
+	switch types := schema["type"].(type) {
+	case string:
+		return []string{types}
+	case []any:
+		return jsonStrings(types)
+	}
+	return nil
+}
+
+func jsonStrings(v any) []string {
+	values, _ := v.([]any)
+	strs := make([]string, 0, len(values))
+	for _, value := range values {
+		if str, ok := value.(string); ok {
+			strs = append(strs, str)
+		}
+	}
+	return strs
+}
+
+func containsJsonValue(values []any, value any) bool {
+	for _, v := range values {
+		if reflect.DeepEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package compatibility
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const jsonPayment = `{"type":"object","properties":{"id":{"type":"string","maxLength":36},"amount":{"type":"integer"},"status":{"enum":["NEW","PAID"]}},"required":["id"]}`
+
+func TestCheckJson(t *testing.T) {
+	cases := map[string]struct {
+		reader   string
+		expected []string
+	}{
+		"same schema": {reader: jsonPayment},
+		"widened types": {
+			reader: `{"type":"object","properties":{"id":{"type":"string"},"amount":{"type":"number"},"status":{"enum":["NEW","PAID","REFUNDED"]}}}`,
+		},
+		"required property added": {
+			reader:   `{"type":"object","properties":{"id":{"type":"string","maxLength":36},"amount":{"type":"integer"}},"required":["id","amount"]}`,
+			expected: []string{`#/required/amount: reader requires property "amount", writer doesn't`},
+		},
+		"narrowed types": {
+			reader: `{"type":"object","properties":{"id":{"type":"string","maxLength":10},"amount":{"type":"string"},"status":{"enum":["NEW"]}},"required":["id"]}`,
+			expected: []string{
+				`#/properties/amount/type: reader types [string] don't include writer type "integer"`,
+				"#/properties/id/maxLength: reader narrows maxLength from 36 to 10",
+				"#/properties/status/enum: reader enum doesn't include writer value PAID",
+			},
+		},
+		"closed content model": {
+			reader: `{"type":"object","properties":{"id":{"type":"string","maxLength":36}},"required":["id"],"additionalProperties":false}`,
+			expected: []string{
+				"#/additionalProperties: reader doesn't allow additional properties, writer does",
+				`#/properties/amount: property "amount" was removed from the reader's closed content model`,
+				`#/properties/status: property "status" was removed from the reader's closed content model`,
+			},
+		},
+		"property added to open content model": {
+			reader:   `{"type":"object","properties":{"id":{"type":"string","maxLength":36},"amount":{"type":"integer"},"status":{"enum":["NEW","PAID"]},"currency":{"type":"string"}},"required":["id"]}`,
+			expected: []string{`#/properties/currency: property "currency" was added to the reader, but the writer's open content model allows other values for it`},
+		},
+		"property without restrictions added to open content model": {
+			reader: `{"type":"object","properties":{"id":{"type":"string","maxLength":36},"amount":{"type":"integer"},"status":{"enum":["NEW","PAID"]},"metadata":{}},"required":["id"]}`,
+		},
+	}
+
+	for name, c := range cases {
+		incompatibilities, err := checkJson(Schema{Type: Json, Definition: c.reader}, Schema{Type: Json, Definition: jsonPayment})
+		require.NoError(t, err, name)
+
+		var messages []string
+		for _, incompatibility := range incompatibilities {
+			messages = append(messages, incompatibility.String())
+		}
+		require.Equal(t, c.expected, messages, name)
+	}
+}
+
+func TestCheckJsonPartiallyOpenContentModel(t *testing.T) {
+	writer := `{"type":"object","properties":{"id":{"type":"string"}},"additionalProperties":{"type":"string"}}`
+
+	incompatibilities, err := checkJson(Schema{Type: Json, Definition: `{"type":"object","properties":{"id":{"type":"string"},"currency":{"type":"string"}}}`}, Schema{Type: Json, Definition: writer})
+	require.NoError(t, err)
+	require.Empty(t, incompatibilities)
+
+	incompatibilities, err = checkJson(Schema{Type: Json, Definition: `{"type":"object","properties":{"id":{"type":"string"},"count":{"type":"integer"}}}`}, Schema{Type: Json, Definition: writer})
+	require.NoError(t, err)
+	require.Len(t, incompatibilities, 1)
+	require.Equal(t, "#/properties/count", incompatibilities[0].Path)
+}
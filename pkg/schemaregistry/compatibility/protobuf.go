@@ -0,0 +1,144 @@
+package compatibility
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoparse"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const protobufFilename = "schema.proto"
+
+// Scalar types which share a wire format, so that changing a field between them keeps the data readable
+var protobufScalarGroups = [][]descriptorpb.FieldDescriptorProto_Type{
+	{descriptorpb.FieldDescriptorProto_TYPE_INT32, descriptorpb.FieldDescriptorProto_TYPE_UINT32, descriptorpb.FieldDescriptorProto_TYPE_INT64, descriptorpb.FieldDescriptorProto_TYPE_UINT64, descriptorpb.FieldDescriptorProto_TYPE_BOOL},
+	{descriptorpb.FieldDescriptorProto_TYPE_SINT32, descriptorpb.FieldDescriptorProto_TYPE_SINT64},
+	{descriptorpb.FieldDescriptorProto_TYPE_FIXED32, descriptorpb.FieldDescriptorProto_TYPE_SFIXED32},
+	{descriptorpb.FieldDescriptorProto_TYPE_FIXED64, descriptorpb.FieldDescriptorProto_TYPE_SFIXED64},
+	{descriptorpb.FieldDescriptorProto_TYPE_STRING, descriptorpb.FieldDescriptorProto_TYPE_BYTES},
+}
+
+func parseProtobuf(schema Schema) (*desc.FileDescriptor, error) {
+	files := map[string]string{protobufFilename: schema.Definition}
+	for name, definition := range schema.References {
+		files[name] = definition
+	}
+
+	parser := protoparse.Parser{Accessor: protoparse.FileContentsFromMap(files)}
+	descriptors, err := parser.ParseFiles(protobufFilename)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Protobuf schema: %w", err)
+	}
+	return descriptors[0], nil
+}
+
+func checkProtobuf(reader, writer Schema) ([]Incompatibility, error) {
+	r, err := parseProtobuf(reader)
+	if err != nil {
+		return nil, err
+	}
+	w, err := parseProtobuf(writer)
+	if err != nil {
+		return nil, err
+	}
+
+	var incompatibilities []Incompatibility
+	add := func(path, format string, args ...any) {
+		incompatibilities = append(incompatibilities, Incompatibility{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if r.GetPackage() != w.GetPackage() {
+		add("/package", `reader package "%s" doesn't match writer package "%s"`, r.GetPackage(), w.GetPackage())
+	}
+
+	readerMessages := protobufMessages(r)
+	writerMessages := protobufMessages(w)
+	for _, name := range sortedKeys(writerMessages) {
+		writerMessage := writerMessages[name]
+		readerMessage, ok := readerMessages[name]
+		if !ok {
+			add("/"+name, "message %s is missing from the reader schema", name)
+			continue
+		}
+
+		for _, writerField := range writerMessage.GetFields() {
+			path := fmt.Sprintf("/%s/%s", name, writerField.GetName())
+			readerField := readerMessage.FindFieldByNumber(writerField.GetNumber())
+			if readerField == nil {
+				if writerField.IsRequired() {
+					add(path, "required field %d was removed from the reader schema", writerField.GetNumber())
+				}
+				continue
+			}
+
+			if readerField.IsRepeated() != writerField.IsRepeated() {
+				add(path, "field %d changed between repeated and singular", writerField.GetNumber())
+				continue
+			}
+			if readerType, writerType := protobufFieldType(r, readerField), protobufFieldType(w, writerField); !protobufTypesMatch(readerField, writerField, readerType, writerType) {
+				add(path, "reader type %s of field %d doesn't match writer type %s", readerType, writerField.GetNumber(), writerType)
+			}
+		}
+
+		for _, readerField := range readerMessage.GetFields() {
+			if readerField.IsRequired() && writerMessage.FindFieldByNumber(readerField.GetNumber()) == nil {
+				add(fmt.Sprintf("/%s/%s", name, readerField.GetName()), "required field %d is missing from the writer schema", readerField.GetNumber())
+			}
+		}
+	}
+
+	return incompatibilities, nil
+}
+
+// protobufMessages returns all messages of the file, including nested messages, by their name relative to the package
+func protobufMessages(file *desc.FileDescriptor) map[string]*desc.MessageDescriptor {
+	messages := make(map[string]*desc.MessageDescriptor)
+	var add func([]*desc.MessageDescriptor)
+	add = func(descriptors []*desc.MessageDescriptor) {
+		for _, message := range descriptors {
+			if message.IsMapEntry() {
+				continue
+			}
+			messages[relativeName(file, message.GetFullyQualifiedName())] = message
+			add(message.GetNestedMessageTypes())
+		}
+	}
+	add(file.GetMessageTypes())
+	return messages
+}
+
+func relativeName(file *desc.FileDescriptor, name string) string {
+	if file.GetPackage() == "" {
+		return name
+	}
+	return strings.TrimPrefix(name, file.GetPackage()+".")
+}
+
+// protobufFieldType returns the name of a scalar type or the name of a message or enum type, relative to the package
+func protobufFieldType(file *desc.FileDescriptor, field *desc.FieldDescriptor) string {
+	if message := field.GetMessageType(); message != nil {
+		return relativeName(file, message.GetFullyQualifiedName())
+	}
+	if enum := field.GetEnumType(); enum != nil {
+		return relativeName(file, enum.GetFullyQualifiedName())
+	}
+	return strings.ToLower(strings.TrimPrefix(field.GetType().String(), "TYPE_"))
+}
+
+func protobufTypesMatch(readerField, writerField *desc.FieldDescriptor, readerType, writerType string) bool {
+	if readerType == writerType {
+		return true
+	}
+	if readerField.GetMessageType() != nil || writerField.GetMessageType() != nil || readerField.GetEnumType() != nil || writerField.GetEnumType() != nil {
+		return false
+	}
+	for _, group := range protobufScalarGroups {
+		if slices.Contains(group, readerField.GetType()) && slices.Contains(group, writerField.GetType()) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package compatibility
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const protobufPayment = `syntax = "proto3";
+package acme;
+
+message Payment {
+  string id = 1;
+  int32 amount = 2;
+  Customer customer = 3;
+
+  message Customer {
+    string name = 1;
+  }
+}
+`
+
+func TestCheckProtobuf(t *testing.T) {
+	cases := map[string]struct {
+		reader   string
+		expected []string
+	}{
+		"same schema": {reader: protobufPayment},
+		"added and removed fields": {
+			reader: `syntax = "proto3"; package acme; message Payment { string id = 1; string currency = 4; message Customer { string name = 1; } }`,
+		},
+		"compatible scalar type": {
+			reader: `syntax = "proto3"; package acme; message Payment { bytes id = 1; int64 amount = 2; Customer customer = 3; message Customer { string name = 1; } }`,
+		},
+		"incompatible scalar type": {
+			reader:   `syntax = "proto3"; package acme; message Payment { string id = 1; double amount = 2; Customer customer = 3; message Customer { string name = 1; } }`,
+			expected: []string{"/Payment/amount: reader type double of field 2 doesn't match writer type int32"},
+		},
+		"repeated field": {
+			reader:   `syntax = "proto3"; package acme; message Payment { repeated string id = 1; int32 amount = 2; Customer customer = 3; message Customer { string name = 1; } }`,
+			expected: []string{"/Payment/id: field 1 changed between repeated and singular"},
+		},
+		"removed message": {
+			reader:   `syntax = "proto3"; package acme; message Payment { string id = 1; int32 amount = 2; }`,
+			expected: []string{"/Payment.Customer: message Payment.Customer is missing from the reader schema"},
+		},
+		"changed package": {
+			reader:   `syntax = "proto3"; package other; message Payment { string id = 1; int32 amount = 2; Customer customer = 3; message Customer { string name = 1; } }`,
+			expected: []string{`/package: reader package "other" doesn't match writer package "acme"`},
+		},
+	}
+
+	for name, c := range cases {
+		incompatibilities, err := checkProtobuf(Schema{Definition: c.reader}, Schema{Definition: protobufPayment})
+		require.NoError(t, err, name)
+
+		var messages []string
+		for _, incompatibility := range incompatibilities {
+			messages = append(messages, incompatibility.String())
+		}
+		require.Equal(t, c.expected, messages, name)
+	}
+}
+
+func TestCheckProtobufReferences(t *testing.T) {
+	schema := Schema{
+		Definition: `syntax = "proto3"; import "customer.proto"; message Payment { Customer customer = 1; }`,
+		References: map[string]string{"customer.proto": `syntax = "proto3"; message Customer { string name = 1; }`},
+	}
+
+	incompatibilities, err := checkProtobuf(schema, schema)
+	require.NoError(t, err)
+	require.Empty(t, incompatibilities)
+}
@@ -0,0 +1,124 @@
+package secret
+
+import (
+	"bufio"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// The agent caches an unlocked protection key for a session. It listens on a Unix socket in a directory which is only
+// accessible to the user who owns it, and answers one line-based request per connection.
+const (
+	agentRequestGet  = "get"
+	agentRequestStop = "stop"
+	agentResponseOk  = "ok"
+
+	agentDialTimeout = time.Second
+)
+
+// ServeAgent serves a protection key on a socket until it is stopped or the timeout expires. A timeout of zero never
+// expires.
+func ServeAgent(socket string, key []byte, timeout time.Duration) error {
+	// The directory is restricted before the socket is created, so that other users can never connect to it
+	dir := filepath.Dir(socket)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	if err := os.Chmod(dir, 0700); err != nil {
+		return err
+	}
+
+	_ = os.Remove(socket)
+
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		return err
+	}
+	defer listener.Close()
+
+	if timeout > 0 {
+		timer := time.AfterFunc(timeout, func() { _ = listener.Close() })
+		defer timer.Stop()
+	}
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+		if stop := handleAgentConn(conn, key); stop {
+			return nil
+		}
+	}
+}
+
+func handleAgentConn(conn net.Conn, key []byte) bool {
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(agentDialTimeout))
+
+	request, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		return false
+	}
+
+	switch strings.TrimSpace(request) {
+	case agentRequestGet:
+		_, _ = fmt.Fprintln(conn, base64.StdEncoding.EncodeToString(key))
+	case agentRequestStop:
+		_, _ = fmt.Fprintln(conn, agentResponseOk)
+		return true
+	}
+	return false
+}
+
+// GetKeyFromAgent returns the protection key cached by the agent listening on a socket.
+func GetKeyFromAgent(socket string) ([]byte, error) {
+	response, err := requestAgent(socket, agentRequestGet)
+	if err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(response)
+}
+
+// StopAgent stops the agent listening on a socket, if any, forgetting the key it caches. It returns whether an agent was
+// running.
+func StopAgent(socket string) bool {
+	if _, err := os.Stat(socket); os.IsNotExist(err) {
+		return false
+	}
+
+	if _, err := requestAgent(socket, agentRequestStop); err != nil {
+		// The agent is gone, but left its socket behind
+		_ = os.Remove(socket)
+		return false
+	}
+	return true
+}
+
+func requestAgent(socket, request string) (string, error) {
+	conn, err := net.DialTimeout("unix", socket, agentDialTimeout)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(agentDialTimeout))
+
+	if _, err := fmt.Fprintln(conn, request); err != nil {
+		return "", err
+	}
+
+	response, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(response), nil
+}
@@ -51,6 +51,10 @@ func DeriveEncryptionKey(salt []byte) ([]byte, error) {
 }
 
 func Encrypt(username, password string, salt, nonce []byte) (string, error) {
+	if IsProtectionEnabled() {
+		return encryptProtected(username, password)
+	}
+
 	encryptionKey, err := DeriveEncryptionKey(salt)
 	if err != nil {
 		return "", err
@@ -75,6 +79,10 @@ func Encrypt(username, password string, salt, nonce []byte) (string, error) {
 }
 
 func Decrypt(username, encrypted string, salt, nonce []byte) (string, error) {
+	if IsProtected(encrypted) {
+		return decryptProtected(username, encrypted)
+	}
+
 	encryptionKey, err := DeriveEncryptionKey(salt)
 	if err != nil {
 		return "", err
@@ -19,7 +19,11 @@ func DeriveEncryptionKey(_ string) ([]byte, error) {
 	return nil, nil
 }
 
-func Encrypt(_, password string, _, _ []byte) (string, error) {
+func Encrypt(username, password string, _, _ []byte) (string, error) {
+	if IsProtectionEnabled() {
+		return encryptProtected(username, password)
+	}
+
 	encryptedPassword, err := dpapi.Encrypt(password)
 	if err != nil {
 		return "", err
@@ -27,7 +31,11 @@ func Encrypt(_, password string, _, _ []byte) (string, error) {
 	return encryptedPassword, nil
 }
 
-func Decrypt(_, encrypted string, _, _ []byte) (string, error) {
+func Decrypt(username, encrypted string, _, _ []byte) (string, error) {
+	if IsProtected(encrypted) {
+		return decryptProtected(username, encrypted)
+	}
+
 	log.CliLogger.Tracef("Decrypting secret: %s", encrypted)
 	decryptedPassword, err := dpapi.Decrypt(encrypted)
 	if err != nil {
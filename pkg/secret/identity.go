@@ -0,0 +1,105 @@
+package secret
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+)
+
+// Key files hold an X25519 identity in the format of age (https://age-encryption.org), so that they can be generated by
+// `age-keygen` and managed alongside other age keys.
+
+// GenerateIdentity returns the contents of a new key file, in the format of `age-keygen`.
+func GenerateIdentity() ([]byte, error) {
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "# created: %s\n", time.Now().Format(time.RFC3339))
+	fmt.Fprintf(&b, "# public key: %s\n", identity.Recipient())
+	fmt.Fprintf(&b, "%s\n", identity)
+	return b.Bytes(), nil
+}
+
+// ParseIdentity returns the first X25519 identity in the contents of a key file.
+func ParseIdentity(data []byte) (*age.X25519Identity, error) {
+	identities, err := age.ParseIdentities(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("malformed age identity: %w", err)
+	}
+
+	for _, identity := range identities {
+		if x25519Identity, ok := identity.(*age.X25519Identity); ok {
+			return x25519Identity, nil
+		}
+	}
+	return nil, fmt.Errorf("no age X25519 identity found")
+}
+
+// EncryptToRecipient encrypts data to an age recipient, so that it can only be decrypted with its identity. The result is
+// an ASCII-armored age file, which can also be decrypted with `age --decrypt`.
+func EncryptToRecipient(recipient string, plaintext []byte) (string, error) {
+	x25519Recipient, err := age.ParseX25519Recipient(recipient)
+	if err != nil {
+		return "", err
+	}
+
+	encrypted, err := encrypt(x25519Recipient, plaintext)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	w := armor.NewWriter(&b)
+	if _, err := w.Write(encrypted); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// DecryptWithIdentity decrypts an ASCII-armored age file which was encrypted to the recipient of an identity.
+func DecryptWithIdentity(identity *age.X25519Identity, encrypted string) ([]byte, error) {
+	data, err := io.ReadAll(armor.NewReader(strings.NewReader(encrypted)))
+	if err != nil {
+		return nil, fmt.Errorf("malformed age file: %w", err)
+	}
+	return decrypt(identity, data)
+}
+
+func encrypt(recipient age.Recipient, plaintext []byte) ([]byte, error) {
+	var b bytes.Buffer
+	w, err := age.Encrypt(&b, recipient)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(plaintext); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+func decrypt(identity age.Identity, data []byte) ([]byte, error) {
+	r, err := age.Decrypt(bytes.NewReader(data), identity)
+	if err != nil {
+		var noIdentityMatchErr *age.NoIdentityMatchError
+		if errors.As(err, &noIdentityMatchErr) {
+			return nil, fmt.Errorf("the identity does not match the recipient the data was encrypted to")
+		}
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
@@ -0,0 +1,186 @@
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+
+	"filippo.io/age"
+	"golang.org/x/crypto/scrypt"
+)
+
+// AesGcmProtected prefixes secrets which are encrypted with a key unlocked from a passphrase or a key file, rather than a
+// key derived from the machine. It begins with AesGcm, so that protected secrets are never encrypted twice.
+const AesGcmProtected = AesGcm + "/Protected"
+
+const (
+	protectionKeyLength = 32
+
+	// scrypt parameters recommended for interactive logins
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+)
+
+var protection struct {
+	sync.Mutex
+	enabled bool
+	key     []byte
+	unlock  func() ([]byte, error)
+}
+
+// SetProtectionKeyFunc enables protected encryption of secrets. The key is unlocked by calling unlock the first time it
+// is needed, so that commands which never touch a secret do not ask for a passphrase.
+func SetProtectionKeyFunc(unlock func() ([]byte, error)) {
+	protection.Lock()
+	defer protection.Unlock()
+
+	protection.enabled = unlock != nil
+	protection.key = nil
+	protection.unlock = unlock
+}
+
+// SetProtectionKey enables protected encryption of secrets with an unlocked key, or disables it if the key is nil.
+func SetProtectionKey(key []byte) {
+	protection.Lock()
+	defer protection.Unlock()
+
+	protection.enabled = key != nil
+	protection.key = key
+	protection.unlock = nil
+}
+
+// IsProtectionEnabled returns whether new secrets are encrypted with a protection key.
+func IsProtectionEnabled() bool {
+	protection.Lock()
+	defer protection.Unlock()
+
+	return protection.enabled
+}
+
+// GetProtectionKey returns the protection key, unlocking it if necessary.
+func GetProtectionKey() ([]byte, error) {
+	protection.Lock()
+	defer protection.Unlock()
+
+	if protection.key != nil {
+		return protection.key, nil
+	}
+	if protection.unlock == nil {
+		return nil, fmt.Errorf("secrets in the configuration file are not protected by a passphrase or key file")
+	}
+
+	key, err := protection.unlock()
+	if err != nil {
+		return nil, err
+	}
+	protection.key = key
+	return key, nil
+}
+
+// DeriveProtectionKeyFromPassphrase stretches a passphrase into a protection key with scrypt.
+func DeriveProtectionKeyFromPassphrase(passphrase string, salt []byte) ([]byte, error) {
+	return scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, protectionKeyLength)
+}
+
+// GenerateProtectionKey returns a new random protection key, to be wrapped for an age identity.
+func GenerateProtectionKey() ([]byte, error) {
+	key := make([]byte, protectionKeyLength)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// WrapProtectionKey encrypts a protection key to the recipient of an age identity, so that it can be stored in the
+// configuration file and only unwrapped with the identity.
+func WrapProtectionKey(identity *age.X25519Identity, key []byte) (string, error) {
+	wrapped, err := encrypt(identity.Recipient(), key)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(wrapped), nil
+}
+
+// UnwrapProtectionKey decrypts a protection key which was wrapped with WrapProtectionKey.
+func UnwrapProtectionKey(identity *age.X25519Identity, wrapped string) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base64: %w", err)
+	}
+	return decrypt(identity, data)
+}
+
+// EncryptWithKey encrypts a secret with a protection key, using the username as additional authenticated data. Each call
+// uses a new random nonce, which is stored alongside the ciphertext.
+func EncryptWithKey(key []byte, username, plaintext string) (string, error) {
+	aesgcm, err := newProtectionCipher(key)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aesgcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	encrypted := aesgcm.Seal(nonce, nonce, []byte(plaintext), []byte(username))
+
+	return AesGcmProtected + ":" + base64.RawStdEncoding.EncodeToString(encrypted), nil
+}
+
+// DecryptWithKey decrypts a secret which was encrypted with EncryptWithKey.
+func DecryptWithKey(key []byte, username, encrypted string) (string, error) {
+	aesgcm, err := newProtectionCipher(key)
+	if err != nil {
+		return "", err
+	}
+
+	cipherText, err := base64.RawStdEncoding.DecodeString(strings.TrimPrefix(encrypted, AesGcmProtected+":"))
+	if err != nil {
+		return "", fmt.Errorf("failed to decode base64: %w", err)
+	}
+	if len(cipherText) < aesgcm.NonceSize() {
+		return "", fmt.Errorf("protected secret is too short")
+	}
+
+	nonce, cipherText := cipherText[:aesgcm.NonceSize()], cipherText[aesgcm.NonceSize():]
+	plaintext, err := aesgcm.Open(nil, nonce, cipherText, []byte(username))
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt protected secret: %w", err)
+	}
+
+	return string(plaintext), nil
+}
+
+// IsProtected returns whether a secret was encrypted with a protection key.
+func IsProtected(encrypted string) bool {
+	return strings.HasPrefix(encrypted, AesGcmProtected+":")
+}
+
+func newProtectionCipher(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func encryptProtected(username, plaintext string) (string, error) {
+	key, err := GetProtectionKey()
+	if err != nil {
+		return "", err
+	}
+	return EncryptWithKey(key, username, plaintext)
+}
+
+func decryptProtected(username, encrypted string) (string, error) {
+	key, err := GetProtectionKey()
+	if err != nil {
+		return "", err
+	}
+	return DecryptWithKey(key, username, encrypted)
+}
@@ -0,0 +1,181 @@
+package secret
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptWithKey(t *testing.T) {
+	key, err := DeriveProtectionKeyFromPassphrase("passphrase", []byte("salt"))
+	require.NoError(t, err)
+
+	encrypted, err := EncryptWithKey(key, "username", "secret")
+	require.NoError(t, err)
+	require.True(t, IsProtected(encrypted))
+
+	again, err := EncryptWithKey(key, "username", "secret")
+	require.NoError(t, err)
+	require.NotEqual(t, encrypted, again)
+
+	decrypted, err := DecryptWithKey(key, "username", encrypted)
+	require.NoError(t, err)
+	require.Equal(t, "secret", decrypted)
+
+	_, err = DecryptWithKey(key, "other-username", encrypted)
+	require.Error(t, err)
+
+	otherKey, err := DeriveProtectionKeyFromPassphrase("other-passphrase", []byte("salt"))
+	require.NoError(t, err)
+	_, err = DecryptWithKey(otherKey, "username", encrypted)
+	require.Error(t, err)
+}
+
+func TestProtectionKeyFunc(t *testing.T) {
+	t.Cleanup(func() { SetProtectionKey(nil) })
+
+	key, err := DeriveProtectionKeyFromPassphrase("passphrase", []byte("salt"))
+	require.NoError(t, err)
+
+	calls := 0
+	SetProtectionKeyFunc(func() ([]byte, error) {
+		calls++
+		return key, nil
+	})
+	require.True(t, IsProtectionEnabled())
+	require.Zero(t, calls)
+
+	encrypted, err := Encrypt("username", "secret", nil, nil)
+	require.NoError(t, err)
+	require.True(t, IsProtected(encrypted))
+
+	decrypted, err := Decrypt("username", encrypted, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "secret", decrypted)
+	require.Equal(t, 1, calls)
+
+	SetProtectionKey(nil)
+	require.False(t, IsProtectionEnabled())
+	_, err = Decrypt("username", encrypted, nil, nil)
+	require.Error(t, err)
+}
+
+func TestIdentity(t *testing.T) {
+	data, err := GenerateIdentity()
+	require.NoError(t, err)
+	require.Regexp(t, `(?m)^# public key: age1[a-z0-9]{58}$`, string(data))
+	require.Regexp(t, `(?m)^AGE-SECRET-KEY-1[A-Z0-9]{58}$`, string(data))
+
+	identity, err := ParseIdentity(data)
+	require.NoError(t, err)
+	require.Contains(t, string(data), identity.Recipient().String())
+
+	_, err = ParseIdentity([]byte("# no identity\n"))
+	require.Error(t, err)
+
+	_, err = ParseIdentity([]byte("AGE-SECRET-KEY-1QQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQ\n"))
+	require.Error(t, err)
+}
+
+func TestAgent(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "agent", "agent.sock")
+	key := []byte("0123456789abcdef0123456789abcdef")
+
+	require.False(t, StopAgent(socket))
+
+	done := make(chan error)
+	go func() { done <- ServeAgent(socket, key, time.Minute) }()
+
+	require.Eventually(t, func() bool {
+		_, err := GetKeyFromAgent(socket)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	cached, err := GetKeyFromAgent(socket)
+	require.NoError(t, err)
+	require.Equal(t, key, cached)
+
+	info, err := os.Stat(filepath.Dir(socket))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0700), info.Mode().Perm())
+
+	require.True(t, StopAgent(socket))
+	require.NoError(t, <-done)
+
+	_, err = GetKeyFromAgent(socket)
+	require.Error(t, err)
+}
+
+func TestAgentTimeout(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "agent.sock")
+	require.NoError(t, ServeAgent(socket, []byte("key"), 10*time.Millisecond))
+
+	_, err := GetKeyFromAgent(socket)
+	require.Error(t, err)
+}
+
+func TestWrapProtectionKey(t *testing.T) {
+	data, err := GenerateIdentity()
+	require.NoError(t, err)
+	identity, err := ParseIdentity(data)
+	require.NoError(t, err)
+
+	key, err := GenerateProtectionKey()
+	require.NoError(t, err)
+	require.Len(t, key, protectionKeyLength)
+
+	wrapped, err := WrapProtectionKey(identity, key)
+	require.NoError(t, err)
+
+	unwrapped, err := UnwrapProtectionKey(identity, wrapped)
+	require.NoError(t, err)
+	require.Equal(t, key, unwrapped)
+
+	otherData, err := GenerateIdentity()
+	require.NoError(t, err)
+	otherIdentity, err := ParseIdentity(otherData)
+	require.NoError(t, err)
+	_, err = UnwrapProtectionKey(otherIdentity, wrapped)
+	require.Error(t, err)
+}
+
+func TestEncryptToRecipient(t *testing.T) {
+	data, err := GenerateIdentity()
+	require.NoError(t, err)
+	identity, err := ParseIdentity(data)
+	require.NoError(t, err)
+
+	recipient := regexp.MustCompile(`age1[a-z0-9]+`).FindString(string(data))
+	encrypted, err := EncryptToRecipient(recipient, []byte("secret"))
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(encrypted, armor.Header))
+
+	// The encrypted data is a standard age file, which can be decrypted without this package
+	r, err := age.Decrypt(armor.NewReader(strings.NewReader(encrypted)), identity)
+	require.NoError(t, err)
+	decrypted, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, []byte("secret"), decrypted)
+
+	decrypted, err = DecryptWithIdentity(identity, encrypted)
+	require.NoError(t, err)
+	require.Equal(t, []byte("secret"), decrypted)
+
+	otherData, err := GenerateIdentity()
+	require.NoError(t, err)
+	otherIdentity, err := ParseIdentity(otherData)
+	require.NoError(t, err)
+	_, err = DecryptWithIdentity(otherIdentity, encrypted)
+	require.ErrorContains(t, err, "the identity does not match the recipient the data was encrypted to")
+
+	_, err = EncryptToRecipient("age1invalid", []byte("secret"))
+	require.Error(t, err)
+}
@@ -1,12 +1,7 @@
 package serdes
 
 import (
-	"fmt"
-	"os"
-
 	"github.com/linkedin/goavro/v2"
-
-	"github.com/confluentinc/cli/v3/pkg/errors"
 )
 
 type AvroDeserializationProvider struct {
@@ -14,16 +9,7 @@ type AvroDeserializationProvider struct {
 }
 
 func (a *AvroDeserializationProvider) LoadSchema(schemaPath string, referencePathMap map[string]string) error {
-	if len(referencePathMap) > 0 {
-		return fmt.Errorf(errors.AvroReferenceNotSupportedErrorMsg)
-	}
-
-	schema, err := os.ReadFile(schemaPath)
-	if err != nil {
-		return err
-	}
-
-	codec, err := goavro.NewCodec(string(schema))
+	codec, err := parseAvroSchema(schemaPath, referencePathMap)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,227 @@
+package serdes
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/linkedin/goavro/v2"
+)
+
+var avroPrimitives = []string{"null", "boolean", "int", "long", "float", "double", "bytes", "string"}
+
+// parseAvroSchema creates a codec for an Avro schema which may use named types defined by its referenced schemas.
+// Since a codec can't be created from several schemas, the definition of every referenced type is inlined where the
+// type is first used, and later uses keep referring to the type by name.
+func parseAvroSchema(schemaPath string, referencePathMap map[string]string) (*goavro.Codec, error) {
+	schema, err := os.ReadFile(schemaPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(referencePathMap) == 0 {
+		return goavro.NewCodec(string(schema))
+	}
+
+	resolver := &avroReferenceResolver{
+		definitions: make(map[string]map[string]any),
+		defined:     make(map[string]bool),
+	}
+
+	// Sort the references so that a type defined by several referenced schemas always resolves the same way
+	names := make([]string, 0, len(referencePathMap))
+	for name := range referencePathMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		reference, err := os.ReadFile(referencePathMap[name])
+		if err != nil {
+			return nil, err
+		}
+		v, err := decodeAvroSchema(reference)
+		if err != nil {
+			return nil, fmt.Errorf(`failed to parse referenced schema "%s": %w`, name, err)
+		}
+		resolver.collect(v, "")
+	}
+
+	v, err := decodeAvroSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	v = resolver.resolve(v, "")
+
+	resolved, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return goavro.NewCodec(string(resolved))
+}
+
+func decodeAvroSchema(schema []byte) (any, error) {
+	// Keep numbers, such as the defaults of long fields, as they are written
+	decoder := json.NewDecoder(bytes.NewReader(schema))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+type avroReferenceResolver struct {
+	// definitions maps the full names of the named types of the referenced schemas to their definitions
+	definitions map[string]map[string]any
+	// defined contains the full names of the types which were already defined in the resolved schema
+	defined map[string]bool
+}
+
+// collect registers the named types defined anywhere in a referenced schema
+func (r *avroReferenceResolver) collect(v any, namespace string) {
+	switch v := v.(type) {
+	case []any:
+		for _, member := range v {
+			r.collect(member, namespace)
+		}
+	case map[string]any:
+		kind, ok := v["type"].(string)
+		if !ok {
+			r.collect(v["type"], namespace)
+			return
+		}
+
+		switch kind {
+		case "record", "error", "enum", "fixed":
+			name := avroFullName(v, namespace)
+			if _, ok := r.definitions[name]; !ok {
+				r.definitions[name] = v
+			}
+			fields, _ := v["fields"].([]any)
+			for _, field := range fields {
+				if field, ok := field.(map[string]any); ok {
+					r.collect(field["type"], avroNamespace(name))
+				}
+			}
+		case "array":
+			r.collect(v["items"], namespace)
+		case "map":
+			r.collect(v["values"], namespace)
+		}
+	}
+}
+
+// resolve returns the schema with the definition of each referenced type inlined at its first use
+func (r *avroReferenceResolver) resolve(v any, namespace string) any {
+	switch v := v.(type) {
+	case string:
+		return r.resolveName(v, namespace)
+	case []any:
+		members := make([]any, len(v))
+		for i, member := range v {
+			members[i] = r.resolve(member, namespace)
+		}
+		return members
+	case map[string]any:
+		kind, ok := v["type"].(string)
+		if !ok {
+			return withAvroAttribute(v, "type", r.resolve(v["type"], namespace))
+		}
+
+		switch kind {
+		case "record", "error", "enum", "fixed":
+			name := avroFullName(v, namespace)
+			if _, ok := r.definitions[name]; ok && r.defined[name] {
+				// A referenced type nested in another referenced type may already have been inlined on its own
+				return name
+			}
+			r.defined[name] = true
+
+			fields, ok := v["fields"].([]any)
+			if !ok {
+				return v
+			}
+			resolvedFields := make([]any, len(fields))
+			for i, field := range fields {
+				if f, ok := field.(map[string]any); ok {
+					field = withAvroAttribute(f, "type", r.resolve(f["type"], avroNamespace(name)))
+				}
+				resolvedFields[i] = field
+			}
+			return withAvroAttribute(v, "fields", resolvedFields)
+		case "array":
+			return withAvroAttribute(v, "items", r.resolve(v["items"], namespace))
+		case "map":
+			return withAvroAttribute(v, "values", r.resolve(v["values"], namespace))
+		default:
+			return withAvroAttribute(v, "type", r.resolveName(kind, namespace))
+		}
+	default:
+		return v
+	}
+}
+
+func (r *avroReferenceResolver) resolveName(name, namespace string) any {
+	if slices.Contains(avroPrimitives, name) {
+		return name
+	}
+
+	// Like in Avro, a name without a namespace is first looked up in the enclosing namespace
+	fullName := name
+	if !strings.Contains(name, ".") && namespace != "" {
+		fullName = namespace + "." + name
+	}
+	if _, ok := r.definitions[fullName]; !ok {
+		fullName = name
+	}
+
+	definition, ok := r.definitions[fullName]
+	if !ok {
+		return name
+	}
+	if r.defined[fullName] {
+		return fullName
+	}
+
+	// The full name keeps the names used by the definition resolving in its own namespace
+	inlined := withAvroAttribute(definition, "name", fullName)
+	delete(inlined, "namespace")
+	return r.resolve(inlined, "")
+}
+
+// avroFullName returns the full name of a named type, see https://avro.apache.org/docs/1.11.1/specification/#names
+func avroFullName(v map[string]any, namespace string) string {
+	name, _ := v["name"].(string)
+	if strings.Contains(name, ".") {
+		return name
+	}
+	if ns, ok := v["namespace"].(string); ok {
+		namespace = ns
+	}
+	if namespace == "" {
+		return name
+	}
+	return namespace + "." + name
+}
+
+func avroNamespace(fullName string) string {
+	if i := strings.LastIndex(fullName, "."); i >= 0 {
+		return fullName[:i]
+	}
+	return ""
+}
+
+// withAvroAttribute returns a copy of a schema object with an attribute replaced, leaving the original untouched
+func withAvroAttribute(v map[string]any, key string, value any) map[string]any {
+	c := make(map[string]any, len(v))
+	for k, val := range v {
+		c[k] = val
+	}
+	c[key] = value
+	return c
+}
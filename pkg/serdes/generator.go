@@ -0,0 +1,187 @@
+package serdes
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"regexp/syntax"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// maxGeneratedDepth limits the nesting of generated records, so that recursive schemas terminate
+	maxGeneratedDepth = 5
+	// maxGeneratedItems is the maximum number of items of generated arrays and maps without bounds
+	maxGeneratedItems = 3
+	// maxRegexRepeat is the maximum number of repetitions of unbounded regular expression operators such as "*"
+	maxRegexRepeat = 10
+
+	alphanumeric = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+)
+
+// generatedTimeStart is the start of the year in which generated timestamps fall. It is fixed, so that a seeded
+// generator always generates the same records.
+var generatedTimeStart = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// Generator creates random records that are valid for the schema of a serialization provider, in the textual format
+// accepted by the provider's Serialize method.
+type Generator interface {
+	Generate() (string, error)
+}
+
+// NewGenerator returns a generator of random records for a serialization provider, which must have loaded its
+// schema if it is schema-based. Overrides constrain the values of fields of the schema by their path.
+func NewGenerator(provider SerializationProvider, r *rand.Rand, overrides map[string]*FieldOverride) (Generator, error) {
+	switch provider := provider.(type) {
+	case *AvroSerializationProvider:
+		return newAvroGenerator(provider, r, overrides)
+	case *JsonSerializationProvider:
+		return newJsonGenerator(provider, r, overrides)
+	case *ProtobufSerializationProvider:
+		return newProtobufGenerator(provider, r, overrides)
+	}
+
+	if len(overrides) > 0 {
+		return nil, fmt.Errorf("field overrides are only supported for schema-based formats")
+	}
+
+	switch provider.(type) {
+	case *StringSerializationProvider:
+		return &primitiveGenerator{generate: func() string { return randomString(r, 8, 16) }}, nil
+	case IntegerSerializationProvider, *IntegerSerializationProvider:
+		return &primitiveGenerator{generate: func() string { return strconv.FormatUint(uint64(r.Uint32()), 10) }}, nil
+	case DoubleSerializationProvider, *DoubleSerializationProvider:
+		return &primitiveGenerator{generate: func() string { return strconv.FormatFloat(r.Float64()*1000, 'f', -1, 64) }}, nil
+	default:
+		return nil, fmt.Errorf("random records cannot be generated for format %T", provider)
+	}
+}
+
+type primitiveGenerator struct {
+	generate func() string
+}
+
+func (g *primitiveGenerator) Generate() (string, error) {
+	return g.generate(), nil
+}
+
+// randomString returns a random alphanumeric string with a length between minLength and maxLength
+func randomString(r *rand.Rand, minLength, maxLength int) string {
+	length := minLength
+	if maxLength > minLength {
+		length += r.Intn(maxLength - minLength + 1)
+	}
+
+	sb := strings.Builder{}
+	for i := 0; i < length; i++ {
+		sb.WriteByte(alphanumeric[r.Intn(len(alphanumeric))])
+	}
+	return sb.String()
+}
+
+// randomInt64 returns a random integer between low and high, which may span the whole range of int64
+func randomInt64(r *rand.Rand, low, high int64) int64 {
+	// the number of integers in the range, which wraps around to 0 for the whole range of int64
+	n := uint64(high) - uint64(low) + 1
+	switch {
+	case n == 0:
+		return int64(r.Uint64())
+	case n <= math.MaxInt64:
+		return int64(uint64(low) + uint64(r.Int63n(int64(n))))
+	}
+	for {
+		if v := r.Uint64(); v < n {
+			return int64(uint64(low) + v)
+		}
+	}
+}
+
+// clampToInt64 converts a float to the closest integer which fits in an int64
+func clampToInt64(f float64) int64 {
+	switch {
+	case f >= math.MaxInt64:
+		return math.MaxInt64
+	case f <= math.MinInt64:
+		return math.MinInt64
+	default:
+		return int64(f)
+	}
+}
+
+// randomTime returns a random time, with millisecond precision, in the year starting at generatedTimeStart
+func randomTime(r *rand.Rand) time.Time {
+	return generatedTimeStart.Add(time.Duration(r.Int63n(int64(365*24*time.Hour/time.Millisecond))) * time.Millisecond)
+}
+
+// randomUuid returns a random UUID drawn from r, rather than from crypto/rand, so that it can be seeded
+func randomUuid(r *rand.Rand) string {
+	return uuid.Must(uuid.NewRandomFromReader(r)).String()
+}
+
+// randomStringFromRegex returns a random string matching a regular expression. Anchors are ignored, since the whole
+// string is generated from the expression.
+func randomStringFromRegex(r *rand.Rand, pattern string) (string, error) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return "", fmt.Errorf(`invalid pattern "%s": %w`, pattern, err)
+	}
+
+	sb := strings.Builder{}
+	writeRandomMatch(r, re.Simplify(), &sb)
+	return sb.String(), nil
+}
+
+func writeRandomMatch(r *rand.Rand, re *syntax.Regexp, sb *strings.Builder) {
+	switch re.Op {
+	case syntax.OpLiteral:
+		sb.WriteString(string(re.Rune))
+	case syntax.OpCharClass:
+		// Rune holds the inclusive ranges of the class as pairs
+		var size int
+		for i := 0; i < len(re.Rune); i += 2 {
+			size += int(re.Rune[i+1]-re.Rune[i]) + 1
+		}
+		if size == 0 {
+			return
+		}
+		n := r.Intn(min(size, 1<<16))
+		for i := 0; i < len(re.Rune); i += 2 {
+			if width := int(re.Rune[i+1]-re.Rune[i]) + 1; n >= width {
+				n -= width
+			} else {
+				sb.WriteRune(re.Rune[i] + rune(n))
+				return
+			}
+		}
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		sb.WriteByte(alphanumeric[r.Intn(len(alphanumeric))])
+	case syntax.OpCapture:
+		writeRandomMatch(r, re.Sub[0], sb)
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			writeRandomMatch(r, sub, sb)
+		}
+	case syntax.OpAlternate:
+		writeRandomMatch(r, re.Sub[r.Intn(len(re.Sub))], sb)
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		minRepeat, maxRepeat := re.Min, re.Max
+		switch re.Op {
+		case syntax.OpStar:
+			minRepeat, maxRepeat = 0, -1
+		case syntax.OpPlus:
+			minRepeat, maxRepeat = 1, -1
+		case syntax.OpQuest:
+			minRepeat, maxRepeat = 0, 1
+		}
+		if maxRepeat < 0 {
+			maxRepeat = minRepeat + maxRegexRepeat
+		}
+		for i := minRepeat + r.Intn(maxRepeat-minRepeat+1); i > 0; i-- {
+			writeRandomMatch(r, re.Sub[0], sb)
+		}
+	}
+}
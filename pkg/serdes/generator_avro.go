@@ -0,0 +1,353 @@
+package serdes
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"slices"
+)
+
+type avroGenerator struct {
+	schema any
+	// named maps the full names of the named types of the schema to their definitions
+	named     map[string]map[string]any
+	overrides map[string]*FieldOverride
+	r         *rand.Rand
+}
+
+func newAvroGenerator(provider *AvroSerializationProvider, r *rand.Rand, overrides map[string]*FieldOverride) (*avroGenerator, error) {
+	if provider.codec == nil {
+		return nil, fmt.Errorf("the Avro schema must be loaded to generate records")
+	}
+
+	schema, err := decodeAvroSchema([]byte(provider.codec.Schema()))
+	if err != nil {
+		return nil, err
+	}
+
+	g := &avroGenerator{schema: schema, named: make(map[string]map[string]any), overrides: overrides, r: r}
+	g.collect(schema, "")
+
+	if err := validateFieldOverrides(overrides, func(path []string) bool { return g.hasField(schema, "", path) }); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func (g *avroGenerator) Generate() (string, error) {
+	v, err := g.generate(g.schema, "", "", 0)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := json.Marshal(v)
+	return string(out), err
+}
+
+// collect registers the named types defined anywhere in the schema, so that later uses by name can be generated
+func (g *avroGenerator) collect(v any, namespace string) {
+	switch v := v.(type) {
+	case []any:
+		for _, member := range v {
+			g.collect(member, namespace)
+		}
+	case map[string]any:
+		kind, ok := v["type"].(string)
+		if !ok {
+			g.collect(v["type"], namespace)
+			return
+		}
+
+		switch kind {
+		case "record", "error", "enum", "fixed":
+			name := avroFullName(v, namespace)
+			g.named[name] = v
+			fields, _ := v["fields"].([]any)
+			for _, field := range fields {
+				if field, ok := field.(map[string]any); ok {
+					g.collect(field["type"], avroNamespace(name))
+				}
+			}
+		case "array":
+			g.collect(v["items"], namespace)
+		case "map":
+			g.collect(v["values"], namespace)
+		}
+	}
+}
+
+// hasField returns whether a path leads from a type to a field which can be overridden. Each part of the path names a
+// field of a record, and arrays, maps, and unions are looked through, like in generate.
+func (g *avroGenerator) hasField(v any, namespace string, path []string) bool {
+	switch v := v.(type) {
+	case string:
+		if slices.Contains(avroPrimitives, v) {
+			return len(path) == 0 && v != "null"
+		}
+		if definition, ok := g.lookup(v, namespace); ok {
+			return g.hasField(definition, namespace, path)
+		}
+	case []any:
+		return slices.ContainsFunc(v, func(member any) bool { return g.hasField(member, namespace, path) })
+	case map[string]any:
+		kind, ok := v["type"].(string)
+		if !ok {
+			return g.hasField(v["type"], namespace, path)
+		}
+
+		switch kind {
+		case "record", "error":
+			if len(path) == 0 {
+				return false
+			}
+			name := avroFullName(v, namespace)
+			fields, _ := v["fields"].([]any)
+			for _, field := range fields {
+				if field, ok := field.(map[string]any); ok && field["name"] == path[0] {
+					return g.hasField(field["type"], avroNamespace(name), path[1:])
+				}
+			}
+		case "enum":
+			return len(path) == 0
+		case "fixed":
+			return false
+		case "array":
+			return g.hasField(v["items"], namespace, path)
+		case "map":
+			return g.hasField(v["values"], namespace, path)
+		default:
+			return g.hasField(kind, namespace, path)
+		}
+	}
+	return false
+}
+
+// generate returns a random value of a schema in the Avro JSON encoding, see
+// https://avro.apache.org/docs/1.11.1/specification/#json-encoding. The path of the field being generated is used to
+// look up its override; the items of arrays and the values of maps share the path of their field.
+func (g *avroGenerator) generate(v any, namespace, path string, depth int) (any, error) {
+	switch v := v.(type) {
+	case string:
+		if slices.Contains(avroPrimitives, v) {
+			return g.generatePrimitive(v, "", path)
+		}
+		if definition, ok := g.lookup(v, namespace); ok {
+			return g.generate(definition, namespace, path, depth)
+		}
+		return nil, nil
+	case []any:
+		return g.generateUnion(v, namespace, path, depth)
+	case map[string]any:
+		kind, ok := v["type"].(string)
+		if !ok {
+			return g.generate(v["type"], namespace, path, depth)
+		}
+
+		switch kind {
+		case "record", "error":
+			name := avroFullName(v, namespace)
+			record := make(map[string]any)
+			fields, _ := v["fields"].([]any)
+			for _, field := range fields {
+				if field, ok := field.(map[string]any); ok {
+					fieldName, _ := field["name"].(string)
+					value, err := g.generate(field["type"], avroNamespace(name), joinFieldPath(path, fieldName), depth+1)
+					if err != nil {
+						return nil, err
+					}
+					record[fieldName] = value
+				}
+			}
+			return record, nil
+		case "enum":
+			if override, ok := g.overrides[path]; ok {
+				return override.generate(g.r, path, overrideKindString)
+			}
+			symbols, _ := v["symbols"].([]any)
+			if len(symbols) == 0 {
+				return nil, nil
+			}
+			return symbols[g.r.Intn(len(symbols))], nil
+		case "fixed":
+			size, _ := v["size"].(json.Number).Int64()
+			return randomString(g.r, int(size), int(size)), nil
+		case "array":
+			items := make([]any, g.getItemCount(depth))
+			for i := range items {
+				item, err := g.generate(v["items"], namespace, path, depth+1)
+				if err != nil {
+					return nil, err
+				}
+				items[i] = item
+			}
+			return items, nil
+		case "map":
+			values := make(map[string]any)
+			for i := g.getItemCount(depth); i > 0; i-- {
+				value, err := g.generate(v["values"], namespace, path, depth+1)
+				if err != nil {
+					return nil, err
+				}
+				values[randomString(g.r, 4, 8)] = value
+			}
+			return values, nil
+		default:
+			logicalType, _ := v["logicalType"].(string)
+			if slices.Contains(avroPrimitives, kind) {
+				return g.generatePrimitive(kind, logicalType, path)
+			}
+			return g.generate(kind, namespace, path, depth)
+		}
+	default:
+		return nil, nil
+	}
+}
+
+// generateUnion picks a random member of a union, which is encoded as an object with the member's type name as its only
+// key. Past the maximum depth, null is picked if possible so that recursive types terminate. For overridden fields, a
+// member whose values the override can generate is picked.
+func (g *avroGenerator) generateUnion(members []any, namespace, path string, depth int) (any, error) {
+	if override, ok := g.overrides[path]; ok {
+		fitting := slices.DeleteFunc(slices.Clone(members), func(member any) bool {
+			kind, ok := g.getOverrideKind(member, namespace)
+			return !ok || !override.fits(kind)
+		})
+		if len(fitting) > 0 {
+			members = fitting
+		}
+	}
+	if len(members) == 0 {
+		return nil, nil
+	}
+
+	member := members[g.r.Intn(len(members))]
+	if depth >= maxGeneratedDepth && slices.Contains(members, any("null")) {
+		member = "null"
+	}
+
+	name := g.getTypeName(member, namespace)
+	if name == "null" {
+		return nil, nil
+	}
+
+	value, err := g.generate(member, namespace, path, depth)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{name: value}, nil
+}
+
+func (g *avroGenerator) getTypeName(v any, namespace string) string {
+	switch v := v.(type) {
+	case string:
+		if slices.Contains(avroPrimitives, v) {
+			return v
+		}
+		if definition, ok := g.lookup(v, namespace); ok {
+			return avroFullName(definition, namespace)
+		}
+		return v
+	case map[string]any:
+		kind, _ := v["type"].(string)
+		switch kind {
+		case "record", "error", "enum", "fixed":
+			return avroFullName(v, namespace)
+		default:
+			return g.getTypeName(kind, namespace)
+		}
+	default:
+		return ""
+	}
+}
+
+// lookup returns the definition of a named type, looking up names without a namespace in the enclosing namespace first
+func (g *avroGenerator) lookup(name, namespace string) (map[string]any, bool) {
+	if namespace != "" {
+		if definition, ok := g.named[namespace+"."+name]; ok {
+			return definition, true
+		}
+	}
+	definition, ok := g.named[name]
+	return definition, ok
+}
+
+func (g *avroGenerator) getItemCount(depth int) int {
+	if depth >= maxGeneratedDepth {
+		return 0
+	}
+	return g.r.Intn(maxGeneratedItems + 1)
+}
+
+func (g *avroGenerator) generatePrimitive(kind, logicalType, path string) (any, error) {
+	if override, ok := g.overrides[path]; ok {
+		return override.generate(g.r, path, getAvroOverrideKind(kind))
+	}
+
+	switch kind {
+	case "boolean":
+		return g.r.Intn(2) == 1, nil
+	case "int":
+		if logicalType == "date" {
+			return int32(randomTime(g.r).Unix() / 86400), nil
+		}
+		return g.r.Int31n(1000), nil
+	case "long":
+		switch logicalType {
+		case "timestamp-millis", "local-timestamp-millis":
+			return randomTime(g.r).UnixMilli(), nil
+		case "timestamp-micros", "local-timestamp-micros":
+			return randomTime(g.r).UnixMicro(), nil
+		}
+		return g.r.Int63n(100000), nil
+	case "float":
+		return g.r.Float32() * 1000, nil
+	case "double":
+		return g.r.Float64() * 1000, nil
+	case "bytes":
+		return randomString(g.r, 4, 16), nil
+	case "string":
+		if logicalType == "uuid" {
+			return randomUuid(g.r), nil
+		}
+		return randomString(g.r, 8, 16), nil
+	default:
+		return nil, nil
+	}
+}
+
+// getOverrideKind returns the kind of values to override a type with, if it can be overridden
+func (g *avroGenerator) getOverrideKind(v any, namespace string) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		if slices.Contains(avroPrimitives, v) {
+			return getAvroOverrideKind(v), v != "null"
+		}
+		if definition, ok := g.lookup(v, namespace); ok {
+			return g.getOverrideKind(definition, namespace)
+		}
+	case map[string]any:
+		kind, _ := v["type"].(string)
+		switch kind {
+		case "enum":
+			return overrideKindString, true
+		case "record", "error", "fixed", "array", "map":
+			return "", false
+		default:
+			return g.getOverrideKind(kind, namespace)
+		}
+	}
+	return "", false
+}
+
+func getAvroOverrideKind(kind string) string {
+	switch kind {
+	case "boolean":
+		return overrideKindBool
+	case "int", "long":
+		return overrideKindInt
+	case "float", "double":
+		return overrideKindFloat
+	default:
+		return overrideKindString
+	}
+}
@@ -0,0 +1,508 @@
+package serdes
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/url"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// maxJsonSchemaIndirections limits the references, subschemas, and items followed while looking up an overridden property,
+// so that recursive schemas terminate
+const maxJsonSchemaIndirections = 32
+
+type jsonGenerator struct {
+	root any
+	// references maps the names of referenced schemas to their documents
+	references map[string]any
+	overrides  map[string]*FieldOverride
+	r          *rand.Rand
+}
+
+func newJsonGenerator(provider *JsonSerializationProvider, r *rand.Rand, overrides map[string]*FieldOverride) (*jsonGenerator, error) {
+	if provider.schemaPath == "" {
+		return nil, fmt.Errorf("the JSON schema must be loaded to generate records")
+	}
+
+	root, err := readJsonDocument(provider.schemaPath)
+	if err != nil {
+		return nil, err
+	}
+
+	references := make(map[string]any)
+	for name, path := range provider.referencePathMap {
+		reference, err := readJsonDocument(path)
+		if err != nil {
+			return nil, fmt.Errorf(`failed to parse referenced schema "%s": %w`, name, err)
+		}
+		references[name] = reference
+	}
+
+	g := &jsonGenerator{root: root, references: references, overrides: overrides, r: r}
+	if err := validateFieldOverrides(overrides, func(path []string) bool { return g.hasField(root, root, path, 0) }); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func readJsonDocument(path string) (any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (g *jsonGenerator) Generate() (string, error) {
+	v, err := g.generate(g.root, g.root, "", 0)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := json.Marshal(v)
+	return string(out), err
+}
+
+// generate returns a random value of a schema. The root is the document of the schema, against which local references
+// are resolved. The path of the property being generated is used to look up its override; the items of arrays share the
+// path of their property.
+func (g *jsonGenerator) generate(v, root any, path string, depth int) (any, error) {
+	schema, ok := v.(map[string]any)
+	if !ok {
+		if v == false {
+			return nil, fmt.Errorf("no value is valid for a schema of false")
+		}
+		// a schema of true, or a missing schema, accepts any value
+		return randomString(g.r, 8, 16), nil
+	}
+
+	if ref, ok := schema["$ref"].(string); ok {
+		resolved, resolvedRoot, err := g.resolveRef(ref, root)
+		if err != nil {
+			return nil, err
+		}
+		return g.generate(resolved, resolvedRoot, path, depth)
+	}
+
+	if subschemas, ok := schema["allOf"].([]any); ok {
+		merged := withoutKeys(schema, "allOf")
+		for _, subschema := range subschemas {
+			resolved, err := g.resolveSubschema(subschema, root)
+			if err != nil {
+				return nil, err
+			}
+			merged = mergeJsonSchemas(merged, resolved)
+		}
+		return g.generate(merged, root, path, depth)
+	}
+	for _, keyword := range []string{"oneOf", "anyOf"} {
+		if subschemas, ok := schema[keyword].([]any); ok && len(subschemas) > 0 {
+			// overridden properties are never null
+			if _, ok := g.overrides[path]; ok {
+				if nonNull := slices.DeleteFunc(slices.Clone(subschemas), isJsonNullSchema); len(nonNull) > 0 {
+					subschemas = nonNull
+				}
+			}
+			resolved, err := g.resolveSubschema(subschemas[g.r.Intn(len(subschemas))], root)
+			if err != nil {
+				return nil, err
+			}
+			return g.generate(mergeJsonSchemas(withoutKeys(schema, keyword), resolved), root, path, depth)
+		}
+	}
+
+	override, hasOverride := g.overrides[path]
+	kind := getJsonSchemaType(schema, hasOverride, g.r)
+	if hasOverride && kind != "object" && kind != "array" {
+		return override.generate(g.r, path, getJsonOverrideKind(kind))
+	}
+
+	if value, ok := schema["const"]; ok {
+		return value, nil
+	}
+	if values, ok := schema["enum"].([]any); ok && len(values) > 0 {
+		return values[g.r.Intn(len(values))], nil
+	}
+
+	switch kind {
+	case "object":
+		return g.generateObject(schema, root, path, depth)
+	case "array":
+		return g.generateArray(schema, root, path, depth)
+	case "string":
+		return g.generateString(schema)
+	case "integer":
+		return g.generateInteger(schema), nil
+	case "number":
+		minimum, maximum := getJsonSchemaBounds(schema)
+		f := g.r.Float64()
+		return minimum*(1-f) + maximum*f, nil
+	case "boolean":
+		return g.r.Intn(2) == 1, nil
+	default:
+		return nil, nil
+	}
+}
+
+// hasField returns whether a path leads from a schema to a property which can be overridden. Each part of the path names
+// a property of an object, and references, subschemas, and the items of arrays are looked through, like in generate.
+func (g *jsonGenerator) hasField(v, root any, path []string, indirections int) bool {
+	schema, ok := v.(map[string]any)
+	if !ok || indirections > maxJsonSchemaIndirections {
+		return false
+	}
+
+	if ref, ok := schema["$ref"].(string); ok {
+		resolved, resolvedRoot, err := g.resolveRef(ref, root)
+		return err == nil && g.hasField(resolved, resolvedRoot, path, indirections+1)
+	}
+
+	for _, keyword := range []string{"allOf", "oneOf", "anyOf"} {
+		subschemas, _ := schema[keyword].([]any)
+		for _, subschema := range subschemas {
+			if g.hasField(subschema, root, path, indirections+1) {
+				return true
+			}
+		}
+	}
+
+	kinds := getJsonSchemaTypes(schema)
+	if slices.Contains(kinds, "array") {
+		items := schema["items"]
+		if tuple, ok := items.([]any); ok {
+			for _, item := range tuple {
+				if g.hasField(item, root, path, indirections+1) {
+					return true
+				}
+			}
+		} else if g.hasField(items, root, path, indirections+1) {
+			return true
+		}
+	}
+
+	if len(path) == 0 {
+		return slices.ContainsFunc(kinds, func(kind string) bool { return kind != "object" && kind != "array" && kind != "null" })
+	}
+
+	properties, _ := schema["properties"].(map[string]any)
+	property, ok := properties[path[0]]
+	return ok && g.hasField(property, root, path[1:], 0)
+}
+
+// getJsonSchemaTypes returns the types a schema allows, inferring them like getJsonSchemaType if there are none
+func getJsonSchemaTypes(schema map[string]any) []string {
+	switch kind := schema["type"].(type) {
+	case string:
+		return []string{kind}
+	case []any:
+		var kinds []string
+		for _, v := range kind {
+			if s, ok := v.(string); ok {
+				kinds = append(kinds, s)
+			}
+		}
+		if len(kinds) > 0 {
+			return kinds
+		}
+	}
+	if _, ok := schema["properties"]; ok {
+		return []string{"object"}
+	}
+	if _, ok := schema["items"]; ok {
+		return []string{"array"}
+	}
+	return []string{"string"}
+}
+
+// getJsonSchemaType returns the type of a schema, picking a random type if several are allowed
+func getJsonSchemaType(schema map[string]any, excludeNull bool, r *rand.Rand) string {
+	switch kind := schema["type"].(type) {
+	case string:
+		return kind
+	case []any:
+		if excludeNull {
+			if nonNull := slices.DeleteFunc(slices.Clone(kind), func(v any) bool { return v == "null" }); len(nonNull) > 0 {
+				kind = nonNull
+			}
+		}
+		if len(kind) > 0 {
+			if s, ok := kind[r.Intn(len(kind))].(string); ok {
+				return s
+			}
+		}
+	}
+
+	if _, ok := schema["properties"]; ok {
+		return "object"
+	}
+	if _, ok := schema["items"]; ok {
+		return "array"
+	}
+	return "string"
+}
+
+func (g *jsonGenerator) generateObject(schema map[string]any, root any, path string, depth int) (any, error) {
+	properties, _ := schema["properties"].(map[string]any)
+	required, _ := schema["required"].([]any)
+
+	// Iterate in a fixed order, so that a seeded generator always generates the same records
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	object := make(map[string]any)
+	for _, name := range names {
+		isRequired := slices.Contains(required, any(name))
+		if !isRequired && (depth >= maxGeneratedDepth || g.r.Intn(2) == 0) {
+			continue
+		}
+
+		value, err := g.generate(properties[name], root, joinFieldPath(path, name), depth+1)
+		if err != nil {
+			return nil, err
+		}
+		object[name] = value
+	}
+	return object, nil
+}
+
+func (g *jsonGenerator) generateArray(schema map[string]any, root any, path string, depth int) (any, error) {
+	minItems := getJsonSchemaInt(schema, "minItems", 0)
+	maxItems := getJsonSchemaInt(schema, "maxItems", minItems+maxGeneratedItems)
+	count := minItems
+	if depth < maxGeneratedDepth && maxItems > minItems {
+		count += g.r.Intn(maxItems - minItems + 1)
+	}
+
+	items := make([]any, count)
+	for i := range items {
+		itemSchema := schema["items"]
+		// a list of schemas validates the item at each position
+		if tuple, ok := itemSchema.([]any); ok {
+			if i >= len(tuple) {
+				return items[:i], nil
+			}
+			itemSchema = tuple[i]
+		}
+
+		item, err := g.generate(itemSchema, root, path, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		items[i] = item
+	}
+	return items, nil
+}
+
+func (g *jsonGenerator) generateString(schema map[string]any) (any, error) {
+	if pattern, ok := schema["pattern"].(string); ok {
+		return randomStringFromRegex(g.r, pattern)
+	}
+
+	format, _ := schema["format"].(string)
+	switch format {
+	case "date-time":
+		return randomTime(g.r).Format(time.RFC3339), nil
+	case "date":
+		return randomTime(g.r).Format(time.DateOnly), nil
+	case "time":
+		return randomTime(g.r).Format("15:04:05Z07:00"), nil
+	case "email":
+		return fmt.Sprintf("%s@example.com", strings.ToLower(randomString(g.r, 4, 10))), nil
+	case "hostname":
+		return fmt.Sprintf("%s.example.com", strings.ToLower(randomString(g.r, 4, 10))), nil
+	case "uri":
+		return (&url.URL{Scheme: "https", Host: "example.com", Path: "/" + randomString(g.r, 4, 10)}).String(), nil
+	case "uuid":
+		return randomUuid(g.r), nil
+	case "ipv4":
+		return fmt.Sprintf("%d.%d.%d.%d", g.r.Intn(256), g.r.Intn(256), g.r.Intn(256), g.r.Intn(256)), nil
+	}
+
+	minLength := getJsonSchemaInt(schema, "minLength", 1)
+	maxLength := getJsonSchemaInt(schema, "maxLength", max(minLength, 16))
+	return randomString(g.r, minLength, maxLength), nil
+}
+
+func (g *jsonGenerator) generateInteger(schema map[string]any) int64 {
+	minimum, maximum := getJsonSchemaBounds(schema)
+	low, high := clampToInt64(math.Ceil(minimum)), clampToInt64(math.Floor(maximum))
+
+	if multipleOf, ok := schema["multipleOf"].(float64); ok && multipleOf >= 1 {
+		step := clampToInt64(multipleOf)
+		// round the bounds inwards to multiples, as integer division rounds towards zero
+		lowMultiple, highMultiple := low/step, high/step
+		if low > 0 && low%step != 0 {
+			lowMultiple++
+		}
+		if high < 0 && high%step != 0 {
+			highMultiple--
+		}
+		if highMultiple < lowMultiple {
+			return lowMultiple * step
+		}
+		return randomInt64(g.r, lowMultiple, highMultiple) * step
+	}
+
+	if high < low {
+		return low
+	}
+	return randomInt64(g.r, low, high)
+}
+
+// getJsonSchemaBounds returns the inclusive bounds of a numeric schema. Exclusive bounds may be numbers, or booleans as
+// in draft 4.
+func getJsonSchemaBounds(schema map[string]any) (float64, float64) {
+	minimum, hasMinimum := schema["minimum"].(float64)
+	maximum, hasMaximum := schema["maximum"].(float64)
+
+	switch exclusiveMinimum := schema["exclusiveMinimum"].(type) {
+	case float64:
+		minimum, hasMinimum = exclusiveMinimum+1, true
+	case bool:
+		if exclusiveMinimum && hasMinimum {
+			minimum++
+		}
+	}
+	switch exclusiveMaximum := schema["exclusiveMaximum"].(type) {
+	case float64:
+		maximum, hasMaximum = exclusiveMaximum-1, true
+	case bool:
+		if exclusiveMaximum && hasMaximum {
+			maximum--
+		}
+	}
+
+	switch {
+	case !hasMinimum && !hasMaximum:
+		return 0, 1000
+	case !hasMinimum:
+		return maximum - 1000, maximum
+	case !hasMaximum:
+		return minimum, minimum + 1000
+	default:
+		return minimum, maximum
+	}
+}
+
+func getJsonOverrideKind(kind string) string {
+	switch kind {
+	case "integer":
+		return overrideKindInt
+	case "number":
+		return overrideKindFloat
+	case "boolean":
+		return overrideKindBool
+	default:
+		return overrideKindString
+	}
+}
+
+func isJsonNullSchema(v any) bool {
+	schema, ok := v.(map[string]any)
+	return ok && schema["type"] == "null"
+}
+
+func getJsonSchemaInt(schema map[string]any, key string, defaultValue int) int {
+	if value, ok := schema[key].(float64); ok {
+		return int(value)
+	}
+	return defaultValue
+}
+
+func (g *jsonGenerator) resolveSubschema(v, root any) (map[string]any, error) {
+	schema, ok := v.(map[string]any)
+	if !ok {
+		return map[string]any{}, nil
+	}
+
+	if ref, ok := schema["$ref"].(string); ok {
+		resolved, resolvedRoot, err := g.resolveRef(ref, root)
+		if err != nil {
+			return nil, err
+		}
+		return g.resolveSubschema(resolved, resolvedRoot)
+	}
+	return schema, nil
+}
+
+// resolveRef returns the schema a reference points to, and the document it is in. References are either local, such as
+// "#/definitions/address", or point into a referenced schema by its name, such as "address.json#/definitions/street".
+func (g *jsonGenerator) resolveRef(ref string, root any) (any, any, error) {
+	name, fragment, _ := strings.Cut(ref, "#")
+	if name != "" {
+		reference, ok := g.references[strings.TrimPrefix(name, "/")]
+		if !ok {
+			return nil, nil, fmt.Errorf(`failed to resolve reference "%s"`, ref)
+		}
+		root = reference
+	}
+
+	v := root
+	for _, token := range strings.Split(strings.TrimPrefix(fragment, "/"), "/") {
+		if token == "" {
+			continue
+		}
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+
+		object, ok := v.(map[string]any)
+		if !ok {
+			return nil, nil, fmt.Errorf(`failed to resolve reference "%s"`, ref)
+		}
+		if v, ok = object[token]; !ok {
+			return nil, nil, fmt.Errorf(`failed to resolve reference "%s"`, ref)
+		}
+	}
+	return v, root, nil
+}
+
+// mergeJsonSchemas combines two schemas which must both be valid, merging their properties and required properties
+func mergeJsonSchemas(a, b map[string]any) map[string]any {
+	merged := withoutKeys(a)
+	for key, value := range b {
+		switch key {
+		case "properties":
+			properties, _ := merged["properties"].(map[string]any)
+			properties = withoutKeys(properties)
+			if bProperties, ok := value.(map[string]any); ok {
+				for name, property := range bProperties {
+					properties[name] = property
+				}
+			}
+			merged["properties"] = properties
+		case "required":
+			required, _ := merged["required"].([]any)
+			if bRequired, ok := value.([]any); ok {
+				required = append(slices.Clone(required), bRequired...)
+			}
+			merged["required"] = required
+		default:
+			merged[key] = value
+		}
+	}
+	return merged
+}
+
+// withoutKeys returns a copy of a schema without some of its keys, leaving the original untouched
+func withoutKeys(schema map[string]any, keys ...string) map[string]any {
+	c := make(map[string]any, len(schema))
+	for key, value := range schema {
+		if !slices.Contains(keys, key) {
+			c[key] = value
+		}
+	}
+	return c
+}
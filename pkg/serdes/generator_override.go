@@ -0,0 +1,172 @@
+package serdes
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"regexp/syntax"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// The kinds of values which field overrides can generate
+const (
+	overrideKindString = "string"
+	overrideKindInt    = "int"
+	overrideKindFloat  = "float"
+	overrideKindBool   = "bool"
+)
+
+// FieldOverride constrains the random values generated for a field to a list of values, a numeric range, or the
+// strings matching a regular expression.
+type FieldOverride struct {
+	values   []string
+	min      float64
+	max      float64
+	hasRange bool
+	pattern  string
+}
+
+// ParseFieldOverrides parses field overrides of the form "<field>=enum:<value>,<value>", "<field>=range:<min>..<max>",
+// or "<field>=regex:<pattern>", where the names of nested fields are separated by ".".
+func ParseFieldOverrides(overrides []string) (map[string]*FieldOverride, error) {
+	fieldOverrides := make(map[string]*FieldOverride)
+	for _, override := range overrides {
+		field, spec, ok := strings.Cut(override, "=")
+		kind, value, hasKind := strings.Cut(spec, ":")
+		if !ok || field == "" || !hasKind {
+			return nil, fmt.Errorf(`invalid field override "%s": must be of the form "<field>=enum:<values>", "<field>=range:<min>..<max>", or "<field>=regex:<pattern>"`, override)
+		}
+
+		fieldOverride := new(FieldOverride)
+		switch kind {
+		case "enum":
+			if value == "" {
+				return nil, fmt.Errorf(`invalid field override "%s": no values`, override)
+			}
+			fieldOverride.values = strings.Split(value, ",")
+		case "range":
+			minimum, maximum, ok := strings.Cut(value, "..")
+			if !ok {
+				return nil, fmt.Errorf(`invalid field override "%s": range must be of the form "<min>..<max>"`, override)
+			}
+			var err error
+			if fieldOverride.min, err = strconv.ParseFloat(minimum, 64); err != nil || !isFinite(fieldOverride.min) {
+				return nil, fmt.Errorf(`invalid field override "%s": invalid minimum "%s"`, override, minimum)
+			}
+			if fieldOverride.max, err = strconv.ParseFloat(maximum, 64); err != nil || !isFinite(fieldOverride.max) {
+				return nil, fmt.Errorf(`invalid field override "%s": invalid maximum "%s"`, override, maximum)
+			}
+			if fieldOverride.min > fieldOverride.max {
+				return nil, fmt.Errorf(`invalid field override "%s": minimum is greater than maximum`, override)
+			}
+			fieldOverride.hasRange = true
+		case "regex":
+			if _, err := syntax.Parse(value, syntax.Perl); err != nil {
+				return nil, fmt.Errorf(`invalid field override "%s": %w`, override, err)
+			}
+			fieldOverride.pattern = value
+		default:
+			return nil, fmt.Errorf(`invalid field override "%s": unknown override "%s", must be "enum", "range", or "regex"`, override, kind)
+		}
+		fieldOverrides[field] = fieldOverride
+	}
+	return fieldOverrides, nil
+}
+
+// generate returns a random value of a kind for the overridden field
+func (o *FieldOverride) generate(r *rand.Rand, field, kind string) (any, error) {
+	switch {
+	case o.hasRange:
+		switch kind {
+		case overrideKindInt:
+			// ranges beyond the bounds of int64 are clamped to them
+			low, high := clampToInt64(math.Ceil(o.min)), clampToInt64(math.Floor(o.max))
+			if high < low {
+				return nil, fmt.Errorf(`range override of field "%s" contains no integers`, field)
+			}
+			return randomInt64(r, low, high), nil
+		case overrideKindFloat:
+			// interpolate between the bounds, since their difference may overflow
+			f := r.Float64()
+			return o.min*(1-f) + o.max*f, nil
+		default:
+			return nil, fmt.Errorf(`range override of field "%s" is only supported for numeric fields`, field)
+		}
+	case o.pattern != "":
+		s, err := randomStringFromRegex(r, o.pattern)
+		if err != nil {
+			return nil, err
+		}
+		return parseOverrideValue(s, field, kind)
+	default:
+		return parseOverrideValue(o.values[r.Intn(len(o.values))], field, kind)
+	}
+}
+
+// fits returns whether the override can generate values of a kind, so that a matching member of a union may be picked
+func (o *FieldOverride) fits(kind string) bool {
+	switch {
+	case o.hasRange:
+		return kind == overrideKindInt || kind == overrideKindFloat
+	case o.pattern != "":
+		return kind == overrideKindString
+	default:
+		for _, value := range o.values {
+			if _, err := parseOverrideValue(value, "", kind); err != nil {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+func parseOverrideValue(s, field, kind string) (any, error) {
+	var v any
+	var err error
+	switch kind {
+	case overrideKindInt:
+		v, err = strconv.ParseInt(s, 10, 64)
+	case overrideKindFloat:
+		v, err = strconv.ParseFloat(s, 64)
+	case overrideKindBool:
+		v, err = strconv.ParseBool(s)
+	default:
+		v = s
+	}
+	if err != nil {
+		return nil, fmt.Errorf(`invalid %s value "%s" for field "%s"`, kind, s, field)
+	}
+	return v, nil
+}
+
+// validateFieldOverrides returns an error naming the first overridden field which the schema doesn't have, so that a
+// mistyped field isn't silently ignored. hasField reports whether a path, split into the names of nested fields, leads
+// to a field whose values are generated from an override.
+func validateFieldOverrides(overrides map[string]*FieldOverride, hasField func(path []string) bool) error {
+	fields := make([]string, 0, len(overrides))
+	for field := range overrides {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+
+	for _, field := range fields {
+		if !hasField(strings.Split(field, ".")) {
+			return fmt.Errorf(`field override of unknown field "%s": overrides must name a field of the schema without nested fields, such as "address.zip"`, field)
+		}
+	}
+	return nil
+}
+
+func isFinite(f float64) bool {
+	return !math.IsNaN(f) && !math.IsInf(f, 0)
+}
+
+// joinFieldPath returns the path of a nested field, as used by field overrides
+func joinFieldPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
@@ -0,0 +1,223 @@
+package serdes
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"slices"
+	"time"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/dynamic"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// protobufWrappers are the well-known types which wrap a single value, and are encoded as that value
+var protobufWrappers = []string{
+	"google.protobuf.DoubleValue", "google.protobuf.FloatValue", "google.protobuf.Int64Value", "google.protobuf.UInt64Value",
+	"google.protobuf.Int32Value", "google.protobuf.UInt32Value", "google.protobuf.BoolValue", "google.protobuf.StringValue",
+	"google.protobuf.BytesValue",
+}
+
+type protobufGenerator struct {
+	descriptor *desc.MessageDescriptor
+	overrides  map[string]*FieldOverride
+	r          *rand.Rand
+}
+
+func newProtobufGenerator(provider *ProtobufSerializationProvider, r *rand.Rand, overrides map[string]*FieldOverride) (*protobufGenerator, error) {
+	message, ok := provider.message.(*dynamic.Message)
+	if !ok {
+		return nil, fmt.Errorf("the Protobuf schema must be loaded to generate records")
+	}
+	descriptor := message.GetMessageDescriptor()
+	if err := validateFieldOverrides(overrides, func(path []string) bool { return hasProtobufField(descriptor, path) }); err != nil {
+		return nil, err
+	}
+	return &protobufGenerator{descriptor: descriptor, overrides: overrides, r: r}, nil
+}
+
+// hasProtobufField returns whether a path leads from a message to a field which can be overridden. Each part of the path
+// names a field of a message, and wrappers are looked through, like in generateMessage.
+func hasProtobufField(descriptor *desc.MessageDescriptor, path []string) bool {
+	if len(path) == 0 {
+		return slices.Contains(protobufWrappers, descriptor.GetFullyQualifiedName())
+	}
+
+	field := descriptor.FindFieldByName(path[0])
+	if field == nil {
+		return false
+	}
+	if field.IsMap() {
+		field = field.GetMapValueType()
+	}
+	if message := field.GetMessageType(); message != nil {
+		return hasProtobufField(message, path[1:])
+	}
+	return len(path) == 1
+}
+
+func (g *protobufGenerator) Generate() (string, error) {
+	v, err := g.generateMessage(g.descriptor, "", 0)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := json.Marshal(v)
+	return string(out), err
+}
+
+// generateMessage returns a random message in the Protobuf JSON encoding, see
+// https://protobuf.dev/programming-guides/proto3/#json. The path of the field being generated is used to look up its
+// override; the items of repeated fields and the values of maps share the path of their field.
+func (g *protobufGenerator) generateMessage(descriptor *desc.MessageDescriptor, path string, depth int) (any, error) {
+	if v, ok, err := g.generateWellKnownType(descriptor, path, depth); ok || err != nil {
+		return v, err
+	}
+
+	// only one field of each oneof may be set
+	chosen := make(map[*desc.OneOfDescriptor]*desc.FieldDescriptor)
+	for _, oneOf := range descriptor.GetOneOfs() {
+		if choices := oneOf.GetChoices(); !oneOf.IsSynthetic() && len(choices) > 0 {
+			chosen[oneOf] = choices[g.r.Intn(len(choices))]
+		}
+	}
+
+	message := make(map[string]any)
+	for _, field := range descriptor.GetFields() {
+		if oneOf := field.GetOneOf(); oneOf != nil && !oneOf.IsSynthetic() && chosen[oneOf] != field {
+			continue
+		}
+		// past the maximum depth, nested messages are left unset so that recursive messages terminate
+		if field.GetMessageType() != nil && depth >= maxGeneratedDepth {
+			continue
+		}
+
+		fieldPath := joinFieldPath(path, field.GetName())
+		switch {
+		case field.IsMap():
+			values := make(map[string]any)
+			for i := g.getItemCount(depth); i > 0; i-- {
+				key, err := g.generateField(field.GetMapKeyType(), "", depth+1)
+				if err != nil {
+					return nil, err
+				}
+				value, err := g.generateField(field.GetMapValueType(), fieldPath, depth+1)
+				if err != nil {
+					return nil, err
+				}
+				values[fmt.Sprint(key)] = value
+			}
+			message[field.GetName()] = values
+		case field.IsRepeated():
+			items := make([]any, g.getItemCount(depth))
+			for i := range items {
+				item, err := g.generateField(field, fieldPath, depth+1)
+				if err != nil {
+					return nil, err
+				}
+				items[i] = item
+			}
+			message[field.GetName()] = items
+		default:
+			value, err := g.generateField(field, fieldPath, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			message[field.GetName()] = value
+		}
+	}
+	return message, nil
+}
+
+func (g *protobufGenerator) generateField(field *desc.FieldDescriptor, path string, depth int) (any, error) {
+	if field.GetMessageType() != nil {
+		return g.generateMessage(field.GetMessageType(), path, depth)
+	}
+	if override, ok := g.overrides[path]; ok && path != "" {
+		return override.generate(g.r, path, getProtobufOverrideKind(field.GetType()))
+	}
+	return g.generateScalar(field), nil
+}
+
+func (g *protobufGenerator) generateScalar(field *desc.FieldDescriptor) any {
+	switch field.GetType() {
+	case descriptorpb.FieldDescriptorProto_TYPE_BOOL:
+		return g.r.Intn(2) == 1
+	case descriptorpb.FieldDescriptorProto_TYPE_INT32, descriptorpb.FieldDescriptorProto_TYPE_SINT32, descriptorpb.FieldDescriptorProto_TYPE_SFIXED32:
+		return g.r.Int31n(1000)
+	case descriptorpb.FieldDescriptorProto_TYPE_INT64, descriptorpb.FieldDescriptorProto_TYPE_SINT64, descriptorpb.FieldDescriptorProto_TYPE_SFIXED64:
+		return g.r.Int63n(100000)
+	case descriptorpb.FieldDescriptorProto_TYPE_UINT32, descriptorpb.FieldDescriptorProto_TYPE_FIXED32:
+		return uint32(g.r.Int31n(1000))
+	case descriptorpb.FieldDescriptorProto_TYPE_UINT64, descriptorpb.FieldDescriptorProto_TYPE_FIXED64:
+		return uint64(g.r.Int63n(100000))
+	case descriptorpb.FieldDescriptorProto_TYPE_FLOAT:
+		return g.r.Float32() * 1000
+	case descriptorpb.FieldDescriptorProto_TYPE_DOUBLE:
+		return g.r.Float64() * 1000
+	case descriptorpb.FieldDescriptorProto_TYPE_STRING:
+		return randomString(g.r, 8, 16)
+	case descriptorpb.FieldDescriptorProto_TYPE_BYTES:
+		return base64.StdEncoding.EncodeToString([]byte(randomString(g.r, 4, 16)))
+	case descriptorpb.FieldDescriptorProto_TYPE_ENUM:
+		values := field.GetEnumType().GetValues()
+		if len(values) == 0 {
+			return nil
+		}
+		return values[g.r.Intn(len(values))].GetName()
+	default:
+		return nil
+	}
+}
+
+// generateWellKnownType returns a random value of the well-known types which have a special JSON encoding. Wrappers are
+// encoded as their wrapped value, which may be overridden.
+func (g *protobufGenerator) generateWellKnownType(descriptor *desc.MessageDescriptor, path string, depth int) (any, bool, error) {
+	switch descriptor.GetFullyQualifiedName() {
+	case "google.protobuf.Timestamp":
+		return randomTime(g.r).Format(time.RFC3339Nano), true, nil
+	case "google.protobuf.Duration":
+		return fmt.Sprintf("%ds", g.r.Intn(3600)), true, nil
+	case "google.protobuf.Struct", "google.protobuf.Empty":
+		return map[string]any{}, true, nil
+	case "google.protobuf.Value":
+		return randomString(g.r, 8, 16), true, nil
+	case "google.protobuf.ListValue":
+		return []any{}, true, nil
+	case "google.protobuf.FieldMask":
+		return "", true, nil
+	case "google.protobuf.Any":
+		return nil, true, nil
+	default:
+		if slices.Contains(protobufWrappers, descriptor.GetFullyQualifiedName()) {
+			v, err := g.generateField(descriptor.FindFieldByName("value"), path, depth)
+			return v, true, err
+		}
+		return nil, false, nil
+	}
+}
+
+func getProtobufOverrideKind(kind descriptorpb.FieldDescriptorProto_Type) string {
+	switch kind {
+	case descriptorpb.FieldDescriptorProto_TYPE_BOOL:
+		return overrideKindBool
+	case descriptorpb.FieldDescriptorProto_TYPE_INT32, descriptorpb.FieldDescriptorProto_TYPE_SINT32, descriptorpb.FieldDescriptorProto_TYPE_SFIXED32,
+		descriptorpb.FieldDescriptorProto_TYPE_INT64, descriptorpb.FieldDescriptorProto_TYPE_SINT64, descriptorpb.FieldDescriptorProto_TYPE_SFIXED64,
+		descriptorpb.FieldDescriptorProto_TYPE_UINT32, descriptorpb.FieldDescriptorProto_TYPE_FIXED32,
+		descriptorpb.FieldDescriptorProto_TYPE_UINT64, descriptorpb.FieldDescriptorProto_TYPE_FIXED64:
+		return overrideKindInt
+	case descriptorpb.FieldDescriptorProto_TYPE_FLOAT, descriptorpb.FieldDescriptorProto_TYPE_DOUBLE:
+		return overrideKindFloat
+	default:
+		return overrideKindString
+	}
+}
+
+func (g *protobufGenerator) getItemCount(depth int) int {
+	if depth >= maxGeneratedDepth {
+		return 0
+	}
+	return g.r.Intn(maxGeneratedItems + 1)
+}
@@ -0,0 +1,322 @@
+package serdes
+
+import (
+	"encoding/json"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testGenerator generates records from a schema, checks that they can be serialized, and returns them decoded
+func testGenerator(t *testing.T, format, schemaName, schemaString string, references map[string]string, overrides []string) []map[string]any {
+	req := require.New(t)
+
+	dir, err := createTempDir()
+	req.NoError(err)
+	defer func() {
+		req.NoError(os.RemoveAll(dir))
+	}()
+
+	schemaPath := filepath.Join(dir, schemaName)
+	req.NoError(os.WriteFile(schemaPath, []byte(schemaString), 0644))
+
+	referencePathMap := make(map[string]string)
+	for name, reference := range references {
+		referencePathMap[name] = filepath.Join(dir, name)
+		req.NoError(os.WriteFile(referencePathMap[name], []byte(reference), 0644))
+	}
+
+	provider, err := GetSerializationProvider(format)
+	req.NoError(err)
+	req.NoError(provider.LoadSchema(schemaPath, referencePathMap))
+
+	fieldOverrides, err := ParseFieldOverrides(overrides)
+	req.NoError(err)
+
+	generator, err := NewGenerator(provider, rand.New(rand.NewSource(1)), fieldOverrides)
+	req.NoError(err)
+
+	records := make([]map[string]any, 100)
+	for i := range records {
+		record, err := generator.Generate()
+		req.NoError(err)
+		_, err = provider.Serialize(record)
+		req.NoError(err, record)
+		req.NoError(json.Unmarshal([]byte(record), &records[i]))
+	}
+	return records
+}
+
+func TestAvroGenerator(t *testing.T) {
+	schemaString := `{
+		"type": "record",
+		"name": "Order",
+		"namespace": "io.confluent",
+		"fields": [
+			{"name": "id", "type": {"type": "string", "logicalType": "uuid"}},
+			{"name": "quantity", "type": "int"},
+			{"name": "price", "type": "double"},
+			{"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["NEW", "SHIPPED"]}},
+			{"name": "created", "type": {"type": "long", "logicalType": "timestamp-millis"}},
+			{"name": "checksum", "type": {"type": "fixed", "name": "Checksum", "size": 4}},
+			{"name": "tags", "type": {"type": "map", "values": "string"}},
+			{"name": "parent", "type": ["null", "Order"]},
+			{"name": "items", "type": {"type": "array", "items": {"type": "record", "name": "Item", "fields": [{"name": "sku", "type": ["null", "string", "long"]}]}}}
+		]
+	}`
+	testGenerator(t, avroSchemaName, "order.avsc", schemaString, nil, nil)
+
+	overrides := []string{"quantity=range:5..7", "status=enum:SHIPPED", "parent.status=enum:NEW", "items.sku=regex:SKU-[0-9]{3}"}
+	for _, record := range testGenerator(t, avroSchemaName, "order.avsc", schemaString, nil, overrides) {
+		require.GreaterOrEqual(t, record["quantity"], 5.0)
+		require.LessOrEqual(t, record["quantity"], 7.0)
+		require.Equal(t, "SHIPPED", record["status"])
+		if parent, ok := record["parent"].(map[string]any); ok {
+			require.Equal(t, "NEW", parent["io.confluent.Order"].(map[string]any)["status"])
+		}
+		for _, item := range record["items"].([]any) {
+			require.Regexp(t, "^SKU-[0-9]{3}$", item.(map[string]any)["sku"].(map[string]any)["string"])
+		}
+	}
+}
+
+func TestJsonGenerator(t *testing.T) {
+	referenceString := `{"type": "string", "format": "email"}`
+	schemaString := `{
+		"type": "object",
+		"definitions": {"node": {"type": "object", "properties": {"child": {"$ref": "#/definitions/node"}}}},
+		"properties": {
+			"id": {"type": "string", "pattern": "^[A-Z]{3}-[0-9]{4}$"},
+			"quantity": {"type": "integer", "minimum": 1, "exclusiveMaximum": 10, "multipleOf": 2},
+			"price": {"type": "number", "minimum": 0.5, "maximum": 2},
+			"status": {"enum": ["NEW", "SHIPPED"]},
+			"created": {"type": "string", "format": "date-time"},
+			"email": {"$ref": "email.json"},
+			"tags": {"type": "array", "items": {"type": "string", "minLength": 2, "maxLength": 4}, "minItems": 1, "maxItems": 2},
+			"node": {"$ref": "#/definitions/node"},
+			"payment": {"oneOf": [{"type": "null"}, {"type": "object", "properties": {"card": {"type": "string"}}, "required": ["card"]}]},
+			"address": {"allOf": [{"properties": {"street": {"type": "string"}}, "required": ["street"]}, {"properties": {"city": {"type": "string"}}, "required": ["city"]}]}
+		},
+		"required": ["id", "quantity", "price", "status", "created", "email", "tags", "node", "payment", "address"],
+		"additionalProperties": false
+	}`
+	references := map[string]string{"email.json": referenceString}
+	testGenerator(t, jsonSchemaName, "order.json", schemaString, references, nil)
+
+	overrides := []string{"quantity=enum:4,8", "price=range:1..1.5", "payment.card=regex:4[0-9]{15}", "tags=enum:ab,cd"}
+	for _, record := range testGenerator(t, jsonSchemaName, "order.json", schemaString, references, overrides) {
+		require.Contains(t, []any{4.0, 8.0}, record["quantity"])
+		require.GreaterOrEqual(t, record["price"], 1.0)
+		require.LessOrEqual(t, record["price"], 1.5)
+		if payment, ok := record["payment"].(map[string]any); ok {
+			require.Regexp(t, "^4[0-9]{15}$", payment["card"])
+		}
+		for _, tag := range record["tags"].([]any) {
+			require.Contains(t, []any{"ab", "cd"}, tag)
+		}
+	}
+}
+
+func TestProtobufGenerator(t *testing.T) {
+	schemaString := `
+	syntax = "proto3";
+	import "google/protobuf/timestamp.proto";
+	import "google/protobuf/wrappers.proto";
+	message Order {
+	  enum Status {
+	    NEW = 0;
+	    SHIPPED = 1;
+	  }
+	  string id = 1;
+	  int64 quantity = 2;
+	  double price = 3;
+	  Status status = 4;
+	  google.protobuf.Timestamp created = 5;
+	  google.protobuf.StringValue note = 6;
+	  bytes checksum = 7;
+	  map<string, int32> tags = 8;
+	  repeated Order children = 9;
+	  optional uint32 priority = 10;
+	  oneof payment {
+	    string card = 11;
+	    string voucher = 12;
+	  }
+	}`
+	testGenerator(t, protobufSchemaName, "order.proto", schemaString, nil, nil)
+
+	overrides := []string{"quantity=range:1..3", "status=enum:SHIPPED", "note=enum:fragile", "children.id=regex:child-[0-9]"}
+	for _, record := range testGenerator(t, protobufSchemaName, "order.proto", schemaString, nil, overrides) {
+		require.Contains(t, []any{1.0, 2.0, 3.0}, record["quantity"])
+		require.Equal(t, "SHIPPED", record["status"])
+		require.Equal(t, "fragile", record["note"])
+		for _, child := range record["children"].([]any) {
+			require.Regexp(t, "^child-[0-9]$", child.(map[string]any)["id"])
+		}
+	}
+}
+
+func TestGeneratorOverrideErrors(t *testing.T) {
+	schemaString := `{"type": "record", "name": "Order", "fields": [{"name": "id", "type": "string"}, {"name": "quantity", "type": "int"}]}`
+
+	dir, err := createTempDir()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+	schemaPath := filepath.Join(dir, "order.avsc")
+	require.NoError(t, os.WriteFile(schemaPath, []byte(schemaString), 0644))
+
+	provider, err := GetSerializationProvider(avroSchemaName)
+	require.NoError(t, err)
+	require.NoError(t, provider.LoadSchema(schemaPath, map[string]string{}))
+
+	for override, expected := range map[string]string{
+		"id=range:1..2":       `range override of field "id" is only supported for numeric fields`,
+		"quantity=enum:a":     `invalid int value "a" for field "quantity"`,
+		"quantity=range:1..1": "",
+		"quantity=range:-9223372036854775808..9223372036854775807": "",
+		"quantity=range:-1e300..1e300":                             "",
+		"quantity=range:-9000000000000000000..9000000000000000000": "",
+	} {
+		overrides, err := ParseFieldOverrides([]string{override})
+		require.NoError(t, err)
+		generator, err := NewGenerator(provider, rand.New(rand.NewSource(1)), overrides)
+		require.NoError(t, err)
+		_, err = generator.Generate()
+		if expected == "" {
+			require.NoError(t, err)
+		} else {
+			require.EqualError(t, err, expected)
+		}
+	}
+
+	stringProvider, err := GetSerializationProvider(stringSchemaName)
+	require.NoError(t, err)
+	overrides, err := ParseFieldOverrides([]string{"id=enum:a"})
+	require.NoError(t, err)
+	_, err = NewGenerator(stringProvider, rand.New(rand.NewSource(1)), overrides)
+	require.EqualError(t, err, "field overrides are only supported for schema-based formats")
+}
+
+func TestGeneratorUnknownOverrideField(t *testing.T) {
+	dir, err := createTempDir()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	schemas := map[string]string{
+		avroSchemaName:     `{"type": "record", "name": "Order", "fields": [{"name": "id", "type": "string"}, {"name": "address", "type": {"type": "record", "name": "Address", "fields": [{"name": "zip", "type": "string"}]}}]}`,
+		jsonSchemaName:     `{"type": "object", "properties": {"id": {"type": "string"}, "address": {"type": "object", "properties": {"zip": {"type": "string"}}}}}`,
+		protobufSchemaName: `syntax = "proto3"; message Order { string id = 1; Address address = 2; } message Address { string zip = 1; }`,
+	}
+	for format, schemaString := range schemas {
+		schemaPath := filepath.Join(dir, "order."+format)
+		require.NoError(t, os.WriteFile(schemaPath, []byte(schemaString), 0644))
+
+		provider, err := GetSerializationProvider(format)
+		require.NoError(t, err)
+		require.NoError(t, provider.LoadSchema(schemaPath, map[string]string{}))
+
+		for field, expected := range map[string]string{
+			"address.zip": "",
+			"adress.zip":  `field override of unknown field "adress.zip": overrides must name a field of the schema without nested fields, such as "address.zip"`,
+			"address":     `field override of unknown field "address": overrides must name a field of the schema without nested fields, such as "address.zip"`,
+			"id.zip":      `field override of unknown field "id.zip": overrides must name a field of the schema without nested fields, such as "address.zip"`,
+		} {
+			overrides, err := ParseFieldOverrides([]string{field + "=enum:a"})
+			require.NoError(t, err)
+			_, err = NewGenerator(provider, rand.New(rand.NewSource(1)), overrides)
+			if expected == "" {
+				require.NoError(t, err, format)
+			} else {
+				require.EqualError(t, err, expected, format)
+			}
+		}
+	}
+}
+
+func TestRandomInt64(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, bounds := range [][2]int64{{math.MinInt64, math.MaxInt64}, {-1, math.MaxInt64}, {math.MinInt64, 0}, {-3, 3}, {5, 5}} {
+		for i := 0; i < 100; i++ {
+			v := randomInt64(r, bounds[0], bounds[1])
+			require.GreaterOrEqual(t, v, bounds[0])
+			require.LessOrEqual(t, v, bounds[1])
+		}
+	}
+}
+
+func TestJsonGeneratorInteger(t *testing.T) {
+	g := &jsonGenerator{r: rand.New(rand.NewSource(1))}
+	for i := 0; i < 100; i++ {
+		require.Equal(t, int64(-4), g.generateInteger(map[string]any{"minimum": -5.0, "maximum": -3.0, "multipleOf": 2.0}))
+		require.Zero(t, g.generateInteger(map[string]any{"minimum": -1e19, "maximum": 1e19, "multipleOf": 1e18})%1e18)
+		g.generateInteger(map[string]any{"minimum": -1e19, "maximum": 1e19})
+		g.generateInteger(map[string]any{"minimum": -9e18, "maximum": 9e18})
+	}
+}
+
+func TestParseFieldOverrides(t *testing.T) {
+	overrides, err := ParseFieldOverrides([]string{"status=enum:NEW,SHIPPED", "address.zip=regex:[0-9]{5}", "price=range:0.5..10"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]*FieldOverride{
+		"status":      {values: []string{"NEW", "SHIPPED"}},
+		"address.zip": {pattern: "[0-9]{5}"},
+		"price":       {min: 0.5, max: 10, hasRange: true},
+	}, overrides)
+
+	for override, expected := range map[string]string{
+		"status":             `invalid field override "status": must be of the form "<field>=enum:<values>", "<field>=range:<min>..<max>", or "<field>=regex:<pattern>"`,
+		"status=NEW":         `invalid field override "status=NEW": must be of the form "<field>=enum:<values>", "<field>=range:<min>..<max>", or "<field>=regex:<pattern>"`,
+		"status=enum:":       `invalid field override "status=enum:": no values`,
+		"price=range:1":      `invalid field override "price=range:1": range must be of the form "<min>..<max>"`,
+		"price=range:2..1":   `invalid field override "price=range:2..1": minimum is greater than maximum`,
+		"price=range:a..1":   `invalid field override "price=range:a..1": invalid minimum "a"`,
+		"price=range:1..Inf": `invalid field override "price=range:1..Inf": invalid maximum "Inf"`,
+		"zip=regex:[0-9":     "invalid field override \"zip=regex:[0-9\": error parsing regexp: missing closing ]: `[0-9`",
+		"zip=glob:*":         `invalid field override "zip=glob:*": unknown override "glob", must be "enum", "range", or "regex"`,
+	} {
+		_, err := ParseFieldOverrides([]string{override})
+		require.EqualError(t, err, expected)
+	}
+}
+
+func TestGeneratorSeed(t *testing.T) {
+	provider, err := GetSerializationProvider(stringSchemaName)
+	require.NoError(t, err)
+
+	generate := func(seed int64) []string {
+		generator, err := NewGenerator(provider, rand.New(rand.NewSource(seed)), nil)
+		require.NoError(t, err)
+
+		records := make([]string, 5)
+		for i := range records {
+			records[i], err = generator.Generate()
+			require.NoError(t, err)
+		}
+		return records
+	}
+
+	require.Equal(t, generate(1), generate(1))
+	require.NotEqual(t, generate(1), generate(2))
+}
+
+func TestRandomStringFromRegex(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, pattern := range []string{`^[A-Z]{3}-\d{4}$`, `(red|green|blue)+`, `[a-f0-9]{8}(-[a-f0-9]{4}){3}`, `.?x*`} {
+		for i := 0; i < 20; i++ {
+			s, err := randomStringFromRegex(r, pattern)
+			require.NoError(t, err)
+			require.Regexp(t, regexp.MustCompile("^(?:"+pattern+")$"), s)
+		}
+	}
+
+	_, err := randomStringFromRegex(r, "[a-")
+	require.Error(t, err)
+}
@@ -12,7 +12,9 @@ import (
 )
 
 type JsonSerializationProvider struct {
-	schemaLoader *gojsonschema.Schema
+	schemaLoader     *gojsonschema.Schema
+	schemaPath       string
+	referencePathMap map[string]string
 }
 
 func (j *JsonSerializationProvider) LoadSchema(schemaPath string, referencePathMap map[string]string) error {
@@ -21,6 +23,8 @@ func (j *JsonSerializationProvider) LoadSchema(schemaPath string, referencePathM
 		return err
 	}
 	j.schemaLoader = schemaLoader
+	j.schemaPath = schemaPath
+	j.referencePathMap = referencePathMap
 	return nil
 }
 
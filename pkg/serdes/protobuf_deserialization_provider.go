@@ -5,36 +5,45 @@ import (
 
 	"github.com/golang/protobuf/jsonpb" //nolint:staticcheck // deprecated module cannot be removed due to https://github.com/jhump/protoreflect/issues/301
 	"github.com/golang/protobuf/proto"  //nolint:staticcheck // deprecated module cannot be removed due to https://github.com/jhump/protoreflect/issues/301
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/dynamic"
 
 	"github.com/confluentinc/cli/v3/pkg/errors"
 )
 
 type ProtobufDeserializationProvider struct {
-	message proto.Message
+	fileDescriptor *desc.FileDescriptor
 }
 
 func (p *ProtobufDeserializationProvider) LoadSchema(schemaPath string, referencePathMap map[string]string) error {
-	message, err := parseMessage(schemaPath, referencePathMap)
+	fileDescriptor, err := parseFileDescriptor(schemaPath, referencePathMap)
 	if err != nil {
 		return err
 	}
-	p.message = message
+	p.fileDescriptor = fileDescriptor
 	return nil
 }
 
 func (p *ProtobufDeserializationProvider) Deserialize(data []byte) (string, error) {
 	// Index array indicates which message in the file we're referring to.
-	// In our case, we simply ignore the index array [0].
-	data = data[1:]
+	indexes, size, err := decodeMessageIndexes(data)
+	if err != nil {
+		return "", err
+	}
+	messageDescriptor, err := findMessageByIndexes(p.fileDescriptor, indexes)
+	if err != nil {
+		return "", err
+	}
+	message := dynamic.NewMessageFactoryWithDefaults().NewMessage(messageDescriptor)
 
 	// Convert from binary format to proto message type.
-	if err := proto.Unmarshal(data, p.message); err != nil {
+	if err := proto.Unmarshal(data[size:], message); err != nil {
 		return "", fmt.Errorf(errors.ProtoDocumentInvalidErrorMsg)
 	}
 
 	// Convert from proto message type to JSON string.
 	marshaler := &jsonpb.Marshaler{}
-	str, err := marshaler.MarshalToString(p.message)
+	str, err := marshaler.MarshalToString(message)
 	if err != nil {
 		return "", err
 	}
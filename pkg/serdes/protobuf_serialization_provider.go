@@ -1,12 +1,15 @@
 package serdes
 
 import (
+	"encoding/binary"
 	"fmt"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/golang/protobuf/jsonpb" //nolint:staticcheck // deprecated module cannot be removed due to https://github.com/jhump/protoreflect/issues/301
 	"github.com/golang/protobuf/proto"  //nolint:staticcheck // deprecated module cannot be removed due to https://github.com/jhump/protoreflect/issues/301
+	"github.com/jhump/protoreflect/desc"
 	parse "github.com/jhump/protoreflect/desc/protoparse"
 	"github.com/jhump/protoreflect/dynamic"
 
@@ -14,15 +17,28 @@ import (
 )
 
 type ProtobufSerializationProvider struct {
-	message proto.Message
+	message        proto.Message
+	messageIndexes []int
+	messageName    string
+}
+
+// SetMessageName selects the message type to serialize by its fully qualified name. By default, the first message
+// of the schema is used.
+func (p *ProtobufSerializationProvider) SetMessageName(name string) {
+	p.messageName = name
 }
 
 func (p *ProtobufSerializationProvider) LoadSchema(schemaPath string, referencePathMap map[string]string) error {
-	message, err := parseMessage(schemaPath, referencePathMap)
+	fileDescriptor, err := parseFileDescriptor(schemaPath, referencePathMap)
 	if err != nil {
 		return err
 	}
-	p.message = message
+	messageDescriptor, err := findMessageByName(fileDescriptor, p.messageName)
+	if err != nil {
+		return err
+	}
+	p.message = dynamic.NewMessageFactoryWithDefaults().NewMessage(messageDescriptor)
+	p.messageIndexes = getMessageIndexes(messageDescriptor)
 	return nil
 }
 
@@ -32,8 +48,7 @@ func (p *ProtobufSerializationProvider) GetSchemaName() string {
 
 func (p *ProtobufSerializationProvider) Serialize(str string) ([]byte, error) {
 	// Index array indicates which message in the file we're referring to.
-	// In our case, index array is always [0].
-	indexBytes := []byte{0x0}
+	indexBytes := encodeMessageIndexes(p.messageIndexes)
 
 	// Convert from JSON string to proto message type.
 	if err := jsonpb.UnmarshalString(str, p.message); err != nil {
@@ -49,7 +64,7 @@ func (p *ProtobufSerializationProvider) Serialize(str string) ([]byte, error) {
 	return data, nil
 }
 
-func parseMessage(schemaPath string, referencePathMap map[string]string) (proto.Message, error) {
+func parseFileDescriptor(schemaPath string, referencePathMap map[string]string) (*desc.FileDescriptor, error) {
 	importPaths := []string{filepath.Dir(schemaPath)}
 	for _, path := range referencePathMap {
 		importPaths = append(importPaths, strings.SplitAfter(path, "ccloud-schema")[0])
@@ -59,17 +74,90 @@ func parseMessage(schemaPath string, referencePathMap map[string]string) (proto.
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", errors.ProtoSchemaInvalidErrorMsg, err)
 	}
-	if len(fileDescriptors) == 0 {
+	if len(fileDescriptors) == 0 || len(fileDescriptors[0].GetMessageTypes()) == 0 {
 		return nil, fmt.Errorf(errors.ProtoSchemaInvalidErrorMsg)
 	}
-	fileDescriptor := fileDescriptors[0]
+	return fileDescriptors[0], nil
+}
 
+// findMessageByName returns the message of the file with a fully qualified name, or the first message if no name is given
+func findMessageByName(fileDescriptor *desc.FileDescriptor, name string) (*desc.MessageDescriptor, error) {
+	if name == "" {
+		return fileDescriptor.GetMessageTypes()[0], nil
+	}
+
+	if messageDescriptor := fileDescriptor.FindMessage(strings.TrimPrefix(name, ".")); messageDescriptor != nil {
+		return messageDescriptor, nil
+	}
+	return nil, errors.NewErrorWithSuggestions(
+		fmt.Sprintf(`message "%s" not found in the protobuf schema`, name),
+		"Specify the message with its fully qualified name, including the package and the names of enclosing messages.",
+	)
+}
+
+// findMessageByIndexes returns the message at a path of message indexes, starting with the index of a top-level message
+func findMessageByIndexes(fileDescriptor *desc.FileDescriptor, indexes []int) (*desc.MessageDescriptor, error) {
 	messageDescriptors := fileDescriptor.GetMessageTypes()
-	if len(messageDescriptors) == 0 {
-		return nil, fmt.Errorf(errors.ProtoSchemaInvalidErrorMsg)
+	var messageDescriptor *desc.MessageDescriptor
+	for _, index := range indexes {
+		if index < 0 || index >= len(messageDescriptors) {
+			return nil, fmt.Errorf("message index %v not found in the protobuf schema", indexes)
+		}
+		messageDescriptor = messageDescriptors[index]
+		messageDescriptors = messageDescriptor.GetNestedMessageTypes()
+	}
+	return messageDescriptor, nil
+}
+
+// getMessageIndexes returns the path of indexes to a message, as used by the Confluent wire format
+func getMessageIndexes(messageDescriptor *desc.MessageDescriptor) []int {
+	var indexes []int
+	for {
+		switch parent := messageDescriptor.GetParent().(type) {
+		case *desc.MessageDescriptor:
+			indexes = append([]int{slices.Index(parent.GetNestedMessageTypes(), messageDescriptor)}, indexes...)
+			messageDescriptor = parent
+		case *desc.FileDescriptor:
+			return append([]int{slices.Index(parent.GetMessageTypes(), messageDescriptor)}, indexes...)
+		default:
+			return indexes
+		}
+	}
+}
+
+// encodeMessageIndexes writes the number of indexes followed by each index as zigzag varints. The common case of the
+// first message, [0], is written as a single 0.
+func encodeMessageIndexes(indexes []int) []byte {
+	if len(indexes) == 1 && indexes[0] == 0 {
+		return []byte{0x0}
+	}
+
+	data := binary.AppendVarint(nil, int64(len(indexes)))
+	for _, index := range indexes {
+		data = binary.AppendVarint(data, int64(index))
+	}
+	return data
+}
+
+// decodeMessageIndexes reads the message indexes at the start of the data, and returns them with their size in bytes
+func decodeMessageIndexes(data []byte) ([]int, int, error) {
+	count, n := binary.Varint(data)
+	if n <= 0 || count < 0 {
+		return nil, 0, fmt.Errorf(errors.ProtoDocumentInvalidErrorMsg)
+	}
+	if count == 0 {
+		return []int{0}, n, nil
+	}
+
+	size := n
+	indexes := make([]int, 0, count)
+	for i := int64(0); i < count; i++ {
+		index, n := binary.Varint(data[size:])
+		if n <= 0 {
+			return nil, 0, fmt.Errorf(errors.ProtoDocumentInvalidErrorMsg)
+		}
+		indexes = append(indexes, int(index))
+		size += n
 	}
-	// We're always using the outermost first message.
-	messageDescriptor := messageDescriptors[0]
-	messageFactory := dynamic.NewMessageFactoryWithDefaults()
-	return messageFactory.NewMessage(messageDescriptor), nil
+	return indexes, size, nil
 }
@@ -122,6 +122,68 @@ func TestAvroSerdesInvalid(t *testing.T) {
 	req.NoError(os.RemoveAll(dir))
 }
 
+func TestAvroSerdesReference(t *testing.T) {
+	req := require.New(t)
+
+	dir, err := createTempDir()
+	req.Nil(err)
+
+	referenceString := `{"type":"record","name":"Address","namespace":"com.example","fields":[{"name":"street","type":"string"},{"name":"country","type":{"type":"enum","name":"Country","symbols":["US","CA"]}}]}`
+	referencePath := filepath.Join(dir, "avro-reference.avsc")
+	req.NoError(os.WriteFile(referencePath, []byte(referenceString), 0644))
+
+	schemaString := `{"type":"record","name":"Customer","namespace":"com.example","fields":[{"name":"home","type":"Address"},{"name":"work","type":["null","com.example.Address"]},{"name":"country","type":"Country"}]}`
+	schemaPath := filepath.Join(dir, "avro-schema.avsc")
+	req.NoError(os.WriteFile(schemaPath, []byte(schemaString), 0644))
+
+	referencePathMap := map[string]string{"address.avsc": referencePath}
+	expectedString := `{"country":"US","home":{"country":"US","street":"a"},"work":{"com.example.Address":{"country":"CA","street":"b"}}}`
+	expectedBytes := []byte{2, 97, 0, 2, 2, 98, 2, 0}
+
+	serializationProvider, _ := GetSerializationProvider(avroSchemaName)
+	err = serializationProvider.LoadSchema(schemaPath, referencePathMap)
+	req.Nil(err)
+	data, err := serializationProvider.Serialize(expectedString)
+	req.Nil(err)
+
+	result := bytes.Compare(expectedBytes, data)
+	req.Zero(result)
+
+	deserializationProvider, _ := GetDeserializationProvider(avroSchemaName)
+	err = deserializationProvider.LoadSchema(schemaPath, referencePathMap)
+	req.Nil(err)
+	str, err := deserializationProvider.Deserialize(expectedBytes)
+	req.Nil(err)
+	req.JSONEq(expectedString, str)
+
+	req.NoError(os.RemoveAll(dir))
+}
+
+func TestAvroSerdesNestedReference(t *testing.T) {
+	req := require.New(t)
+
+	dir, err := createTempDir()
+	req.Nil(err)
+
+	referenceString := `{"type":"record","name":"Address","namespace":"com.example","fields":[{"name":"country","type":{"type":"enum","name":"Country","symbols":["US","CA"]}}]}`
+	referencePath := filepath.Join(dir, "avro-reference.avsc")
+	req.NoError(os.WriteFile(referencePath, []byte(referenceString), 0644))
+
+	// The nested type is used before the type which defines it
+	schemaString := `{"type":"record","name":"Customer","namespace":"com.example","fields":[{"name":"country","type":"Country"},{"name":"home","type":"Address"}]}`
+	schemaPath := filepath.Join(dir, "avro-schema.avsc")
+	req.NoError(os.WriteFile(schemaPath, []byte(schemaString), 0644))
+
+	serializationProvider, _ := GetSerializationProvider(avroSchemaName)
+	err = serializationProvider.LoadSchema(schemaPath, map[string]string{"address.avsc": referencePath})
+	req.Nil(err)
+	data, err := serializationProvider.Serialize(`{"country":"CA","home":{"country":"US"}}`)
+	req.Nil(err)
+	req.Equal([]byte{2, 0}, data)
+
+	req.NoError(os.RemoveAll(dir))
+}
+
 func TestJsonSerdesValid(t *testing.T) {
 	req := require.New(t)
 
@@ -426,3 +488,63 @@ func createTempDir() (string, error) {
 	err := os.MkdirAll(dir, 0755)
 	return dir, err
 }
+
+func TestProtobufSerdesMessageName(t *testing.T) {
+	req := require.New(t)
+
+	dir, err := createTempDir()
+	req.Nil(err)
+
+	schemaString := `
+	syntax = "proto3";
+	package io.confluent;
+	message Person {
+	  string name = 1;
+	  message Phone {
+	    string number = 1;
+	  }
+	}
+	message Order {
+	  int32 id = 1;
+	}`
+	schemaPath := filepath.Join(dir, "person.proto")
+	req.NoError(os.WriteFile(schemaPath, []byte(schemaString), 0644))
+
+	tests := []struct {
+		messageName    string
+		expectedString string
+		expectedBytes  []byte
+	}{
+		{"", `{"name":"abc"}`, []byte{0, 10, 3, 97, 98, 99}},
+		{"io.confluent.Order", `{"id":5}`, []byte{2, 2, 8, 5}},
+		{"io.confluent.Person.Phone", `{"number":"234"}`, []byte{4, 0, 0, 10, 3, 50, 51, 52}},
+	}
+
+	deserializationProvider, _ := GetDeserializationProvider(protobufSchemaName)
+	err = deserializationProvider.LoadSchema(schemaPath, map[string]string{})
+	req.Nil(err)
+
+	for _, test := range tests {
+		serializationProvider := new(ProtobufSerializationProvider)
+		serializationProvider.SetMessageName(test.messageName)
+		err = serializationProvider.LoadSchema(schemaPath, map[string]string{})
+		req.Nil(err)
+		data, err := serializationProvider.Serialize(test.expectedString)
+		req.Nil(err)
+		req.Equal(test.expectedBytes, data)
+
+		str, err := deserializationProvider.Deserialize(data)
+		req.Nil(err)
+		req.Equal(test.expectedString, str)
+	}
+
+	serializationProvider := new(ProtobufSerializationProvider)
+	serializationProvider.SetMessageName("io.confluent.Address")
+	err = serializationProvider.LoadSchema(schemaPath, map[string]string{})
+	req.EqualError(err, `message "io.confluent.Address" not found in the protobuf schema`)
+
+	_, err = deserializationProvider.Deserialize([]byte{2, 4, 8, 5})
+	req.EqualError(err, "message index [2] not found in the protobuf schema")
+
+	req.NoError(os.RemoveAll(dir))
+}
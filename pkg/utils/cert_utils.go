@@ -122,7 +122,7 @@ func SelfSignedCertClient(caCertReader io.Reader, clientCert tls.Certificate) (*
 	}
 	defaultClient := DefaultClient()
 	client := &http.Client{
-		Transport:     transport,
+		Transport:     log.NewTransport(transport),
 		CheckRedirect: defaultClient.CheckRedirect,
 		Jar:           defaultClient.Jar,
 		Timeout:       defaultClient.Timeout,
@@ -157,10 +157,10 @@ func DefaultTransport() *http.Transport {
 
 func DefaultClient() *http.Client {
 	return &http.Client{
-		Transport: DefaultTransport(),
+		Transport: log.NewTransport(DefaultTransport()),
 	}
 }
 
 func DefaultClientWithTransport(transport *http.Transport) *http.Client {
-	return &http.Client{Transport: transport}
+	return &http.Client{Transport: log.NewTransport(transport)}
 }
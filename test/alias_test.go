@@ -0,0 +1,43 @@
+package test
+
+func (s *CLITestSuite) TestAlias() {
+	resetConfiguration(s.T(), false)
+
+	tests := []CLITest{
+		{args: `alias create describe-color "configuration describe enable_color"`, fixture: "alias/create/success.golden"},
+		{args: `alias create describe-two "configuration describe $1" "configuration describe $2"`, fixture: "alias/create/multiple.golden"},
+		{args: `alias create describe-color "configuration describe disable_plugins"`, fixture: "alias/create/duplicate.golden", exitCode: 1},
+		{args: `alias create kafka "kafka cluster list"`, fixture: "alias/create/shadow.golden", exitCode: 1},
+		{args: `alias create Describe "configuration describe enable_color"`, fixture: "alias/create/invalid-name.golden", exitCode: 1},
+		{args: `alias create describe "confluent configuration describe enable_color"`, fixture: "alias/create/invalid-command.golden", exitCode: 1},
+		{args: "alias list", fixture: "alias/list/success.golden"},
+		{args: "alias list -o json", fixture: "alias/list/success-json.golden"},
+		{args: "describe-color", fixture: "alias/run/success.golden"},
+		{args: "describe-color -o json", fixture: "alias/run/success-json.golden"},
+		{args: "describe-two enable_color disable_plugins", fixture: "alias/run/multiple.golden"},
+		{args: "describe-two enable_color", fixture: "alias/run/missing-argument.golden", exitCode: 1},
+		{args: "describe-two enable_color invalid", fixture: "alias/run/multiple-fail.golden", exitCode: 1},
+		{args: "alias delete describe-color describe-two --force", fixture: "alias/delete/success.golden"},
+		{args: "alias delete describe-color --force", fixture: "alias/delete/fail.golden", exitCode: 1},
+	}
+
+	for _, test := range tests {
+		test.workflow = true
+		s.runIntegrationTest(test)
+	}
+}
+
+func (s *CLITestSuite) TestAlias_Help() {
+	resetConfiguration(s.T(), false)
+
+	tests := []CLITest{
+		{args: `alias create describe-color "configuration describe enable_color"`},
+		{args: "--help", fixture: "alias/run/help.golden"},
+		{args: `__complete describe-`, fixture: "alias/run/autocomplete.golden"},
+	}
+
+	for _, test := range tests {
+		test.workflow = true
+		s.runIntegrationTest(test)
+	}
+}
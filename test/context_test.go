@@ -2,6 +2,7 @@ package test
 
 import (
 	"fmt"
+	"os"
 
 	pauth "github.com/confluentinc/cli/v3/pkg/auth"
 )
@@ -66,6 +67,33 @@ func (s *CLITestSuite) TestContextDescribe() {
 	}
 }
 
+func (s *CLITestSuite) TestContextExportImport() {
+	resetConfiguration(s.T(), false)
+	s.T().Cleanup(func() { _ = os.Remove("context-export.json") })
+
+	const recipient = "age1d3lnvtegkk7ny5z7e9eef3hp9ynkm57ey34kszwljkrnqgamfv8qwg4xcx"
+
+	tests := []CLITest{
+		{args: s.contextCreateArgs("0")},
+		{args: "context export 1 --file context-export.json", fixture: "context/export/fail.golden", exitCode: 1},
+		{args: "context export 0 --file context-export.json --recipient age1invalid", fixture: "context/export/invalid-recipient.golden", exitCode: 1},
+		{args: "context export 0 --file context-export.json --recipient " + recipient, fixture: "context/export/success.golden"},
+		{args: "context import context-export.json --key-file test/fixtures/input/context/key.txt", fixture: "context/import/merge.golden"},
+		{args: "context import context-export.json --key-file test/fixtures/input/context/key.txt --conflict rename", fixture: "context/import/rename.golden"},
+		{args: "context import context-export.json --conflict skip", fixture: "context/import/skip.golden"},
+		{args: "context import context-export.json --conflict overwrite", fixture: "context/import/invalid-conflict.golden", exitCode: 1},
+		{args: "context delete 0 --force"},
+		{args: "context import context-export.json --key-file test/fixtures/input/context/key.txt", fixture: "context/import/create.golden"},
+		{args: "context use 0"},
+		{args: "context describe --api-key", fixture: "context/import/describe.golden"},
+	}
+
+	for _, test := range tests {
+		test.workflow = true
+		s.runIntegrationTest(test)
+	}
+}
+
 func (s *CLITestSuite) TestContextList() {
 	resetConfiguration(s.T(), false)
 
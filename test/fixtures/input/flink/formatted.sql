@@ -0,0 +1,4 @@
+SET 'client.statement-name' = 'my-statement';
+
+SELECT *
+FROM orders;
@@ -0,0 +1,4 @@
+SET 'client.unknown' = 'value';
+SET 'table.exec.mini-batch.enabled' = 'true';
+INSERT INTO sink SELECT * FROM source;
+SELECT 'unterminated FROM orders
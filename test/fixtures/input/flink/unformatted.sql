@@ -0,0 +1,2 @@
+-- orders by customer
+insert into customer_orders select customer_id, count(*) as cnt from orders where status='shipped' group by customer_id;
@@ -92,6 +92,21 @@ func (s *CLITestSuite) TestFlinkStatement() {
 	}
 }
 
+func (s *CLITestSuite) TestFlinkStatementReplay() {
+	resetConfiguration(s.T(), false)
+
+	// A replay doesn't call the Flink gateway, so it works without a login
+	tests := []CLITest{
+		{args: "flink statement describe my-statement --replay test/fixtures/input/flink/statement-recording.jsonl", fixture: "flink/statement/describe-replay.golden"},
+		{args: "flink statement list --replay test/fixtures/input/flink/statement-recording.jsonl", fixture: "flink/statement/list-replay.golden"},
+		{args: "flink statement describe other-statement --replay test/fixtures/input/flink/statement-recording.jsonl", fixture: "flink/statement/describe-replay-missing.golden", exitCode: 1},
+	}
+
+	for _, test := range tests {
+		s.runIntegrationTest(test)
+	}
+}
+
 func (s *CLITestSuite) TestFlinkStatementCreate() {
 	tests := []CLITest{
 		{args: `flink statement create my-statement --sql "INSERT * INTO table;" --compute-pool lfcp-123456 --service-account sa-123456`, fixture: "flink/statement/create.golden"},
@@ -120,3 +135,43 @@ func (s *CLITestSuite) TestFlink_Autocomplete() {
 		s.runIntegrationTest(test)
 	}
 }
+
+func (s *CLITestSuite) TestFlinkHistory() {
+	tests := []CLITest{
+		{args: "flink history list", fixture: "flink/history/list-empty.golden"},
+		{args: "flink history rerun 1", fixture: "flink/history/rerun-not-found.golden", exitCode: 1},
+	}
+
+	for _, test := range tests {
+		test.login = "cloud"
+		s.runIntegrationTest(test)
+	}
+}
+
+func (s *CLITestSuite) TestFlinkHistory_NoLogin() {
+	resetConfiguration(s.T(), false)
+
+	// The history is a local file, so it can be listed without a login
+	tests := []CLITest{
+		{args: "flink history list", fixture: "flink/history/list-empty.golden"},
+		{args: "flink history rerun 1", fixture: "flink/history/rerun-no-login.golden", exitCode: 1},
+	}
+
+	for _, test := range tests {
+		s.runIntegrationTest(test)
+	}
+}
+
+func (s *CLITestSuite) TestFlinkSql() {
+	tests := []CLITest{
+		{args: "flink sql format test/fixtures/input/flink/unformatted.sql", fixture: "flink/sql/format.golden"},
+		{args: "flink sql format test/fixtures/input/flink/formatted.sql test/fixtures/input/flink/unformatted.sql --check", fixture: "flink/sql/format-check.golden", exitCode: 1},
+		{args: "flink sql format test/fixtures/input/flink/formatted.sql --check"},
+		{args: "flink sql lint test/fixtures/input/flink/lint.sql", fixture: "flink/sql/lint.golden", exitCode: 1},
+		{args: "flink sql lint test/fixtures/input/flink/formatted.sql -o json", fixture: "flink/sql/lint-json.golden"},
+	}
+
+	for _, test := range tests {
+		s.runIntegrationTest(test)
+	}
+}
@@ -339,6 +339,15 @@ func (s *CLITestSuite) TestKafkaPartition() {
 		{args: "kafka partition reassignment list --topic topic1", fixture: "kafka/partition/reassignment/list-by-topic.golden"},
 		{args: "kafka partition reassignment list 0 --topic topic1", fixture: "kafka/partition/reassignment/list-by-partition.golden"},
 		{args: "kafka partition reassignment list 0 --topic topic1 -o yaml", fixture: "kafka/partition/reassignment/list-by-partition-yaml.golden"},
+		{args: "kafka partition reassignment generate --balance", fixture: "kafka/partition/reassignment/generate-balance.golden"},
+		{args: "kafka partition reassignment generate --balance -o json", fixture: "kafka/partition/reassignment/generate-balance-json.golden"},
+		{args: "kafka partition reassignment generate --topics topic1 --remove-brokers 1", fixture: "kafka/partition/reassignment/generate-remove-brokers.golden"},
+		{args: "kafka partition reassignment generate --topics topic1 --replication-factor 2", fixture: "kafka/partition/reassignment/generate-replication-factor.golden"},
+		{args: "kafka partition reassignment generate --replication-factor 3", fixture: "kafka/partition/reassignment/generate-replication-factor-too-large.golden", exitCode: 1},
+		{args: "kafka partition reassignment throttle --rate 52428800", fixture: "kafka/partition/reassignment/throttle.golden"},
+		{args: "kafka partition reassignment throttle --rate 10485760 --input-file test/fixtures/input/kafka/partition/reassignment-plan.json", fixture: "kafka/partition/reassignment/throttle-input-file.golden"},
+		{args: "kafka partition reassignment throttle --remove --input-file test/fixtures/input/kafka/partition/reassignment-plan.json", fixture: "kafka/partition/reassignment/throttle-remove.golden"},
+		{args: "kafka partition reassignment throttle --remove", fixture: "kafka/partition/reassignment/throttle-remove-no-input-file.golden", exitCode: 1},
 	}
 	for _, test := range tests {
 		test.login = "onprem"
@@ -431,6 +440,19 @@ func (s *CLITestSuite) TestKafkaTopicDelete() {
 	}
 }
 
+func (s *CLITestSuite) TestKafkaTopicApply() {
+	kafkaRestURL := s.TestBackend.GetKafkaRestUrl()
+	tests := []CLITest{
+		{args: fmt.Sprintf("kafka topic apply test/fixtures/input/kafka/topic/manifest.yaml --url %s --no-authentication --dry-run", kafkaRestURL), fixture: "kafka/topic/apply-dry-run.golden"},
+		{args: fmt.Sprintf("kafka topic apply test/fixtures/input/kafka/topic/manifest.yaml --url %s --no-authentication -o json", kafkaRestURL), fixture: "kafka/topic/apply-json.golden"},
+		{args: fmt.Sprintf("kafka topic apply test/fixtures/input/kafka/topic/manifest-partitions.yaml --url %s --no-authentication", kafkaRestURL), fixture: "kafka/topic/apply-partitions-onprem.golden", exitCode: 1},
+	}
+
+	for _, test := range tests {
+		s.runIntegrationTest(test)
+	}
+}
+
 func (s *CLITestSuite) TestKafkaTopicUpdate() {
 	kafkaRestURL := s.TestBackend.GetKafkaRestUrl()
 	tests := []CLITest{
@@ -0,0 +1,59 @@
+package test
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func (s *CLITestSuite) TestLogFile() {
+	s.T().Cleanup(func() { _ = os.Remove("log.json") })
+
+	test := CLITest{
+		args:    "environment list --log-file log.json",
+		login:   "cloud",
+		fixture: "environment/1.golden",
+		wantFunc: func(t *testing.T) {
+			file, err := os.Open("log.json")
+			require.NoError(t, err)
+			defer file.Close()
+
+			var entries []map[string]any
+			scanner := bufio.NewScanner(file)
+			for scanner.Scan() {
+				entry := map[string]any{}
+				require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+				entries = append(entries, entry)
+			}
+			require.NoError(t, scanner.Err())
+			require.NotEmpty(t, entries)
+
+			require.Equal(t, "Started command", entries[0]["@message"])
+			require.Equal(t, "confluent environment list", entries[0]["command"])
+			require.Equal(t, "Finished command", entries[len(entries)-1]["@message"])
+
+			var requests, responses int
+			for _, entry := range entries {
+				require.Equal(t, entries[0]["correlation_id"], entry["correlation_id"])
+
+				switch entry["@message"] {
+				case "HTTP request":
+					requests++
+					headers := entry["headers"].(map[string]any)
+					require.Equal(t, "[REDACTED]", headers["Authorization"])
+				case "HTTP response":
+					responses++
+					require.Equal(t, float64(200), entry["status"])
+					require.Contains(t, entry, "duration_ms")
+				}
+			}
+			require.NotZero(t, requests)
+			require.Equal(t, requests, responses)
+		},
+	}
+
+	s.runIntegrationTest(test)
+}
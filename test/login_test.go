@@ -314,6 +314,90 @@ func (s *CLITestSuite) TestLogin_CaCertPath() {
 	}
 }
 
+func (s *CLITestSuite) TestLogin_OAuth() {
+	resetConfiguration(s.T(), false)
+
+	mdsUrl := s.TestBackend.GetMdsUrl()
+	tokenUrl := mdsUrl + "/oauth/token"
+
+	tests := []CLITest{
+		{
+			args:     fmt.Sprintf("login --url %s --client-secret my-secret", mdsUrl),
+			fixture:  "login/oauth/no-token-url.golden",
+			exitCode: 1,
+		},
+		{
+			args:     fmt.Sprintf("login --url %s --oauth-token-url %s --client-id my-client --client-secret wrong-secret", mdsUrl, tokenUrl),
+			fixture:  "login/oauth/invalid-client.golden",
+			exitCode: 1,
+		},
+		{
+			args:     fmt.Sprintf("login --oauth-token-url %s --client-id my-client --client-secret my-secret", tokenUrl),
+			fixture:  "login/oauth/ccloud.golden",
+			exitCode: 1,
+		},
+		{
+			env:  []string{fmt.Sprintf("%s=my-secret", pauth.ConfluentPlatformClientSecret)},
+			args: fmt.Sprintf("login --url %s --oauth-token-url %s --client-id my-client --scope kafka,mds", mdsUrl, tokenUrl),
+			wantFunc: func(t *testing.T) {
+				got, err := os.ReadFile(filepath.Join(os.Getenv("HOME"), ".confluent", "config.json"))
+				require.NoError(t, err)
+				data := config.Config{}
+				require.NoError(t, json.Unmarshal(got, &data))
+
+				oauth := data.Credentials["client-my-client"].OAuth
+				require.Equal(t, &config.OAuthConfig{TokenUrl: tokenUrl, ClientId: "my-client", Scopes: []string{"kafka", "mds"}}, oauth)
+			},
+		},
+		{
+			args:    "context list -o yaml",
+			fixture: "login/oauth/context-list.golden",
+			regex:   true,
+		},
+	}
+
+	for _, test := range tests {
+		test.workflow = true
+		s.runIntegrationTest(test)
+	}
+}
+
+func (s *CLITestSuite) TestLogin_ClientCertificate() {
+	resetConfiguration(s.T(), false)
+
+	tests := []CLITest{
+		{
+			args: fmt.Sprintf("login --url %s --client-cert-path test/fixtures/input/login/client.crt --client-key-path test/fixtures/input/login/client.key", s.TestBackend.GetMdsUrl()),
+			wantFunc: func(t *testing.T) {
+				got, err := os.ReadFile(filepath.Join(os.Getenv("HOME"), ".confluent", "config.json"))
+				require.NoError(t, err)
+				data := config.Config{}
+				require.NoError(t, json.Unmarshal(got, &data))
+
+				credential := data.Credentials["certificate-mtls-user"]
+				require.True(t, filepath.IsAbs(credential.ClientCertPath))
+				require.True(t, strings.HasSuffix(credential.ClientCertPath, "client.crt"))
+				require.True(t, strings.HasSuffix(credential.ClientKeyPath, "client.key"))
+			},
+		},
+		{
+			args:    "context list -o yaml",
+			fixture: "login/client-certificate/context-list.golden",
+			regex:   true,
+		},
+		{
+			args:     fmt.Sprintf("login --url %s --client-cert-path test/fixtures/input/login/client.crt --client-key-path test/fixtures/input/login/test.crt", s.TestBackend.GetMdsUrl()),
+			fixture:  "login/client-certificate/invalid-key.golden",
+			exitCode: 1,
+		},
+	}
+
+	for _, test := range tests {
+		test.workflow = true
+		s.runIntegrationTest(test)
+	}
+}
+
 func (s *CLITestSuite) TestLogin_SsoCodeInvalidFormat() {
 	resetConfiguration(s.T(), false)
 
@@ -0,0 +1,59 @@
+package test
+
+import (
+	"os"
+
+	"github.com/confluentinc/cli/v3/pkg/config"
+)
+
+func (s *CLITestSuite) TestProjectConfig() {
+	tests := []struct {
+		project string
+		CLITest
+	}{
+		{
+			project: `{`,
+			CLITest: CLITest{args: "version", contains: "[WARN] Ignoring the project configuration: unable to read configuration file"},
+		},
+		{
+			project: `{"context": "missing"}`,
+			CLITest: CLITest{args: "context list", login: "cloud", contains: `[WARN] Ignoring the project configuration: the context "missing" pinned by project configuration file`},
+		},
+		{
+			project: `{"context": "missing"}`,
+			CLITest: CLITest{args: "context list", login: "cloud", contains: "login-fake@user.com"},
+		},
+		{
+			project: `{"flags": {"force": "true"}}`,
+			CLITest: CLITest{args: "environment list", login: "cloud", contains: `[WARN] Ignoring the project configuration: flag "--force" cannot be set by project configuration file`},
+		},
+		{
+			project: `{"flags": {"environment": "env-596"}}`,
+			CLITest: CLITest{args: "environment list", login: "cloud", notContains: "[WARN]"},
+		},
+	}
+
+	s.T().Cleanup(func() { _ = os.Remove(config.ProjectConfigFilename) })
+
+	for _, test := range tests {
+		s.Require().NoError(os.WriteFile(config.ProjectConfigFilename, []byte(test.project), 0600))
+		s.runIntegrationTest(test.CLITest)
+	}
+}
+
+func (s *CLITestSuite) TestProjectConfigContextUse() {
+	resetConfiguration(s.T(), false)
+
+	s.T().Cleanup(func() { _ = os.Remove(config.ProjectConfigFilename) })
+
+	for _, test := range []CLITest{{args: s.contextCreateArgs("0")}, {args: s.contextCreateArgs("1")}, {args: "context use 0"}} {
+		test.workflow = true
+		s.runIntegrationTest(test)
+	}
+
+	s.Require().NoError(os.WriteFile(config.ProjectConfigFilename, []byte(`{"context": "0"}`), 0600))
+	s.runIntegrationTest(CLITest{args: "context use 1", contains: `Using context "1".`, workflow: true})
+	s.Require().NoError(os.Remove(config.ProjectConfigFilename))
+
+	s.runIntegrationTest(CLITest{args: "context describe", contains: "| Name       | 1 ", workflow: true})
+}
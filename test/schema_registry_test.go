@@ -52,6 +52,25 @@ func (s *CLITestSuite) TestSchemaRegistryCompatibilityValidate() {
 	}
 }
 
+func (s *CLITestSuite) TestSchemaRegistryCompatibilityCheck() {
+	v1 := getInputFixturePath("schema-registry/compatibility", "payment-v1.avsc")
+	v2 := getInputFixturePath("schema-registry/compatibility", "payment-v2.avsc")
+	v3 := getInputFixturePath("schema-registry/compatibility", "payment-v3.avsc")
+	exportDirectory := getInputFixturePath("schema-registry/compatibility", "export")
+
+	tests := []CLITest{
+		{args: fmt.Sprintf("schema-registry compatibility check %s --type avro --previous %s", v2, v1), fixture: "schema-registry/compatibility/check.golden"},
+		{args: fmt.Sprintf("schema-registry compatibility check %s --type avro --previous %s,%s --compatibility full_transitive", v3, v1, v2), fixture: "schema-registry/compatibility/check-incompatible.golden", exitCode: 1},
+		{args: fmt.Sprintf("schema-registry compatibility check %s --directory %s --subject payments-value -o json", v3, exportDirectory), fixture: "schema-registry/compatibility/check-directory-json.golden", exitCode: 1},
+		{args: fmt.Sprintf("schema-registry compatibility check %s --directory %s --subject payments-value --compatibility none", v3, exportDirectory), fixture: "schema-registry/compatibility/check-directory-none.golden"},
+		{args: fmt.Sprintf("schema-registry compatibility check %s --directory %s --subject orders-value", v3, exportDirectory), fixture: "schema-registry/compatibility/check-directory-subject-not-found.golden", exitCode: 1},
+	}
+
+	for _, test := range tests {
+		s.runIntegrationTest(test)
+	}
+}
+
 func (s *CLITestSuite) TestSchemaRegistryConfigDescribe() {
 	tests := []CLITest{
 		{args: fmt.Sprintf("schema-registry config describe --environment %s", testserver.SRApiEnvId), fixture: "schema-registry/config/describe-global.golden"},
@@ -148,6 +167,21 @@ func (s *CLITestSuite) TestSchemaRegistrySubject() {
 	}
 }
 
+func (s *CLITestSuite) TestSchemaRegistrySubjectExportImport() {
+	dir := s.T().TempDir()
+
+	tests := []CLITest{
+		{args: fmt.Sprintf("schema-registry subject export %s --prefix lvl --environment %s", dir, testserver.SRApiEnvId), fixture: "schema-registry/subject/export.golden"},
+		{args: fmt.Sprintf("schema-registry subject import %s --environment %s", dir, testserver.SRApiEnvId), fixture: "schema-registry/subject/import.golden"},
+		{args: fmt.Sprintf("schema-registry subject import %s --preserve-ids --environment %s -o json", dir, testserver.SRApiEnvId), fixture: "schema-registry/subject/import-preserve-ids-json.golden"},
+	}
+
+	for _, test := range tests {
+		test.login = "cloud"
+		s.runIntegrationTest(test)
+	}
+}
+
 func (s *CLITestSuite) TestSchemaRegistryRegionList() {
 	tests := []CLITest{
 		{args: "schema-registry region list", fixture: "schema-registry/region/list-all.golden"},
@@ -45,6 +45,7 @@ var kafkaRestRoutes = []route{
 	{"/kafka/v3/clusters/{cluster_id}/topics/{topic_name}/partitions", handleKafkaTopicPartitions},
 	{"/kafka/v3/clusters/{cluster_id}/topics/{topic_name}/partitions/{partition_id}", handleKafkaTopicPartitionId},
 	{"/kafka/v3/clusters/{cluster_id}/topics/{topic_name}/partitions/{partition_id}/reassignment", handleKafkaTopicPartitionIdReassignment},
+	{"/kafka/v3/clusters/{cluster_id}/topics/-/partitions/-/replica-status", handleKafkaRestAllReplicaStatus},
 	{"/kafka/v3/clusters/{cluster_id}/topics/{topic}/partitions/-/replica-status", handleKafkaRestReplicaStatus},
 	{"/kafka/v3/clusters/{cluster}/acls", handleKafkaRestACLs},
 	{"/kafka/v3/clusters/{cluster}/links", handleKafkaRestLinks},
@@ -316,6 +317,22 @@ func handleKafkaRestTopicConfigs(t *testing.T) http.HandlerFunc {
 	}
 }
 
+// Handler for: "/kafka/v3/clusters/{cluster_id}/topics/-/partitions/-/replica-status"
+func handleKafkaRestAllReplicaStatus(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		err := json.NewEncoder(w).Encode(cpkafkarestv3.ReplicaStatusDataList{
+			Data: []cpkafkarestv3.ReplicaStatusData{
+				{TopicName: "topic1", BrokerId: 1, PartitionId: 0, IsLeader: true, IsInIsr: true, LogStartOffset: 0, LogEndOffset: 100},
+				{TopicName: "topic1", BrokerId: 1, PartitionId: 1, IsLeader: true, IsInIsr: true, LogStartOffset: 20, LogEndOffset: 120},
+				{TopicName: "topic1", BrokerId: 1, PartitionId: 2, IsLeader: true, IsInIsr: true, LogStartOffset: 0, LogEndOffset: 50},
+				{TopicName: "topic2", BrokerId: 1, PartitionId: 0, IsLeader: true, IsInIsr: true, LogStartOffset: 0, LogEndOffset: 10},
+				{TopicName: "topic2", BrokerId: 2, PartitionId: 0, IsInIsr: true, LogStartOffset: 0, LogEndOffset: 10},
+			},
+		})
+		require.NoError(t, err)
+	}
+}
+
 // Handler for: "/kafka/v3/clusters/{cluster_id}/topics/{topic}/partitions/-/replica-status"
 func handleKafkaRestReplicaStatus(t *testing.T) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -16,6 +16,7 @@ import (
 var mdsRoutes = []route{
 	{"/security/1.0/authenticate", handleAuthenticate},
 	{"/security/1.0/registry/clusters", handleRegistryClusters},
+	{"/oauth/token", handleOAuthToken},
 }
 
 type MdsRouter struct {
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/gorilla/mux"
@@ -79,8 +80,11 @@ func handleSRSubjectVersions(t *testing.T) http.HandlerFunc {
 			require.NoError(t, err)
 		case http.MethodGet:
 			var versions []int32
-			if mux.Vars(r)["subject"] == "testSubject" {
+			switch subject := mux.Vars(r)["subject"]; {
+			case subject == "testSubject":
 				versions = []int32{1, 2, 3}
+			case strings.HasPrefix(subject, "lvl"):
+				versions = []int32{1}
 			}
 			err := json.NewEncoder(w).Encode(versions)
 			require.NoError(t, err)
@@ -297,6 +301,15 @@ func handleSRById(t *testing.T) http.HandlerFunc {
 func handleSRSubjects(t *testing.T) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		subjects := []string{"subject1", "subject2", "subject3", "topic1-value", "topic2-value"}
+		if prefix := r.URL.Query().Get("subjectPrefix"); prefix != "" && prefix != ":*:" {
+			var filtered []string
+			for _, subject := range append(subjects, "lvl0", "lvl1-1", "lvl1-2", "lvl2") {
+				if strings.HasPrefix(subject, prefix) {
+					filtered = append(filtered, subject)
+				}
+			}
+			subjects = filtered
+		}
 		err := json.NewEncoder(w).Encode(subjects)
 		require.NoError(t, err)
 	}
@@ -443,11 +456,17 @@ func handleSRSubjectConfig(t *testing.T) http.HandlerFunc {
 // Handler for: "/mode/{subject}"
 func handleSRSubjectMode(t *testing.T) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		req := &srsdk.ModeUpdateRequest{}
-		err := json.NewDecoder(r.Body).Decode(req)
-		require.NoError(t, err)
-		err = json.NewEncoder(w).Encode(srsdk.ModeUpdateRequest{Mode: req.Mode})
-		require.NoError(t, err)
+		switch r.Method {
+		case http.MethodPut:
+			req := &srsdk.ModeUpdateRequest{}
+			err := json.NewDecoder(r.Body).Decode(req)
+			require.NoError(t, err)
+			err = json.NewEncoder(w).Encode(srsdk.ModeUpdateRequest{Mode: req.Mode})
+			require.NoError(t, err)
+		case http.MethodGet:
+			err := json.NewEncoder(w).Encode(srsdk.Mode{Mode: srsdk.PtrString("READWRITE")})
+			require.NoError(t, err)
+		}
 	}
 }
 